@@ -2,6 +2,7 @@ package githubappauthextension // import "github.com/liatrio/liatrio-otel-collec
 
 import (
 	"errors"
+	"net/url"
 
 	"go.opentelemetry.io/collector/component"
 )
@@ -10,6 +11,7 @@ var (
 	errNoGitHubAppIDProvided      = errors.New("no GitHub App ID provided in the GitHub App Auth extension configuration")
 	errNoGitHubAppInstIDProvided  = errors.New("no GitHub App Installation ID provided in the GitHub App Auth extension configuration")
 	errNoGitHubPrivateKeyProvided = errors.New("no GitHub App Private Key provided in the GitHub App Auth extension configuration")
+	errInvalidAPIURL              = errors.New("the GitHub API URL in the GitHub App Auth extension configuration must be an absolute http or https URL")
 )
 
 // Config stores the configuration for the GitHub App Installation flow. See:
@@ -25,6 +27,11 @@ type Config struct {
 	// GitHubAppPrivateKeyFile is the file path to the private key generated
 	// for the GitHub App.
 	GitHubAppPrivateKeyFile string `mapstructure:"private_key_file"`
+
+	// APIURL is the base URL of the GitHub REST API used to create
+	// installation access tokens. Defaults to https://api.github.com. For
+	// GitHub Enterprise Server, this is typically https://HOSTNAME/api/v3.
+	APIURL string `mapstructure:"api_url"`
 }
 
 var _ component.Config = (*Config)(nil)
@@ -42,5 +49,12 @@ func (cfg *Config) Validate() error {
 	if cfg.GitHubAppPrivateKeyFile == "" {
 		return errNoGitHubPrivateKeyProvided
 	}
+
+	if cfg.APIURL != "" {
+		u, err := url.Parse(cfg.APIURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errInvalidAPIURL
+		}
+	}
 	return nil
 }
@@ -29,10 +29,23 @@ func TestLoadConfig(t *testing.T) {
 				GitHubAppPrivateKeyFile: "testdata/test-key.pem",
 			},
 		},
+		{
+			id: component.NewIDWithName(metadata.Type, "enterprise"),
+			expected: &Config{
+				GitHubAppID:             1234,
+				GitHubAppInstId:         1234,
+				GitHubAppPrivateKeyFile: "testdata/test-key.pem",
+				APIURL:                  "https://ghes.example.com/api/v3",
+			},
+		},
 		{
 			id:          component.NewIDWithName(metadata.Type, "missingid"),
 			expectedErr: errNoGitHubAppIDProvided,
 		},
+		{
+			id:          component.NewIDWithName(metadata.Type, "invalidapiurl"),
+			expectedErr: errInvalidAPIURL,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.id.String(), func(t *testing.T) {
@@ -3,6 +3,7 @@ package githubappauthextension // import "github.com/liatrio/liatrio-otel-collec
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	"go.opentelemetry.io/collector/component"
@@ -29,6 +30,10 @@ func newGitHubAppAuthenticator(cfg *Config, logger *zap.Logger) (*githubAppAuthe
 		return nil, err
 	}
 
+	if cfg.APIURL != "" {
+		a.BaseURL = strings.TrimSuffix(cfg.APIURL, "/")
+	}
+
 	return &githubAppAuthenticator{
 		logger: logger,
 		client: &http.Client{
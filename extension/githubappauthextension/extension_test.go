@@ -3,6 +3,7 @@ package githubappauthextension // import "github.com/liatrio/liatrio-otel-collec
 import (
 	"testing"
 
+	"github.com/bradleyfalzon/ghinstallation/v2"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 )
@@ -13,6 +14,9 @@ func TestGitHubAppAuthSettings(t *testing.T) {
 		settings      *Config
 		shouldError   bool
 		expectedError string
+		// expectedBaseURL is the GitHub API URL installation tokens are
+		// created with when it is not the default.
+		expectedBaseURL string
 	}{
 		{
 			name: "all_valid_settings",
@@ -24,6 +28,16 @@ func TestGitHubAppAuthSettings(t *testing.T) {
 			shouldError:   false,
 			expectedError: "",
 		},
+		{
+			name: "enterprise_server",
+			settings: &Config{
+				GitHubAppID:             1234,
+				GitHubAppInstId:         1234,
+				GitHubAppPrivateKeyFile: "testdata/test-key.pem",
+				APIURL:                  "https://ghes.example.com/api/v3/",
+			},
+			expectedBaseURL: "https://ghes.example.com/api/v3",
+		},
 		{
 			name: "invalid_settings",
 			settings: &Config{
@@ -38,13 +52,16 @@ func TestGitHubAppAuthSettings(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			_, err := newGitHubAppAuthenticator(test.settings, zap.NewNop())
+			auth, err := newGitHubAppAuthenticator(test.settings, zap.NewNop())
 			if test.shouldError {
 				assert.Error(t, err)
 				assert.Contains(t, err.Error(), test.expectedError)
 				return
 			}
 			assert.NoError(t, err)
+			if test.expectedBaseURL != "" {
+				assert.Equal(t, test.expectedBaseURL, auth.client.Transport.(*ghinstallation.Transport).BaseURL)
+			}
 		})
 	}
 }
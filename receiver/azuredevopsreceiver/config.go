@@ -12,6 +12,7 @@ import (
 	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/multierr"
 
 	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadata"
@@ -38,6 +39,20 @@ type WebHook struct {
 	AzureDevOpsHeaders AzureDevOpsHeaders             `mapstructure:",squash"` // GitLab headers set by default
 	Secret             string                         `mapstructure:"secret"`  // secret for webhook
 	ServiceName        string                         `mapstructure:"service_name"`
+	Async              WebHookAsync                   `mapstructure:"async"` // optional asynchronous processing of deliveries
+}
+
+// WebHookAsync configures processing deliveries asynchronously. Validated
+// deliveries are acknowledged with a 202 right away and processed from a
+// bounded queue by a pool of workers, so a slow pipeline does not make Azure
+// DevOps time out.
+type WebHookAsync struct {
+	Enabled    bool `mapstructure:"enabled"`
+	QueueSize  int  `mapstructure:"queue_size"`  // maximum number of deliveries waiting to be processed
+	NumWorkers int  `mapstructure:"num_workers"` // number of deliveries processed concurrently
+	// Overflow is the behavior when the queue is full: block until there is
+	// room, drop the delivery, or reject it with a 429.
+	Overflow string `mapstructure:"overflow"`
 }
 
 type AzureDevOpsHeaders struct {
@@ -48,14 +63,43 @@ type AzureDevOpsHeaders struct {
 var _ component.Config = (*Config)(nil)
 var _ confmap.Unmarshaler = (*Config)(nil)
 
+var (
+	errAsyncQueueSize  = errors.New("the async queue_size must be greater than 0")
+	errAsyncNumWorkers = errors.New("the async num_workers must be greater than 0")
+	errAsyncOverflow   = errors.New("the async overflow must be one of [block, drop, reject]")
+)
+
 // Validate the configuration passed through the OTEL config.yaml
 func (cfg *Config) Validate() error {
 	if len(cfg.Scrapers) == 0 {
 		return errors.New("must specify at least one scraper")
 	}
+	if cfg.WebHook.Async.Enabled {
+		return cfg.WebHook.Async.validate()
+	}
 	return nil
 }
 
+func (cfg *WebHookAsync) validate() error {
+	var errs error
+
+	if cfg.QueueSize <= 0 {
+		errs = multierr.Append(errs, errAsyncQueueSize)
+	}
+
+	if cfg.NumWorkers <= 0 {
+		errs = multierr.Append(errs, errAsyncNumWorkers)
+	}
+
+	switch cfg.Overflow {
+	case overflowBlock, overflowDrop, overflowReject:
+	default:
+		errs = multierr.Append(errs, errAsyncOverflow)
+	}
+
+	return errs
+}
+
 // Unmarshal a config.Parser into the config struct.
 func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 	if componentParser == nil {
@@ -67,12 +67,59 @@ func TestLoadConfig(t *testing.T) {
 			},
 			Path:       "/events",
 			HealthPath: "/health",
+			Async: WebHookAsync{
+				Enabled:    true,
+				QueueSize:  100,
+				NumWorkers: 2,
+				Overflow:   overflowDrop,
+			},
 		},
 	}
 
 	assert.Equal(t, expectedConfig, r1)
 }
 
+func TestConfig_ValidateAsync(t *testing.T) {
+	tests := []struct {
+		name    string
+		async   WebHookAsync
+		wantErr []error
+	}{
+		{
+			name:  "disabled",
+			async: WebHookAsync{},
+		},
+		{
+			name:  "valid",
+			async: WebHookAsync{Enabled: true, QueueSize: 1, NumWorkers: 1, Overflow: overflowReject},
+		},
+		{
+			name:    "invalid",
+			async:   WebHookAsync{Enabled: true, Overflow: "retry"},
+			wantErr: []error{errAsyncQueueSize, errAsyncNumWorkers, errAsyncOverflow},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Scrapers = map[string]internal.Config{
+				azuredevopsscraper.TypeStr: (&azuredevopsscraper.Factory{}).CreateDefaultConfig(),
+			}
+			cfg.WebHook.Async = test.async
+
+			err := cfg.Validate()
+			if len(test.wantErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, wantErr := range test.wantErr {
+				require.ErrorIs(t, err, wantErr)
+			}
+		})
+	}
+}
+
 func TestLoadInvalidConfig_NoScrapers(t *testing.T) {
 	factories, err := otelcoltest.NopFactories()
 	require.NoError(t, err)
@@ -29,6 +29,13 @@ const (
 	defaultPath         = "/events"
 	defaultHealthPath   = "/health"
 	defaultEndpoint     = "localhost:8080"
+
+	// defaultAsyncQueueSize is the number of deliveries which can wait to be
+	// processed when processing asynchronously.
+	defaultAsyncQueueSize = 1000
+	// defaultAsyncNumWorkers is the number of deliveries processed
+	// concurrently when processing asynchronously.
+	defaultAsyncNumWorkers = 4
 )
 
 var (
@@ -74,6 +81,11 @@ func createDefaultConfig() component.Config {
 			},
 			Path:       defaultPath,
 			HealthPath: defaultHealthPath,
+			Async: WebHookAsync{
+				QueueSize:  defaultAsyncQueueSize,
+				NumWorkers: defaultAsyncNumWorkers,
+				Overflow:   overflowBlock,
+			},
 		},
 	}
 }
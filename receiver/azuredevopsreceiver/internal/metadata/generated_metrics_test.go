@@ -403,7 +403,7 @@ func TestMetricsBuilder(t *testing.T) {
 						validatedMetrics["vcs.change.count"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (either open or merged).", mi.Description())
+						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (open, merged or closed without merging).", mi.Description())
 						assert.Equal(t, "{change}", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -427,7 +427,7 @@ func TestMetricsBuilder(t *testing.T) {
 						validatedMetrics["vcs.change.count"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (either open or merged).", mi.Description())
+						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (open, merged or closed without merging).", mi.Description())
 						assert.Equal(t, "{change}", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -458,7 +458,7 @@ func TestMetricsBuilder(t *testing.T) {
 						validatedMetrics["vcs.change.duration"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in an open state.", mi.Description())
+						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in a given state (open, or until it was merged or closed).", mi.Description())
 						assert.Equal(t, "s", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -485,7 +485,7 @@ func TestMetricsBuilder(t *testing.T) {
 						validatedMetrics["vcs.change.duration"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in an open state.", mi.Description())
+						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in a given state (open, or until it was merged or closed).", mi.Description())
 						assert.Equal(t, "s", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -18,6 +18,7 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
+	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadata"
 )
 
@@ -57,12 +58,15 @@ type WorkItemBatchResult struct {
 }
 
 type azuredevopsScraper struct {
-	client   *http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	logger   *zap.Logger
-	mb       *metadata.MetricsBuilder
-	rb       *metadata.ResourceBuilder
+	client    *http.Client
+	cfg       *Config
+	settings  component.TelemetrySettings
+	logger    *zap.Logger
+	mb        *metadata.MetricsBuilder
+	rb        *metadata.ResourceBuilder
+	telemetry *metadata.TelemetryBuilder
+	// scraperTelemetry records the requests and the scrapes of the scraper
+	scraperTelemetry *internal.ScraperTelemetry
 }
 
 func (ados *azuredevopsScraper) start(ctx context.Context, host component.Host) (err error) {
@@ -76,6 +80,17 @@ func (ados *azuredevopsScraper) start(ctx context.Context, host component.Host)
 	}
 
 	ados.client, err = ados.cfg.ToClient(ctx, extensions, ados.settings)
+	if err != nil {
+		return
+	}
+
+	ados.telemetry, err = metadata.NewTelemetryBuilder(ados.settings)
+	if err != nil {
+		return
+	}
+
+	ados.scraperTelemetry = internal.NewScraperTelemetry(ados.telemetry, TypeStr)
+	ados.client.Transport = ados.scraperTelemetry.Transport(ados.client.Transport)
 
 	if ados.cfg.Metrics.WorkItemTagCount.Enabled && len(ados.cfg.WorkItemTagAllowlist) == 0 {
 		ados.logger.Sugar().Warn("work_item.tag.count is enabled but work_item_tag_allowlist is empty — no tag " +
@@ -85,6 +100,13 @@ func (ados *azuredevopsScraper) start(ctx context.Context, host component.Host)
 	return
 }
 
+func (ados *azuredevopsScraper) shutdown(context.Context) error {
+	if ados.telemetry != nil {
+		ados.telemetry.Shutdown()
+	}
+	return nil
+}
+
 func newAzureDevOpsScraper(
 	_ context.Context,
 	settings receiver.Settings,
@@ -106,7 +128,10 @@ func (ados *azuredevopsScraper) scrape(ctx context.Context) (pmetric.Metrics, er
 		return pmetric.NewMetrics(), errClientNotInitErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	start := time.Now()
+	defer ados.scraperTelemetry.RecordScrape(ctx, start)
+
+	now := pcommon.NewTimestampFromTime(start)
 	ados.logger.Sugar().Debugf("current time: %v", now)
 
 	ados.logger.Sugar().Debug("creating Azure DevOps REST API client")
@@ -134,6 +159,7 @@ func (ados *azuredevopsScraper) scrape(ctx context.Context) (pmetric.Metrics, er
 		}
 
 		ados.logger.Sugar().Infof("Found %d repositories for organization %s and project %s", len(repos), ados.cfg.Organization, ados.cfg.Project)
+		ados.scraperTelemetry.RecordRepositories(ctx, len(repos))
 
 		// Record repository count metric
 		if m.VcsRepositoryCount.Enabled {
@@ -246,6 +272,7 @@ func (ados *azuredevopsScraper) scrape(ctx context.Context) (pmetric.Metrics, er
 						// Count PRs by state
 						openCount := int64(0)
 						mergedCount := int64(0)
+						closedCount := int64(0)
 
 						// Process pull request metrics
 						for _, pr := range pullRequests {
@@ -264,6 +291,13 @@ func (ados *azuredevopsScraper) scrape(ctx context.Context) (pmetric.Metrics, er
 									ados.mb.RecordVcsChangeDurationDataPoint(now, prAge, repo.WebURL, repo.Name, repo.ID,
 										pr.SourceRefName, metadata.AttributeVcsChangeStateOpen)
 								}
+							case "abandoned":
+								closedCount++
+								if !pr.ClosedDate.IsZero() && !pr.CreationDate.IsZero() {
+									prAge := int64(pr.ClosedDate.Sub(pr.CreationDate).Seconds())
+									ados.mb.RecordVcsChangeDurationDataPoint(now, prAge, repo.WebURL, repo.Name, repo.ID,
+										pr.SourceRefName, metadata.AttributeVcsChangeStateClosed)
+								}
 							}
 							mux.Unlock()
 						}
@@ -276,6 +310,9 @@ func (ados *azuredevopsScraper) scrape(ctx context.Context) (pmetric.Metrics, er
 						if mergedCount > 0 {
 							ados.mb.RecordVcsChangeCountDataPoint(now, mergedCount, repo.WebURL, metadata.AttributeVcsChangeStateMerged, repo.Name, repo.ID)
 						}
+						if closedCount > 0 {
+							ados.mb.RecordVcsChangeCountDataPoint(now, closedCount, repo.WebURL, metadata.AttributeVcsChangeStateClosed, repo.Name, repo.ID)
+						}
 						mux.Unlock()
 					} // end needsPullRequests
 				}()
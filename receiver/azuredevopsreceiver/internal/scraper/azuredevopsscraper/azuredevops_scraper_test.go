@@ -947,3 +947,76 @@ func TestScrapeWithPullRequestMetrics(t *testing.T) {
 	assert.NotNil(t, metrics)
 	assert.Greater(t, metrics.MetricCount(), 0)
 }
+
+func TestScrapeWithAbandonedPullRequests(t *testing.T) {
+	now := time.Now()
+	creationTime := now.Add(-3 * time.Hour)
+	closedTime := now.Add(-1 * time.Hour)
+
+	pullRequestsByStatus := map[string]string{
+		"active": fmt.Sprintf(`{"value": [{"pullRequestId": 1, "status": "active", "creationDate": "%s",
+			"sourceRefName": "refs/heads/feature-a"}]}`, creationTime.Format(time.RFC3339)),
+		"completed": `{"value": []}`,
+		"abandoned": fmt.Sprintf(`{"value": [{"pullRequestId": 2, "status": "abandoned", "creationDate": "%s",
+			"closedDate": "%s", "sourceRefName": "refs/heads/feature-b"}]}`,
+			creationTime.Format(time.RFC3339), closedTime.Format(time.RFC3339)),
+	}
+
+	handlers := map[string]func(w http.ResponseWriter, r *http.Request){
+		"/test-org/test-project/_apis/git/repositories": func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, singleRepoResponse)
+		},
+		"/test-org/test-project/_apis/git/repositories/repo-1/pullrequests": func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, pullRequestsByStatus[r.URL.Query().Get("searchCriteria.status")])
+		},
+	}
+
+	server := setupMockServer(t, handlers)
+	defer server.Close()
+
+	defaultCfg := metadata.NewDefaultMetricsBuilderConfig()
+	mbCfg := allMetricsDisabled()
+	mbCfg.Metrics.VcsChangeCount = defaultCfg.Metrics.VcsChangeCount
+	mbCfg.Metrics.VcsChangeDuration = defaultCfg.Metrics.VcsChangeDuration
+
+	cfg := &Config{
+		Organization:             "test-org",
+		Project:                  "test-project",
+		BaseURL:                  server.URL,
+		MetricsBuilderConfig:     mbCfg,
+		ResourceAttributesConfig: metadata.DefaultResourceAttributesConfig(),
+	}
+
+	settings := receivertest.NewNopSettings(metadata.Type)
+	scraper := newAzureDevOpsScraper(context.Background(), settings, cfg)
+	scraper.client = &http.Client{}
+
+	metrics, err := scraper.scrape(context.Background())
+	require.NoError(t, err)
+
+	counts := map[string]int64{}
+	durations := map[string]int64{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		m := ms.At(i)
+		if m.Name() != "vcs.change.count" && m.Name() != "vcs.change.duration" {
+			continue
+		}
+		dps := m.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			state, ok := dps.At(j).Attributes().Get("vcs.change.state")
+			require.True(t, ok)
+			if m.Name() == "vcs.change.count" {
+				counts[state.Str()] = dps.At(j).IntValue()
+			} else {
+				durations[state.Str()] = dps.At(j).IntValue()
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]int64{"open": 1, "closed": 1}, counts)
+	assert.Equal(t, int64(2*time.Hour/time.Second), durations["closed"])
+	assert.Contains(t, durations, "open")
+}
@@ -45,5 +45,6 @@ func (f *Factory) CreateMetricsScraper(
 	return scraper.NewMetrics(
 		s.scrape,
 		scraper.WithStart(s.start),
+		scraper.WithShutdown(s.shutdown),
 	)
 }
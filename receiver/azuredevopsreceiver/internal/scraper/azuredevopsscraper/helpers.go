@@ -344,7 +344,12 @@ func (ados *azuredevopsScraper) getCombinedPullRequests(
 	if err != nil {
 		return nil, err
 	}
+	abandonedPrs, err := ados.getPullRequests(ctx, projectID, repoID, "abandoned", minTime)
+	if err != nil {
+		return nil, err
+	}
 	prs := append(activePrs, completedPrs...)
+	prs = append(prs, abandonedPrs...)
 	return prs, nil
 }
 
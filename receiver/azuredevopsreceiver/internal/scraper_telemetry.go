@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal"
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadata"
+)
+
+// errorTypeOther is the error.type of a request which failed without a
+// response, following the OpenTelemetry semantic conventions.
+const errorTypeOther = "_OTHER"
+
+var guidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ScraperTelemetry records the internal telemetry of a scraper: the requests
+// it sends to the Azure DevOps APIs, the duration of its scrapes and the number of
+// repositories they processed.
+type ScraperTelemetry struct {
+	telemetry *metadata.TelemetryBuilder
+	scraper   attribute.KeyValue
+}
+
+// NewScraperTelemetry returns the internal telemetry of the scraper of the
+// given type, recorded with the given telemetry builder.
+func NewScraperTelemetry(telemetry *metadata.TelemetryBuilder, scraper string) *ScraperTelemetry {
+	return &ScraperTelemetry{
+		telemetry: telemetry,
+		scraper:   attribute.String("scraper", scraper),
+	}
+}
+
+// Transport returns an http.RoundTripper recording the count, the duration
+// and the errors of the requests sent through next.
+func (st *ScraperTelemetry) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &instrumentedTransport{next: next, telemetry: st}
+}
+
+// RecordScrape records the duration of a scrape which started at start. It
+// does nothing on a nil ScraperTelemetry, such as the one of a scraper which
+// was not started.
+func (st *ScraperTelemetry) RecordScrape(ctx context.Context, start time.Time) {
+	if st == nil {
+		return
+	}
+
+	st.telemetry.ReceiverScraperDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(st.scraper))
+}
+
+// RecordRepositories records the number of repositories processed by a
+// scrape. It does nothing on a nil ScraperTelemetry.
+func (st *ScraperTelemetry) RecordRepositories(ctx context.Context, repositories int) {
+	if st == nil {
+		return
+	}
+
+	st.telemetry.ReceiverScraperRepositories.Record(ctx, int64(repositories), metric.WithAttributes(st.scraper))
+}
+
+type instrumentedTransport struct {
+	next      http.RoundTripper
+	telemetry *ScraperTelemetry
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	endpoint := attribute.String("endpoint", requestEndpoint(req))
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start).Seconds()
+
+	tel := t.telemetry.telemetry
+	if err != nil {
+		attrs := metric.WithAttributes(t.telemetry.scraper, endpoint)
+		tel.ReceiverScraperRequests.Add(ctx, 1, attrs)
+		tel.ReceiverScraperRequestDuration.Record(ctx, duration, attrs)
+		tel.ReceiverScraperRequestErrors.Add(ctx, 1,
+			metric.WithAttributes(t.telemetry.scraper, endpoint, attribute.String("error.type", errorTypeOther)))
+		return nil, err
+	}
+
+	attrs := metric.WithAttributes(t.telemetry.scraper, endpoint, attribute.Int("http.response.status_code", resp.StatusCode))
+	tel.ReceiverScraperRequests.Add(ctx, 1, attrs)
+	tel.ReceiverScraperRequestDuration.Record(ctx, duration, attrs)
+	if resp.StatusCode >= http.StatusBadRequest {
+		tel.ReceiverScraperRequestErrors.Add(ctx, 1,
+			metric.WithAttributes(t.telemetry.scraper, endpoint, attribute.String("error.type", strconv.Itoa(resp.StatusCode))))
+	}
+
+	return resp, nil
+}
+
+// requestEndpoint returns the route of the Azure DevOps API a request is sent
+// to, with the organization and project, the repository IDs and the numeric
+// IDs and GUIDs in its path replaced by placeholders so that the endpoint has
+// a low cardinality. The segments before _apis, which include the collection
+// of an Azure DevOps Server, are replaced by the organization and project.
+func requestEndpoint(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	for i, segment := range segments {
+		if segment != "_apis" {
+			continue
+		}
+
+		prefix := []string{"{organization}"}
+		if i > 1 {
+			prefix = append(prefix, "{project}")
+		}
+		segments = append(prefix, segments[i:]...)
+		break
+	}
+
+	for i, segment := range segments {
+		switch {
+		case i > 0 && segments[i-1] == "repositories":
+			segments[i] = "{repository}"
+		case isNumeric(segment) || guidPattern.MatchString(segment):
+			segments[i] = "{id}"
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func isNumeric(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
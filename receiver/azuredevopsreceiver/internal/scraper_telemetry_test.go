@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadatatest"
+)
+
+func TestRequestEndpoint(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://dev.azure.com/liatrio/_apis/projects", expected: "/{organization}/_apis/projects"},
+		{url: "https://dev.azure.com/liatrio/project1/_apis/git/repositories?api-version=7.1", expected: "/{organization}/{project}/_apis/git/repositories"},
+		{
+			url:      "https://dev.azure.com/liatrio/project1/_apis/git/repositories/0f1e2d3c-4b5a-6978-8a9b-0c1d2e3f4a5b/pullrequests",
+			expected: "/{organization}/{project}/_apis/git/repositories/{repository}/pullrequests",
+		},
+		{url: "https://dev.azure.com/liatrio/project1/_apis/test/codecoverage?buildId=42", expected: "/{organization}/{project}/_apis/test/codecoverage"},
+		{url: "https://vsrm.dev.azure.com/liatrio/project1/_apis/release/definitions/7", expected: "/{organization}/{project}/_apis/release/definitions/{id}"},
+		{url: "https://ado.example.com/tfs/collection/project1/_apis/wit/wiql", expected: "/{organization}/{project}/_apis/wit/wiql"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, requestEndpoint(req))
+		})
+	}
+}
+
+func TestScraperTelemetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/liatrio/missing/_apis/git/repositories" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+	telemetry, err := metadata.NewTelemetryBuilder(metadatatest.NewSettings(tel).TelemetrySettings)
+	require.NoError(t, err)
+
+	st := NewScraperTelemetry(telemetry, "azuredevops")
+	client := &http.Client{Transport: st.Transport(nil)}
+
+	for _, path := range []string{"/liatrio/project1/_apis/wit/wiql", "/liatrio/project1/_apis/wit/wiql", "/liatrio/missing/_apis/git/repositories"} {
+		resp, err := client.Post(server.URL+path, "application/json", http.NoBody)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// a request failing without a response is an error without status code
+	_, err = client.Get("http://127.0.0.1:0/liatrio/project1/_apis/wit/wiql")
+	require.Error(t, err)
+
+	st.RecordScrape(context.Background(), time.Now())
+	st.RecordRepositories(context.Background(), 2)
+
+	scraper := attribute.String("scraper", "azuredevops")
+	wiql := attribute.String("endpoint", "/{organization}/{project}/_apis/wit/wiql")
+	repos := attribute.String("endpoint", "/{organization}/{project}/_apis/git/repositories")
+
+	metadatatest.AssertEqualReceiverScraperRequests(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 2, Attributes: attribute.NewSet(scraper, wiql, attribute.Int("http.response.status_code", http.StatusOK))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, repos, attribute.Int("http.response.status_code", http.StatusNotFound))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, wiql)},
+		},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualReceiverScraperRequestErrors(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 1, Attributes: attribute.NewSet(scraper, repos, attribute.String("error.type", "404"))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, wiql, attribute.String("error.type", "_OTHER"))},
+		},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualReceiverScraperRepositories(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 2, Attributes: attribute.NewSet(scraper)},
+		},
+		metricdatatest.IgnoreTimestamp())
+}
+
+func TestScraperTelemetryNotStarted(t *testing.T) {
+	var st *ScraperTelemetry
+	assert.NotPanics(t, func() {
+		st.RecordScrape(context.Background(), time.Now())
+		st.RecordRepositories(context.Background(), 1)
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 )
 
@@ -38,6 +39,7 @@ type azuredevopsTracesReceiver struct {
 	settings      receiver.Settings
 	logger        *zap.Logger
 	obsrecv       *receiverhelper.ObsReport
+	queue         *webhookQueue
 }
 
 func newTracesReceiver(
@@ -67,6 +69,13 @@ func newTracesReceiver(
 		obsrecv:       obsrecv,
 	}
 
+	if config.WebHook.Async.Enabled {
+		atr.queue, err = newWebhookQueue(config.WebHook.Async, params.TelemetrySettings, atr.handlePayload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return atr, nil
 }
 
@@ -107,6 +116,10 @@ func (atr *azuredevopsTracesReceiver) Start(ctx context.Context, host component.
 
 	atr.logger.Info("Health check now listening at", zap.String("health_path", atr.cfg.WebHook.HealthPath))
 
+	if atr.queue != nil {
+		atr.queue.start(ctx)
+	}
+
 	atr.shutdownWG.Add(1)
 	go func() {
 		defer atr.shutdownWG.Done()
@@ -118,19 +131,22 @@ func (atr *azuredevopsTracesReceiver) Start(ctx context.Context, host component.
 	return nil
 }
 
-func (atr *azuredevopsTracesReceiver) Shutdown(_ context.Context) error {
+func (atr *azuredevopsTracesReceiver) Shutdown(ctx context.Context) error {
 	if atr.server == nil {
 		return nil
 	}
 
 	err := atr.server.Close()
 	atr.shutdownWG.Wait()
+
+	// process the deliveries already acknowledged before shutting down
+	if atr.queue != nil {
+		err = multierr.Append(err, atr.queue.shutdown(ctx))
+	}
 	return err
 }
 
 func (atr *azuredevopsTracesReceiver) handleReq(w http.ResponseWriter, req *http.Request) {
-	ctx := atr.obsrecv.StartTracesOp(req.Context())
-
 	// Validate request path
 	if req.URL.Path != atr.cfg.WebHook.Path {
 		http.Error(w, "Not found", http.StatusNotFound)
@@ -155,6 +171,24 @@ func (atr *azuredevopsTracesReceiver) handleReq(w http.ResponseWriter, req *http
 		}
 	}
 
+	// Acknowledge the delivery right away when processing asynchronously
+	if atr.queue != nil {
+		status := atr.queue.enqueue(req, body)
+		if status != http.StatusAccepted {
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		w.WriteHeader(status)
+		return
+	}
+
+	atr.handlePayload(w, req, body)
+}
+
+// handlePayload converts a validated delivery into traces and consumes them.
+func (atr *azuredevopsTracesReceiver) handlePayload(w http.ResponseWriter, req *http.Request, body []byte) {
+	ctx := atr.obsrecv.StartTracesOp(req.Context())
+
 	// Parse the webhook payload based on event type
 	event, err := atr.parseAzureDevOpsWebhook(body)
 	if err != nil {
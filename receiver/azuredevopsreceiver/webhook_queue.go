@@ -0,0 +1,173 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevopsreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver"
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadata"
+)
+
+const (
+	overflowBlock  = "block"
+	overflowDrop   = "drop"
+	overflowReject = "reject"
+)
+
+// webhookDelivery is a validated delivery waiting to be processed.
+type webhookDelivery struct {
+	req  *http.Request
+	body []byte
+}
+
+// webhookQueue processes webhook deliveries asynchronously from a bounded
+// queue with a fixed number of workers. Deliveries are processed by the same
+// handler as synchronous deliveries, with the response discarded.
+type webhookQueue struct {
+	cfg        WebHookAsync
+	deliveries chan webhookDelivery
+	process    func(http.ResponseWriter, *http.Request, []byte)
+	telemetry  *metadata.TelemetryBuilder
+	logger     *zap.Logger
+
+	// mu guards closing the deliveries channel while deliveries are queued.
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func newWebhookQueue(
+	cfg WebHookAsync,
+	settings component.TelemetrySettings,
+	process func(http.ResponseWriter, *http.Request, []byte),
+) (*webhookQueue, error) {
+	telemetry, err := metadata.NewTelemetryBuilder(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookQueue{
+		cfg:        cfg,
+		deliveries: make(chan webhookDelivery, cfg.QueueSize),
+		process:    process,
+		telemetry:  telemetry,
+		logger:     settings.Logger,
+	}, nil
+}
+
+// start starts the workers processing the queued deliveries.
+func (q *webhookQueue) start(ctx context.Context) {
+	q.telemetry.ReceiverWebhookQueueCapacity.Record(ctx, int64(q.cfg.QueueSize))
+
+	for range q.cfg.NumWorkers {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			for d := range q.deliveries {
+				q.telemetry.ReceiverWebhookQueueSize.Add(d.req.Context(), -1)
+
+				w := &discardResponseWriter{header: http.Header{}, status: http.StatusOK}
+				q.process(w, d.req, d.body)
+				switch {
+				case w.status >= http.StatusInternalServerError:
+					q.logger.Warn("failed to process webhook delivery", zap.Int("status_code", w.status))
+				case w.status >= http.StatusBadRequest:
+					q.logger.Debug("webhook delivery was not processed", zap.Int("status_code", w.status))
+				}
+			}
+		}()
+	}
+}
+
+// enqueue queues the delivery and returns the status code it is acknowledged
+// with. When the queue is full, the configured overflow behavior applies.
+func (q *webhookQueue) enqueue(req *http.Request, body []byte) int {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return http.StatusServiceUnavailable
+	}
+
+	// the delivery is processed after the response is written, so it must
+	// not be canceled along with the request.
+	ctx := req.Context()
+	d := webhookDelivery{req: req.WithContext(context.WithoutCancel(ctx)), body: body}
+
+	select {
+	case q.deliveries <- d:
+		q.telemetry.ReceiverWebhookQueueSize.Add(ctx, 1)
+		return http.StatusAccepted
+	default:
+	}
+
+	switch q.cfg.Overflow {
+	case overflowBlock:
+		select {
+		case q.deliveries <- d:
+			q.telemetry.ReceiverWebhookQueueSize.Add(ctx, 1)
+			return http.StatusAccepted
+		case <-ctx.Done():
+			q.telemetry.ReceiverWebhookQueueDropped.Add(ctx, 1)
+			return http.StatusServiceUnavailable
+		}
+	case overflowDrop:
+		q.telemetry.ReceiverWebhookQueueDropped.Add(ctx, 1)
+		q.logger.Warn("webhook queue is full, dropping delivery")
+		return http.StatusAccepted
+	default:
+		q.telemetry.ReceiverWebhookQueueDropped.Add(ctx, 1)
+		return http.StatusTooManyRequests
+	}
+}
+
+// shutdown stops accepting deliveries and waits for the queued deliveries to
+// be processed.
+func (q *webhookQueue) shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.deliveries)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.telemetry.Shutdown()
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to drain webhook queue: %w", ctx.Err())
+	}
+}
+
+// discardResponseWriter is the http.ResponseWriter of deliveries processed
+// from the queue, recording only the status code.
+type discardResponseWriter struct {
+	header http.Header
+	status int
+}
+
+func (w *discardResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (*discardResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *discardResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
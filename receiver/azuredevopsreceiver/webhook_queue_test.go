@@ -0,0 +1,135 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package azuredevopsreceiver
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/azuredevopsreceiver/internal/metadatatest"
+)
+
+// newStageReq creates a delivery of a completed stage signed with the secret.
+func newStageReq(ctx context.Context, t *testing.T, secret string) *http.Request {
+	stage, err := os.ReadFile(filepath.Join("testdata", "example-stage-event.json"))
+	require.NoError(t, err)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(stage)
+
+	req := httptest.NewRequestWithContext(ctx, http.MethodPost, "http://localhost/events", bytes.NewReader(stage))
+	req.Header.Set("X-Hub-Signature-256", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func TestHandleReqAsync(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.Secret = "secret"
+	cfg.WebHook.Async.Enabled = true
+
+	sink := new(consumertest.TracesSink)
+	r, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+	r.queue.start(context.Background())
+
+	// invalid deliveries are still rejected right away
+	w := httptest.NewRecorder()
+	r.handleReq(w, newStageReq(context.Background(), t, "wrong"))
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	w = httptest.NewRecorder()
+	r.handleReq(w, newStageReq(context.Background(), t, "secret"))
+	require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+
+	// shutting down the queue waits for the accepted delivery to be processed
+	require.NoError(t, r.queue.shutdown(context.Background()))
+	require.Equal(t, 1, sink.SpanCount())
+
+	w = httptest.NewRecorder()
+	r.handleReq(w, newStageReq(context.Background(), t, "secret"))
+	require.Equal(t, http.StatusServiceUnavailable, w.Result().StatusCode)
+}
+
+func TestWebhookQueueOverflow(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		overflow       string
+		expectedStatus int
+	}{
+		{
+			overflow:       overflowBlock,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			overflow:       overflowDrop,
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			overflow:       overflowReject,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.overflow, func(t *testing.T) {
+			tel := componenttest.NewTelemetry()
+			defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+			cfg := createDefaultConfig().(*Config)
+			cfg.WebHook.Async = WebHookAsync{Enabled: true, QueueSize: 1, NumWorkers: 1, Overflow: test.overflow}
+
+			sink := new(consumertest.TracesSink)
+			r, err := newTracesReceiver(metadatatest.NewSettings(tel), cfg, sink)
+			require.NoError(t, err)
+
+			// the queue is filled before the workers are started
+			w := httptest.NewRecorder()
+			r.handleReq(w, newStageReq(context.Background(), t, ""))
+			require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+
+			// a blocked delivery gives up once the request is canceled
+			w = httptest.NewRecorder()
+			r.handleReq(w, newStageReq(canceled, t, ""))
+			require.Equal(t, test.expectedStatus, w.Result().StatusCode)
+
+			metadatatest.AssertEqualReceiverWebhookQueueSize(t, tel,
+				[]metricdata.DataPoint[int64]{{Value: 1}},
+				metricdatatest.IgnoreTimestamp())
+			metadatatest.AssertEqualReceiverWebhookQueueDropped(t, tel,
+				[]metricdata.DataPoint[int64]{{Value: 1}},
+				metricdatatest.IgnoreTimestamp())
+
+			r.queue.start(context.Background())
+			require.NoError(t, r.queue.shutdown(context.Background()))
+
+			// only the delivery which fit in the queue is processed
+			require.Equal(t, 1, sink.SpanCount())
+
+			metadatatest.AssertEqualReceiverWebhookQueueCapacity(t, tel,
+				[]metricdata.DataPoint[int64]{{Value: 1}},
+				metricdatatest.IgnoreTimestamp())
+			metadatatest.AssertEqualReceiverWebhookQueueSize(t, tel,
+				[]metricdata.DataPoint[int64]{{Value: 0}},
+				metricdatatest.IgnoreTimestamp())
+		})
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.uber.org/zap"
+)
+
+const (
+	// githubActionsAppSlug is the slug of the GitHub App that creates the check
+	// suites and check runs of GitHub Actions. These are already covered by the
+	// workflow_run and workflow_job events.
+	githubActionsAppSlug = "github-actions"
+
+	// checkRunAnnotationEventName is the name of the span events created from
+	// check run annotations.
+	checkRunAnnotationEventName = "github.check_run.annotation"
+)
+
+// isGitHubActionsApp returns true when the check was created by GitHub
+// Actions.
+func isGitHubActionsApp(app *github.App) bool {
+	return app.GetSlug() == githubActionsAppSlug
+}
+
+// handleCheckSuite handles the creation of the root span for a GitHub Check
+// Suite event. A check suite is created by an external CI system reporting to
+// GitHub through the Checks API and maps to the semantic conventions for a
+// `cicd.pipeline.run`.
+func (gtr *githubTracesReceiver) handleCheckSuite(e *github.CheckSuiteEvent) (ptrace.Traces, error) {
+	t := ptrace.NewTraces()
+	r := t.ResourceSpans().AppendEmpty()
+
+	err := gtr.getCheckSuiteAttrs(r.Resource(), e)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to get check suite attributes: %w", err)
+	}
+
+	suiteID := e.GetCheckSuite().GetID()
+	traceID, err := newCheckSuiteTraceID(suiteID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to generate trace ID", zap.Error(err))
+	}
+
+	err = gtr.createCheckSuiteSpan(r, e, traceID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to create check suite span", zap.Error(err))
+		return ptrace.Traces{}, fmt.Errorf("failed to create check suite span: %w", err)
+	}
+
+	return t, nil
+}
+
+// handleCheckRun handles the creation of a task span for a GitHub Check Run
+// event, parented to the span of its check suite. A check run maps to the
+// semantic conventions for a `cicd.pipeline.task`. Annotations of the check run
+// are added as span events.
+func (gtr *githubTracesReceiver) handleCheckRun(ctx context.Context, e *github.CheckRunEvent) (ptrace.Traces, error) {
+	t := ptrace.NewTraces()
+	r := t.ResourceSpans().AppendEmpty()
+
+	err := gtr.getCheckRunAttrs(r.Resource(), e)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to get check run attributes: %w", err)
+	}
+
+	suiteID := e.GetCheckRun().GetCheckSuite().GetID()
+	traceID, err := newCheckSuiteTraceID(suiteID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to generate trace ID", zap.Error(err))
+	}
+
+	err = gtr.createCheckRunSpan(ctx, r, e, traceID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to create check run span", zap.Error(err))
+		return ptrace.Traces{}, fmt.Errorf("failed to create check run span: %w", err)
+	}
+
+	return t, nil
+}
+
+// getCheckSuiteAttrs sets the resource attributes for the Check Suite GitHub
+// event type and returns an error if one occurs.
+func (gtr *githubTracesReceiver) getCheckSuiteAttrs(resource pcommon.Resource, e *github.CheckSuiteEvent) error {
+	repo := e.GetRepo()
+	err := gtr.getRepositoryAttrs(resource, repo.CustomProperties["service_name"], repo.GetName(), repo.GetHTMLURL(), repo.GetOwner().GetLogin())
+
+	suite := e.GetCheckSuite()
+	attrs := resource.Attributes()
+
+	// VCS Attributes
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), suite.GetHeadBranch())
+	attrs.PutStr(string(semconv.VCSRefHeadTypeKey), AttributeVCSRefHeadTypeBranch)
+	attrs.PutStr(string(semconv.VCSRefHeadRevisionKey), suite.GetHeadSHA())
+
+	// CICD Attributes
+	attrs.PutStr(string(semconv.CICDPipelineNameKey), suite.GetApp().GetName())
+	attrs.PutStr(string(AttributeCICDPipelineRunSenderLoginKey), e.GetSender().GetLogin())
+	attrs.PutInt(string(semconv.CICDPipelineRunIDKey), suite.GetID())
+	if conclusion := strings.ToLower(suite.GetConclusion()); conclusion != "" {
+		attrs.PutStr(string(AttributeCICDPipelineRunStatusKey), conclusion)
+	}
+
+	return err
+}
+
+// getCheckRunAttrs sets the resource attributes for the Check Run GitHub event
+// type and returns an error if one occurs.
+func (gtr *githubTracesReceiver) getCheckRunAttrs(resource pcommon.Resource, e *github.CheckRunEvent) error {
+	repo := e.GetRepo()
+	err := gtr.getRepositoryAttrs(resource, repo.CustomProperties["service_name"], repo.GetName(), repo.GetHTMLURL(), repo.GetOwner().GetLogin())
+
+	run := e.GetCheckRun()
+	attrs := resource.Attributes()
+
+	// VCS Attributes
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), run.GetCheckSuite().GetHeadBranch())
+	attrs.PutStr(string(semconv.VCSRefHeadTypeKey), AttributeVCSRefHeadTypeBranch)
+	attrs.PutStr(string(semconv.VCSRefHeadRevisionKey), run.GetHeadSHA())
+
+	// CICD Attributes
+	attrs.PutStr(string(semconv.CICDPipelineNameKey), run.GetApp().GetName())
+	attrs.PutStr(string(semconv.CICDPipelineTaskNameKey), run.GetName())
+	attrs.PutStr(string(AttributeCICDPipelineTaskRunSenderLoginKey), e.GetSender().GetLogin())
+	attrs.PutStr(string(semconv.CICDPipelineTaskRunURLFullKey), run.GetHTMLURL())
+	attrs.PutInt(string(semconv.CICDPipelineTaskRunIDKey), run.GetID())
+	if conclusion := strings.ToLower(run.GetConclusion()); conclusion != "" {
+		attrs.PutStr(string(AttributeCICDPipelineTaskRunStatusKey), conclusion)
+	}
+
+	return err
+}
+
+// createCheckSuiteSpan creates the root span of a check suite, associated with
+// the deterministic traceID.
+func (gtr *githubTracesReceiver) createCheckSuiteSpan(
+	resourceSpans ptrace.ResourceSpans,
+	event *github.CheckSuiteEvent,
+	traceID pcommon.TraceID,
+) error {
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	span := scopeSpans.Spans().AppendEmpty()
+
+	suite := event.GetCheckSuite()
+
+	rootSpanID, err := newCheckSuiteSpanID(suite.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to generate root span ID: %w", err)
+	}
+
+	span.SetTraceID(traceID)
+	span.SetSpanID(rootSpanID)
+	span.SetName(suite.GetApp().GetName())
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(suite.GetCreatedAt().Time))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(suite.GetUpdatedAt().Time))
+
+	setCheckSpanStatus(span, suite.GetConclusion())
+
+	return nil
+}
+
+// createCheckRunSpan creates the task span of a check run parented to the
+// span of its check suite.
+func (gtr *githubTracesReceiver) createCheckRunSpan(
+	ctx context.Context,
+	resourceSpans ptrace.ResourceSpans,
+	event *github.CheckRunEvent,
+	traceID pcommon.TraceID,
+) error {
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	span := scopeSpans.Spans().AppendEmpty()
+
+	run := event.GetCheckRun()
+	suiteID := run.GetCheckSuite().GetID()
+
+	parentSpanID, err := newCheckSuiteSpanID(suiteID)
+	if err != nil {
+		return fmt.Errorf("failed to generate parent span ID: %w", err)
+	}
+
+	spanID, err := newCheckRunSpanID(suiteID, run.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to generate check run span ID: %w", err)
+	}
+
+	span.SetTraceID(traceID)
+	span.SetParentSpanID(parentSpanID)
+	span.SetSpanID(spanID)
+	span.SetName(run.GetName())
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(run.GetStartedAt().Time))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(run.GetCompletedAt().Time))
+
+	setCheckSpanStatus(span, run.GetConclusion())
+
+	for _, annotation := range gtr.getCheckRunAnnotations(ctx, event) {
+		spanEvent := span.Events().AppendEmpty()
+		spanEvent.SetName(checkRunAnnotationEventName)
+		spanEvent.SetTimestamp(pcommon.NewTimestampFromTime(run.GetCompletedAt().Time))
+
+		attrs := spanEvent.Attributes()
+		attrs.PutStr(string(semconv.CodeFilePathKey), annotation.GetPath())
+		attrs.PutInt(string(semconv.CodeLineNumberKey), int64(annotation.GetStartLine()))
+		attrs.PutStr(string(AttributeGitHubCheckRunAnnotationLevelKey), annotation.GetAnnotationLevel())
+		attrs.PutStr(string(AttributeGitHubCheckRunAnnotationTitleKey), annotation.GetTitle())
+		attrs.PutStr(string(AttributeGitHubCheckRunAnnotationMessageKey), annotation.GetMessage())
+	}
+
+	return nil
+}
+
+// getCheckRunAnnotations returns the annotations of a check run. Webhook
+// payloads only include the number of annotations, so they are retrieved from
+// the GitHub API when a client is configured.
+func (gtr *githubTracesReceiver) getCheckRunAnnotations(ctx context.Context, event *github.CheckRunEvent) []*github.CheckRunAnnotation {
+	output := event.GetCheckRun().GetOutput()
+	if len(output.Annotations) > 0 || output.GetAnnotationsCount() == 0 || gtr.ghClient == nil {
+		return output.Annotations
+	}
+
+	var all []*github.CheckRunAnnotation
+	opt := &github.ListOptions{PerPage: 100}
+	for {
+		annotations, resp, err := gtr.ghClient.Checks.ListCheckRunAnnotations(
+			ctx,
+			event.GetRepo().GetOwner().GetLogin(),
+			event.GetRepo().GetName(),
+			event.GetCheckRun().GetID(),
+			opt,
+		)
+		if err != nil {
+			gtr.logger.Sugar().Errorf("failed to get check run annotations", zap.Error(err))
+			return all
+		}
+
+		all = append(all, annotations...)
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return all
+}
+
+// setCheckSpanStatus sets the status of a check suite or check run span based
+// on its conclusion.
+func setCheckSpanStatus(span ptrace.Span, conclusion string) {
+	switch strings.ToLower(conclusion) {
+	case "success":
+		span.Status().SetCode(ptrace.StatusCodeOk)
+	case "failure", "timed_out":
+		span.Status().SetCode(ptrace.StatusCodeError)
+	default:
+		span.Status().SetCode(ptrace.StatusCodeUnset)
+	}
+
+	span.Status().SetMessage(conclusion)
+}
+
+// newCheckSuiteTraceID creates a deterministic Trace ID based on the provided
+// checkSuiteID. The `checksuite` prefix differentiates the input from the
+// workflow run based IDs and `t` is appended to the end of the input to
+// differentiate between the traceID and the root spanID. GitHub reuses the ID
+// of a check suite when it is rerequested and the payload does not identify
+// the attempt, so a rerequested check suite shares the trace of its first
+// attempt.
+func newCheckSuiteTraceID(checkSuiteID int64) (pcommon.TraceID, error) {
+	input := fmt.Sprintf("checksuite%dt", checkSuiteID)
+	return newTraceIDFromInput(input)
+}
+
+// newCheckSuiteSpanID creates a deterministic root Span ID based on the
+// provided checkSuiteID. `s` is appended to the end of the input to
+// differentiate between the traceID and the root spanID.
+func newCheckSuiteSpanID(checkSuiteID int64) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("checksuite%ds", checkSuiteID)
+	return newSpanID(input)
+}
+
+// newCheckRunSpanID creates a deterministic Check Run Span ID based on the
+// provided checkSuiteID and checkRunID. The check runs of a rerequested check
+// suite are created anew, so their IDs differ from the ones of the first
+// attempt.
+func newCheckRunSpanID(checkSuiteID int64, checkRunID int64) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("checksuite%drun%d", checkSuiteID, checkRunID)
+	return newSpanID(input)
+}
@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+)
+
+func TestHandleCheckSuiteWithGoldenFile(t *testing.T) {
+	defaultConfig := createDefaultConfig().(*Config)
+	defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+	consumer := consumertest.NewNop()
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumer)
+	require.NoError(t, err, "failed to create receiver")
+
+	data, err := os.ReadFile(filepath.Join("testdata", "check-suite-completed.json"))
+	require.NoError(t, err, "Failed to read test data file")
+
+	var event github.CheckSuiteEvent
+	err = json.Unmarshal(data, &event)
+	require.NoError(t, err, "Failed to unmarshal check suite event")
+
+	traces, err := receiver.handleCheckSuite(&event)
+	require.NoError(t, err, "Failed to handle check suite event")
+
+	expectedFile := filepath.Join("testdata", "check-suite-expected.yaml")
+
+	// Uncomment the following line to update the golden file
+	// golden.WriteTraces(t, expectedFile, traces)
+
+	expectedTraces, err := golden.ReadTraces(expectedFile)
+	require.NoError(t, err, "Failed to read expected traces")
+
+	require.NoError(t, ptracetest.CompareTraces(expectedTraces, traces))
+}
+
+func TestHandleCheckRunWithGoldenFile(t *testing.T) {
+	annotations, err := os.ReadFile(filepath.Join("testdata", "check-run-annotations.json"))
+	require.NoError(t, err, "Failed to read test data file")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/repos/liatrio/otel-testing/check-runs/35563904128/annotations" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(annotations)
+	}))
+	defer server.Close()
+
+	defaultConfig := createDefaultConfig().(*Config)
+	defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+	defaultConfig.WebHook.Client = &confighttp.ClientConfig{Endpoint: server.URL}
+	consumer := consumertest.NewNop()
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumer)
+	require.NoError(t, err, "failed to create receiver")
+
+	receiver.ghClient, err = receiver.newGitHubClient(context.Background(), nil)
+	require.NoError(t, err, "failed to create github client")
+
+	data, err := os.ReadFile(filepath.Join("testdata", "check-run-completed.json"))
+	require.NoError(t, err, "Failed to read test data file")
+
+	var event github.CheckRunEvent
+	err = json.Unmarshal(data, &event)
+	require.NoError(t, err, "Failed to unmarshal check run event")
+
+	traces, err := receiver.handleCheckRun(context.Background(), &event)
+	require.NoError(t, err, "Failed to handle check run event")
+
+	expectedFile := filepath.Join("testdata", "check-run-expected.yaml")
+
+	// Uncomment the following line to update the golden file
+	// golden.WriteTraces(t, expectedFile, traces)
+
+	expectedTraces, err := golden.ReadTraces(expectedFile)
+	require.NoError(t, err, "Failed to read expected traces")
+
+	require.NoError(t, ptracetest.CompareTraces(expectedTraces, traces))
+}
+
+// TestCheckRunSpanParentsToCheckSuiteSpan ensures a check run span is parented
+// to the span of the check suite it belongs to.
+func TestCheckRunSpanParentsToCheckSuiteSpan(t *testing.T) {
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), consumertest.NewNop())
+	require.NoError(t, err)
+
+	var suite github.CheckSuiteEvent
+	data, err := os.ReadFile(filepath.Join("testdata", "check-suite-completed.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &suite))
+
+	var run github.CheckRunEvent
+	data, err = os.ReadFile(filepath.Join("testdata", "check-run-completed.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &run))
+
+	suiteTraces, err := receiver.handleCheckSuite(&suite)
+	require.NoError(t, err)
+	runTraces, err := receiver.handleCheckRun(context.Background(), &run)
+	require.NoError(t, err)
+
+	suiteSpan := suiteTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	runSpan := runTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+
+	require.Equal(t, suiteSpan.TraceID(), runSpan.TraceID())
+	require.Equal(t, suiteSpan.SpanID(), runSpan.ParentSpanID())
+
+	// without a client the annotations are not retrieved
+	require.Equal(t, 0, runSpan.Events().Len())
+}
+
+// TestRerequestedCheckSuite ensures a rerequested check suite shares the trace
+// of its first attempt, with the check runs created for the rerequest parented
+// to the same check suite span without colliding with the previous ones.
+func TestRerequestedCheckSuite(t *testing.T) {
+	sink := new(consumertest.TracesSink)
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), sink)
+	require.NoError(t, err)
+
+	suite, err := os.ReadFile(filepath.Join("testdata", "check-suite-completed.json"))
+	require.NoError(t, err)
+	run, err := os.ReadFile(filepath.Join("testdata", "check-run-completed.json"))
+	require.NoError(t, err)
+	rerequested := bytes.Replace(suite, []byte(`"action": "completed"`), []byte(`"action": "rerequested"`), 1)
+	rerequested = bytes.Replace(rerequested, []byte(`"status": "completed"`), []byte(`"status": "queued"`), 1)
+	rerun := bytes.Replace(run, []byte(`"id": 35563904128`), []byte(`"id": 35563904129`), 1)
+
+	deliveries := []struct {
+		event string
+		body  []byte
+	}{
+		{event: "check_run", body: run},
+		{event: "check_suite", body: suite},
+		{event: "check_suite", body: rerequested},
+		{event: "check_run", body: rerun},
+		{event: "check_suite", body: suite},
+	}
+	for _, d := range deliveries {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(d.body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(github.EventTypeHeader, d.event)
+		receiver.handleReq(httptest.NewRecorder(), req)
+	}
+
+	traces := sink.AllTraces()
+	require.Len(t, traces, 4)
+	span := func(i int) ptrace.Span {
+		return traces[i].ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	}
+
+	// the IDs only depend on the payloads, so every replica traces the
+	// rerequest the same way
+	firstRun, firstSuite, secondRun, secondSuite := span(0), span(1), span(2), span(3)
+	require.Equal(t, firstSuite.TraceID(), secondSuite.TraceID())
+	require.Equal(t, firstSuite.SpanID(), secondSuite.SpanID())
+	require.Equal(t, firstSuite.TraceID(), secondRun.TraceID())
+	require.Equal(t, firstSuite.SpanID(), firstRun.ParentSpanID())
+	require.Equal(t, firstSuite.SpanID(), secondRun.ParentSpanID())
+	require.NotEqual(t, firstRun.SpanID(), secondRun.SpanID())
+}
+
+func TestHandleCheckReq(t *testing.T) {
+	suite, err := os.ReadFile(filepath.Join("testdata", "check-suite-completed.json"))
+	require.NoError(t, err)
+	run, err := os.ReadFile(filepath.Join("testdata", "check-run-completed.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc           string
+		event          string
+		body           []byte
+		expectedStatus int
+		expectedSpans  int
+	}{
+		{
+			desc:           "check suite completed",
+			event:          "check_suite",
+			body:           suite,
+			expectedStatus: http.StatusOK,
+			expectedSpans:  1,
+		},
+		{
+			desc:           "check suite not complete is skipped",
+			event:          "check_suite",
+			body:           bytes.Replace(suite, []byte(`"status": "completed"`), []byte(`"status": "in_progress"`), 1),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			desc:           "check suite created by github actions is skipped",
+			event:          "check_suite",
+			body:           bytes.Replace(suite, []byte(`"slug": "circleci-checks"`), []byte(`"slug": "github-actions"`), 1),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			desc:           "check run completed",
+			event:          "check_run",
+			body:           run,
+			expectedStatus: http.StatusOK,
+			expectedSpans:  1,
+		},
+		{
+			desc:           "check run created by github actions is skipped",
+			event:          "check_run",
+			body:           bytes.ReplaceAll(run, []byte(`"slug": "circleci-checks"`), []byte(`"slug": "github-actions"`)),
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sink := new(consumertest.TracesSink)
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), sink)
+			require.NoError(t, err)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(github.EventTypeHeader, test.event)
+
+			w := httptest.NewRecorder()
+			receiver.handleReq(w, req)
+
+			require.Equal(t, test.expectedStatus, w.Result().StatusCode)
+			require.Equal(t, test.expectedSpans, sink.SpanCount())
+		})
+	}
+}
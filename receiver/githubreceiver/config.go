@@ -6,6 +6,9 @@ package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/rece
 import (
 	"errors"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -17,6 +20,9 @@ import (
 
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubdeploymentscraper"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubrunnerscraper"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubscraper"
 )
 
 const (
@@ -35,6 +41,7 @@ type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	Scrapers                       map[string]internal.Config `mapstructure:"scrapers"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	internal.ServerConfig          `mapstructure:",squash"`
 	WebHook                        WebHook `mapstructure:"webhook"`
 }
 
@@ -47,6 +54,166 @@ type WebHook struct {
 	GitHubHeaders           GitHubHeaders                  `mapstructure:",squash"`          // GitLab headers set by default
 	Secret                  string                         `mapstructure:"secret"`           // secret for webhook
 	ServiceName             string                         `mapstructure:"service_name"`
+	Client                  *confighttp.ClientConfig       `mapstructure:"client"`       // optional GitHub API client for data not included in webhook payloads (ie. check run annotations)
+	Metrics                 WebHookMetrics                 `mapstructure:"metrics"`      // metrics derived from webhook events
+	Dedup                   WebHookDedup                   `mapstructure:"dedup"`        // deduplication of webhook deliveries
+	Reconciler              WebHookReconciler              `mapstructure:"reconciler"`   // recovery of failed webhook deliveries
+	Async                   WebHookAsync                   `mapstructure:"async"`        // asynchronous processing of webhook deliveries
+	JobLogs                 WebHookJobLogs                 `mapstructure:"job_logs"`     // logs of completed workflow jobs
+	TestResults             WebHookTestResults             `mapstructure:"test_results"` // JUnit test results uploaded as workflow run artifacts
+	Poller                  WebHookPoller                  `mapstructure:"poller"`       // polling of workflow runs for repositories without webhooks
+}
+
+// WebHookPoller configures polling the GitHub API for completed workflow runs
+// and their jobs, for organizations where webhooks cannot be registered. The
+// polled runs and jobs are processed the same way as the workflow_run and
+// workflow_job webhook events. The webhook `client` must be configured.
+type WebHookPoller struct {
+	// Enabled polls the workflow runs API for completed workflow runs.
+	// Default is false.
+	Enabled bool `mapstructure:"enabled"`
+	// Owner is the organization, or the user, owning the repositories.
+	Owner string `mapstructure:"owner"`
+	// Repositories are the names of the repositories polled. When empty, all
+	// the repositories of the organization are polled.
+	Repositories []string `mapstructure:"repositories"`
+	// Interval is how often workflow runs are polled for. Default is 5m.
+	Interval time.Duration `mapstructure:"interval"`
+	// Lookback is how far back completed workflow runs are backfilled for a
+	// repository without a persisted checkpoint. Default is 24h.
+	Lookback time.Duration `mapstructure:"lookback"`
+	// Storage is the optional ID of a storage extension used to persist the
+	// checkpoint of the last poll of each repository across restarts.
+	Storage *component.ID `mapstructure:"storage"`
+}
+
+// WebHookTestResults configures downloading the JUnit XML reports uploaded as
+// artifacts of completed workflow runs from the GitHub API and emitting their
+// test suites and test cases as spans under the trace of the run. The webhook
+// `client` must be configured.
+type WebHookTestResults struct {
+	// Enabled downloads the test results of completed workflow runs. Default
+	// is false.
+	Enabled bool `mapstructure:"enabled"`
+	// ArtifactPattern is the glob pattern the names of the artifacts
+	// containing JUnit XML reports must match. Default is `*junit*`.
+	ArtifactPattern string `mapstructure:"artifact_pattern"`
+	// MaxSize is the maximum number of bytes of an artifact downloaded.
+	// Larger artifacts are skipped. Default is 10MiB.
+	MaxSize int64 `mapstructure:"max_size"`
+	// MaxUncompressedSize is the maximum number of bytes of the reports read
+	// from the artifacts of a workflow run attempt once uncompressed. Default
+	// is 50MiB.
+	MaxUncompressedSize int64 `mapstructure:"max_uncompressed_size"`
+	// MaxFiles is the maximum number of reports read from the artifacts of a
+	// workflow run attempt. Default is 100.
+	MaxFiles int `mapstructure:"max_files"`
+}
+
+// WebHookJobLogs configures downloading the logs of completed workflow jobs
+// from the GitHub API and emitting them as log records correlated with the
+// spans of their steps. The webhook `client` must be configured.
+type WebHookJobLogs struct {
+	// Enabled downloads the logs of completed workflow jobs. Default is false.
+	Enabled bool `mapstructure:"enabled"`
+	// Conclusions are the conclusions of the workflow jobs whose logs are
+	// downloaded. An empty list downloads the logs of all workflow jobs.
+	// Default is [failure].
+	Conclusions []string `mapstructure:"conclusions"`
+	// MaxSize is the maximum number of bytes of a job log downloaded. The
+	// rest of longer logs is discarded. Default is 1MiB.
+	MaxSize int64 `mapstructure:"max_size"`
+	// Redact are regular expressions whose matches are masked in the log
+	// lines, in addition to the secrets masked by GitHub.
+	Redact []string `mapstructure:"redact"`
+}
+
+// WebHookAsync configures processing deliveries asynchronously. Validated
+// deliveries are acknowledged with a 202 right away and processed from a
+// bounded queue by a pool of workers, so a slow pipeline does not make GitHub
+// time out.
+type WebHookAsync struct {
+	Enabled    bool `mapstructure:"enabled"`
+	QueueSize  int  `mapstructure:"queue_size"`  // maximum number of deliveries waiting to be processed
+	NumWorkers int  `mapstructure:"num_workers"` // number of deliveries processed concurrently
+	// Overflow is the behavior when the queue is full: block until there is
+	// room, drop the delivery, or reject it with a 429.
+	Overflow string `mapstructure:"overflow"`
+}
+
+// WebHookReconciler configures the recovery of webhook deliveries which failed,
+// such as while the collector was unavailable, using the GitHub hook
+// deliveries API. The webhook `client` must be configured.
+type WebHookReconciler struct {
+	// Enabled polls the hook deliveries API for failed deliveries. Default is
+	// false.
+	Enabled bool `mapstructure:"enabled"`
+	// Owner is the organization, or the owner of the repository, the webhook
+	// is configured on.
+	Owner string `mapstructure:"owner"`
+	// Repository is the name of the repository the webhook is configured on.
+	// When empty, the webhook is an organization webhook.
+	Repository string `mapstructure:"repository"`
+	// HookID is the ID of the webhook.
+	HookID int64 `mapstructure:"hook_id"`
+	// Interval is how often failed deliveries are polled for. Default is 5m.
+	Interval time.Duration `mapstructure:"interval"`
+	// Lookback is how far back failed deliveries are looked for when no
+	// checkpoint has been persisted. Default is 1h.
+	Lookback time.Duration `mapstructure:"lookback"`
+	// MaxAttempts is how many times a failed delivery is redelivered or
+	// processed before giving up on it, so a delivery which keeps failing
+	// does not hold back the checkpoint. Default is 5.
+	MaxAttempts int `mapstructure:"max_attempts"`
+	// Mode is either `redeliver`, to ask GitHub to redeliver the failed
+	// deliveries, or `process`, to fetch and process their payload directly.
+	// Default is redeliver.
+	Mode string `mapstructure:"mode"`
+	// Storage is the optional ID of a storage extension used to persist the
+	// checkpoint of the last poll across restarts.
+	Storage *component.ID `mapstructure:"storage"`
+}
+
+// WebHookDedup configures the deduplication of webhook deliveries retried or
+// redelivered by GitHub, based on the X-GitHub-Delivery header.
+type WebHookDedup struct {
+	// CacheSize is the number of most recent delivery IDs remembered. A size
+	// of 0 disables deduplication. Default is 10000.
+	CacheSize int `mapstructure:"cache_size"`
+	// Storage is the optional ID of a storage extension used to persist the
+	// delivery IDs across restarts.
+	Storage *component.ID `mapstructure:"storage"`
+}
+
+// WebHookMetrics configures the metrics derived from workflow_run and
+// workflow_job webhook events.
+type WebHookMetrics struct {
+	// Enabled emits the webhook metrics to the metrics pipeline alongside the
+	// scraped metrics. Default is false.
+	Enabled bool `mapstructure:"enabled"`
+	// HistogramBuckets are the explicit bucket boundaries, in seconds, of the
+	// duration histograms.
+	HistogramBuckets []float64 `mapstructure:"histogram_buckets"`
+	// Histograms enable or disable each duration histogram. The other
+	// webhook metrics are enabled or disabled with the `metrics` setting of
+	// the receiver, but mdatagen does not generate histograms.
+	Histograms WebHookHistograms `mapstructure:"histograms"`
+	// JobStateTTL is how long a queued or in progress workflow job is tracked
+	// without receiving another event for it. Default is 24h.
+	JobStateTTL time.Duration `mapstructure:"job_state_ttl"`
+}
+
+// WebHookHistograms configures the duration histograms derived from
+// workflow_run and workflow_job webhook events.
+type WebHookHistograms struct {
+	PipelineRunDuration          HistogramConfig `mapstructure:"cicd.pipeline.run.duration"`
+	PipelineTaskRunDuration      HistogramConfig `mapstructure:"cicd.pipeline.task.run.duration"`
+	PipelineTaskRunQueueDuration HistogramConfig `mapstructure:"cicd.pipeline.task.run.queue.duration"`
+}
+
+// HistogramConfig configures a histogram derived from webhook events.
+type HistogramConfig struct {
+	Enabled bool `mapstructure:"enabled"`
 }
 
 type GitHubHeaders struct {
@@ -63,6 +230,29 @@ var (
 	errWriteTimeoutExceedsMaxValue = errors.New("the duration specified for write_timeout exceeds the maximum allowed value of 10s")
 	errRequiredHeader              = errors.New("both key and value are required to assign a required_header")
 	errRequireOneScraper           = errors.New("must specify at least one scraper")
+	errHistogramBuckets            = errors.New("webhook metrics histogram_buckets must be sorted in increasing order")
+	errJobStateTTL                 = errors.New("webhook metrics job_state_ttl must be greater than 0")
+	errDedupCacheSize              = errors.New("webhook dedup cache_size must not be negative")
+	errReconcilerClient            = errors.New("webhook reconciler requires the webhook client to be configured")
+	errReconcilerHook              = errors.New("webhook reconciler requires an owner and hook_id")
+	errReconcilerInterval          = errors.New("webhook reconciler interval must be greater than 0")
+	errReconcilerMode              = errors.New("webhook reconciler mode must be one of [redeliver, process]")
+	errReconcilerMaxAttempts       = errors.New("webhook reconciler max_attempts must be greater than 0")
+	errAsyncQueueSize              = errors.New("webhook async queue_size must be greater than 0")
+	errAsyncNumWorkers             = errors.New("webhook async num_workers must be greater than 0")
+	errAsyncOverflow               = errors.New("webhook async overflow must be one of [block, drop, reject]")
+	errJobLogsClient               = errors.New("webhook job_logs requires the webhook client to be configured")
+	errJobLogsMaxSize              = errors.New("webhook job_logs max_size must be greater than 0")
+	errJobLogsRedact               = errors.New("webhook job_logs redact must be valid regular expressions")
+	errTestResultsClient           = errors.New("webhook test_results requires the webhook client to be configured")
+	errTestResultsPattern          = errors.New("webhook test_results artifact_pattern must be a valid glob pattern")
+	errTestResultsMaxSize          = errors.New("webhook test_results max_size must be greater than 0")
+	errTestResultsMaxUncompressed  = errors.New("webhook test_results max_uncompressed_size must be greater than 0")
+	errTestResultsMaxFiles         = errors.New("webhook test_results max_files must be greater than 0")
+	errPollerClient                = errors.New("webhook poller requires the webhook client to be configured")
+	errPollerOwner                 = errors.New("webhook poller requires an owner")
+	errPollerInterval              = errors.New("webhook poller interval must be greater than 0")
+	errPollerLookback              = errors.New("webhook poller lookback must not be negative")
 	errGitHubHeader                = errors.New("github default headers [X-GitHub-Event, X-GitHub-Delivery, X-GitHub-Hook-ID, X-Hub-Signature-256] cannot be configured")
 )
 
@@ -96,6 +286,38 @@ func (cfg *Config) Validate() error {
 		errs = multierr.Append(errs, errWriteTimeoutExceedsMaxValue)
 	}
 
+	if !slices.IsSorted(cfg.WebHook.Metrics.HistogramBuckets) {
+		errs = multierr.Append(errs, errHistogramBuckets)
+	}
+
+	if cfg.WebHook.Metrics.Enabled && cfg.WebHook.Metrics.JobStateTTL <= 0 {
+		errs = multierr.Append(errs, errJobStateTTL)
+	}
+
+	if cfg.WebHook.Dedup.CacheSize < 0 {
+		errs = multierr.Append(errs, errDedupCacheSize)
+	}
+
+	if cfg.WebHook.Reconciler.Enabled {
+		errs = multierr.Append(errs, cfg.WebHook.Reconciler.validate(cfg.WebHook.Client))
+	}
+
+	if cfg.WebHook.Async.Enabled {
+		errs = multierr.Append(errs, cfg.WebHook.Async.validate())
+	}
+
+	if cfg.WebHook.JobLogs.Enabled {
+		errs = multierr.Append(errs, cfg.WebHook.JobLogs.validate(cfg.WebHook.Client))
+	}
+
+	if cfg.WebHook.TestResults.Enabled {
+		errs = multierr.Append(errs, cfg.WebHook.TestResults.validate(cfg.WebHook.Client))
+	}
+
+	if cfg.WebHook.Poller.Enabled {
+		errs = multierr.Append(errs, cfg.WebHook.Poller.validate(cfg.WebHook.Client))
+	}
+
 	for key, value := range cfg.WebHook.RequiredHeaders {
 		if key == "" || value == "" {
 			errs = multierr.Append(errs, errRequiredHeader)
@@ -147,8 +369,132 @@ func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
 			return fmt.Errorf("error reading settings for scraper type %q: %w", key, err)
 		}
 
+		// the scrapers talk to the same GitHub server as the webhook
+		switch scraperCfg := collectorCfg.(type) {
+		case *githubscraper.Config:
+			scraperCfg.Server = cfg.ServerConfig
+		case *githubrunnerscraper.Config:
+			scraperCfg.Server = cfg.ServerConfig
+		case *githubdeploymentscraper.Config:
+			scraperCfg.Server = cfg.ServerConfig
+		}
+
 		cfg.Scrapers[key] = collectorCfg
 	}
 
 	return nil
 }
+
+func (cfg *WebHookReconciler) validate(client *confighttp.ClientConfig) error {
+	var errs error
+
+	if client == nil {
+		errs = multierr.Append(errs, errReconcilerClient)
+	}
+
+	if cfg.Owner == "" || cfg.HookID <= 0 {
+		errs = multierr.Append(errs, errReconcilerHook)
+	}
+
+	if cfg.Interval <= 0 {
+		errs = multierr.Append(errs, errReconcilerInterval)
+	}
+
+	if cfg.Mode != reconcileModeRedeliver && cfg.Mode != reconcileModeProcess {
+		errs = multierr.Append(errs, errReconcilerMode)
+	}
+
+	if cfg.MaxAttempts <= 0 {
+		errs = multierr.Append(errs, errReconcilerMaxAttempts)
+	}
+
+	return errs
+}
+
+func (cfg *WebHookAsync) validate() error {
+	var errs error
+
+	if cfg.QueueSize <= 0 {
+		errs = multierr.Append(errs, errAsyncQueueSize)
+	}
+
+	if cfg.NumWorkers <= 0 {
+		errs = multierr.Append(errs, errAsyncNumWorkers)
+	}
+
+	switch cfg.Overflow {
+	case overflowBlock, overflowDrop, overflowReject:
+	default:
+		errs = multierr.Append(errs, errAsyncOverflow)
+	}
+
+	return errs
+}
+
+func (cfg *WebHookJobLogs) validate(client *confighttp.ClientConfig) error {
+	var errs error
+
+	if client == nil {
+		errs = multierr.Append(errs, errJobLogsClient)
+	}
+
+	if cfg.MaxSize <= 0 {
+		errs = multierr.Append(errs, errJobLogsMaxSize)
+	}
+
+	for _, expr := range cfg.Redact {
+		if _, err := regexp.Compile(expr); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("%w: %w", errJobLogsRedact, err))
+		}
+	}
+
+	return errs
+}
+
+func (cfg *WebHookTestResults) validate(client *confighttp.ClientConfig) error {
+	var errs error
+
+	if client == nil {
+		errs = multierr.Append(errs, errTestResultsClient)
+	}
+
+	if _, err := path.Match(cfg.ArtifactPattern, ""); err != nil {
+		errs = multierr.Append(errs, fmt.Errorf("%w: %w", errTestResultsPattern, err))
+	}
+
+	if cfg.MaxSize <= 0 {
+		errs = multierr.Append(errs, errTestResultsMaxSize)
+	}
+
+	if cfg.MaxUncompressedSize <= 0 {
+		errs = multierr.Append(errs, errTestResultsMaxUncompressed)
+	}
+
+	if cfg.MaxFiles <= 0 {
+		errs = multierr.Append(errs, errTestResultsMaxFiles)
+	}
+
+	return errs
+}
+
+func (cfg *WebHookPoller) validate(client *confighttp.ClientConfig) error {
+	var errs error
+
+	if client == nil {
+		errs = multierr.Append(errs, errPollerClient)
+	}
+
+	if cfg.Owner == "" {
+		errs = multierr.Append(errs, errPollerOwner)
+	}
+
+	if cfg.Interval <= 0 {
+		errs = multierr.Append(errs, errPollerInterval)
+	}
+
+	if cfg.Lookback < 0 {
+		errs = multierr.Append(errs, errPollerLookback)
+	}
+
+	return errs
+}
@@ -20,6 +20,8 @@ import (
 
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubdeploymentscraper"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubrunnerscraper"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubscraper"
 )
 
@@ -68,19 +70,80 @@ func TestLoadConfig(t *testing.T) {
 				"X-Hub-Signature-256": "",
 			},
 		},
+		Metrics: WebHookMetrics{
+			HistogramBuckets: defaultHistogramBuckets,
+			Histograms: WebHookHistograms{
+				PipelineRunDuration:          HistogramConfig{Enabled: true},
+				PipelineTaskRunDuration:      HistogramConfig{Enabled: true},
+				PipelineTaskRunQueueDuration: HistogramConfig{Enabled: true},
+			},
+			JobStateTTL: defaultJobStateTTL,
+		},
+		Dedup: WebHookDedup{
+			CacheSize: defaultDedupCacheSize,
+		},
+		Reconciler: WebHookReconciler{
+			Interval:    defaultReconcileInterval,
+			Lookback:    defaultReconcileLookback,
+			MaxAttempts: defaultReconcileMaxAttempts,
+			Mode:        reconcileModeRedeliver,
+		},
+		Async: WebHookAsync{
+			QueueSize:  defaultAsyncQueueSize,
+			NumWorkers: defaultAsyncNumWorkers,
+			Overflow:   overflowBlock,
+		},
+		JobLogs: WebHookJobLogs{
+			Conclusions: defaultJobLogsConclusions,
+			MaxSize:     defaultJobLogsMaxSize,
+		},
+		TestResults: WebHookTestResults{
+			ArtifactPattern:     defaultTestResultsArtifactPattern,
+			MaxSize:             defaultTestResultsMaxSize,
+			MaxUncompressedSize: defaultTestResultsMaxUncompressedSize,
+			MaxFiles:            defaultTestResultsMaxFiles,
+		},
+		Poller: WebHookPoller{
+			Interval: defaultPollInterval,
+			Lookback: defaultPollLookback,
+		},
 	}
 
 	assert.Equal(t, defaultConfigGitHubReceiver, r0)
 
 	r1 := cfg.Receivers[component.NewIDWithName(metadata.Type, "customname")].(*Config)
+	storageID := component.MustNewID("file_storage")
+	server := internal.ServerConfig{
+		APIURL: "https://github.example.com/api/v3",
+		WebURL: "https://github.example.com",
+	}
+	scraperConfig := (&githubscraper.Factory{}).CreateDefaultConfig().(*githubscraper.Config)
+	scraperConfig.Server = server
+	scraperConfig.GitHubOrg = "liatrio"
+	scraperConfig.Organizations = []githubscraper.OrganizationConfig{
+		{Name: "open-telemetry", SearchQuery: "org:open-telemetry topic:cicd"},
+		{Name: "octocat"},
+	}
+	runnerScraperConfig := (&githubrunnerscraper.Factory{}).CreateDefaultConfig().(*githubrunnerscraper.Config)
+	runnerScraperConfig.Server = server
+	runnerScraperConfig.GitHubOrg = "liatrio"
+	runnerScraperConfig.Repositories = []string{"otel-testing"}
+	deploymentScraperConfig := (&githubdeploymentscraper.Factory{}).CreateDefaultConfig().(*githubdeploymentscraper.Config)
+	deploymentScraperConfig.Server = server
+	deploymentScraperConfig.GitHubOrg = "liatrio"
+	deploymentScraperConfig.Environments = []string{"production"}
+	deploymentScraperConfig.LookbackDays = 14
 	expectedConfig := &Config{
 		ControllerConfig: scraperhelper.ControllerConfig{
 			CollectionInterval: 30 * time.Second,
 			InitialDelay:       1 * time.Second,
 		},
 		MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+		ServerConfig:         server,
 		Scrapers: map[string]internal.Config{
-			githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+			githubscraper.TypeStr:           scraperConfig,
+			githubrunnerscraper.TypeStr:     runnerScraperConfig,
+			githubdeploymentscraper.TypeStr: deploymentScraperConfig,
 		},
 		WebHook: WebHook{
 			ServerConfig: confighttp.ServerConfig{
@@ -107,6 +170,61 @@ func TestLoadConfig(t *testing.T) {
 					"X-Hub-Signature-256": "",
 				},
 			},
+			Client: &confighttp.ClientConfig{
+				Endpoint: "https://github.example.com",
+			},
+			Metrics: WebHookMetrics{
+				Enabled:          true,
+				HistogramBuckets: []float64{1, 10, 100},
+				Histograms: WebHookHistograms{
+					PipelineRunDuration:          HistogramConfig{Enabled: true},
+					PipelineTaskRunDuration:      HistogramConfig{Enabled: true},
+					PipelineTaskRunQueueDuration: HistogramConfig{Enabled: false},
+				},
+				JobStateTTL: time.Hour,
+			},
+			Dedup: WebHookDedup{
+				CacheSize: 100,
+				Storage:   &storageID,
+			},
+			Reconciler: WebHookReconciler{
+				Enabled:     true,
+				Owner:       "liatrio",
+				Repository:  "otel-testing",
+				HookID:      123456,
+				Interval:    time.Minute,
+				Lookback:    defaultReconcileLookback,
+				MaxAttempts: 3,
+				Mode:        reconcileModeProcess,
+				Storage:     &storageID,
+			},
+			Async: WebHookAsync{
+				Enabled:    true,
+				QueueSize:  100,
+				NumWorkers: 2,
+				Overflow:   overflowReject,
+			},
+			JobLogs: WebHookJobLogs{
+				Enabled:     true,
+				Conclusions: []string{"failure", "cancelled"},
+				MaxSize:     65536,
+				Redact:      []string{`ghp_[A-Za-z0-9]+`},
+			},
+			TestResults: WebHookTestResults{
+				Enabled:             true,
+				ArtifactPattern:     "test-results-*",
+				MaxSize:             1048576,
+				MaxUncompressedSize: 5242880,
+				MaxFiles:            20,
+			},
+			Poller: WebHookPoller{
+				Enabled:      true,
+				Owner:        "liatrio",
+				Repositories: []string{"otel-testing"},
+				Interval:     10 * time.Minute,
+				Lookback:     720 * time.Hour,
+				Storage:      &storageID,
+			},
 		},
 	}
 
@@ -135,6 +253,276 @@ func TestLoadInvalidConfig_InvalidScraperKey(t *testing.T) {
 	require.ErrorContains(t, err, "invalid scraper key: \"invalidscraperkey\"")
 }
 
+func TestLoadInvalidConfig_ServerURL(t *testing.T) {
+	factories, err := otelcoltest.NopFactories()
+	require.NoError(t, err)
+
+	factory := NewFactory()
+	factories.Receivers[metadata.Type] = factory
+	_, err = otelcoltest.LoadConfigAndValidate(filepath.Join("testdata", "config-invalidserverurl.yaml"), factories)
+
+	require.ErrorIs(t, err, internal.ErrInvalidServerURL)
+}
+
+func TestValidateConfig_HistogramBuckets(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Scrapers = map[string]internal.Config{
+		githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+	}
+	require.NoError(t, cfg.Validate())
+
+	cfg.WebHook.Metrics.HistogramBuckets = []float64{60, 30}
+	require.ErrorIs(t, cfg.Validate(), errHistogramBuckets)
+}
+
+func TestValidateConfig_JobStateTTL(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Scrapers = map[string]internal.Config{
+		githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+	}
+	cfg.WebHook.Metrics.JobStateTTL = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.WebHook.Metrics.Enabled = true
+	require.ErrorIs(t, cfg.Validate(), errJobStateTTL)
+}
+
+func TestValidateConfig_DedupCacheSize(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.Scrapers = map[string]internal.Config{
+		githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+	}
+	cfg.WebHook.Dedup.CacheSize = 0
+	require.NoError(t, cfg.Validate())
+
+	cfg.WebHook.Dedup.CacheSize = -1
+	require.ErrorIs(t, cfg.Validate(), errDedupCacheSize)
+}
+
+func TestValidateConfig_Reconciler(t *testing.T) {
+	tests := []struct {
+		desc        string
+		client      *confighttp.ClientConfig
+		reconciler  WebHookReconciler
+		expectedErr []error
+	}{
+		{
+			desc:   "valid",
+			client: &confighttp.ClientConfig{},
+			reconciler: WebHookReconciler{
+				Enabled:     true,
+				Owner:       "liatrio",
+				HookID:      123456,
+				Interval:    time.Minute,
+				MaxAttempts: 5,
+				Mode:        reconcileModeRedeliver,
+			},
+		},
+		{
+			desc: "not enabled",
+		},
+		{
+			desc: "missing client, hook, interval, mode and max attempts",
+			reconciler: WebHookReconciler{
+				Enabled: true,
+				Mode:    "retry",
+			},
+			expectedErr: []error{errReconcilerClient, errReconcilerHook, errReconcilerInterval, errReconcilerMode, errReconcilerMaxAttempts},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Scrapers = map[string]internal.Config{
+				githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+			}
+			cfg.WebHook.Client = test.client
+			cfg.WebHook.Reconciler = test.reconciler
+
+			err := cfg.Validate()
+			if len(test.expectedErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, expected := range test.expectedErr {
+				require.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_Async(t *testing.T) {
+	tests := []struct {
+		desc        string
+		async       WebHookAsync
+		expectedErr []error
+	}{
+		{
+			desc:  "valid",
+			async: WebHookAsync{Enabled: true, QueueSize: 10, NumWorkers: 1, Overflow: overflowDrop},
+		},
+		{
+			desc:  "not enabled",
+			async: WebHookAsync{Overflow: "retry"},
+		},
+		{
+			desc:        "invalid queue size, workers and overflow",
+			async:       WebHookAsync{Enabled: true, Overflow: "retry"},
+			expectedErr: []error{errAsyncQueueSize, errAsyncNumWorkers, errAsyncOverflow},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Scrapers = map[string]internal.Config{
+				githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+			}
+			cfg.WebHook.Async = test.async
+
+			err := cfg.Validate()
+			if len(test.expectedErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, expected := range test.expectedErr {
+				require.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_JobLogs(t *testing.T) {
+	tests := []struct {
+		desc        string
+		client      *confighttp.ClientConfig
+		jobLogs     WebHookJobLogs
+		expectedErr []error
+	}{
+		{
+			desc:    "valid",
+			client:  &confighttp.ClientConfig{},
+			jobLogs: WebHookJobLogs{Enabled: true, MaxSize: 1024, Redact: []string{`ghp_[A-Za-z0-9]+`}},
+		},
+		{
+			desc: "not enabled",
+		},
+		{
+			desc:        "missing client, max size and invalid redact expression",
+			jobLogs:     WebHookJobLogs{Enabled: true, Redact: []string{`ghp_[`}},
+			expectedErr: []error{errJobLogsClient, errJobLogsMaxSize, errJobLogsRedact},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Scrapers = map[string]internal.Config{
+				githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+			}
+			cfg.WebHook.Client = test.client
+			cfg.WebHook.JobLogs = test.jobLogs
+
+			err := cfg.Validate()
+			if len(test.expectedErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, expected := range test.expectedErr {
+				require.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_TestResults(t *testing.T) {
+	tests := []struct {
+		desc        string
+		client      *confighttp.ClientConfig
+		testResults WebHookTestResults
+		expectedErr []error
+	}{
+		{
+			desc:        "valid",
+			client:      &confighttp.ClientConfig{},
+			testResults: WebHookTestResults{Enabled: true, ArtifactPattern: "*junit*", MaxSize: 1024, MaxUncompressedSize: 4096, MaxFiles: 1},
+		},
+		{
+			desc:        "not enabled",
+			testResults: WebHookTestResults{ArtifactPattern: "["},
+		},
+		{
+			desc:        "missing client, limits and invalid pattern",
+			testResults: WebHookTestResults{Enabled: true, ArtifactPattern: "["},
+			expectedErr: []error{errTestResultsClient, errTestResultsPattern, errTestResultsMaxSize, errTestResultsMaxUncompressed, errTestResultsMaxFiles},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Scrapers = map[string]internal.Config{
+				githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+			}
+			cfg.WebHook.Client = test.client
+			cfg.WebHook.TestResults = test.testResults
+
+			err := cfg.Validate()
+			if len(test.expectedErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, expected := range test.expectedErr {
+				require.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_Poller(t *testing.T) {
+	tests := []struct {
+		desc        string
+		client      *confighttp.ClientConfig
+		poller      WebHookPoller
+		expectedErr []error
+	}{
+		{
+			desc:   "valid",
+			client: &confighttp.ClientConfig{},
+			poller: WebHookPoller{Enabled: true, Owner: "liatrio", Interval: time.Minute},
+		},
+		{
+			desc: "not enabled",
+		},
+		{
+			desc:        "missing client, owner, interval and negative lookback",
+			poller:      WebHookPoller{Enabled: true, Lookback: -time.Hour},
+			expectedErr: []error{errPollerClient, errPollerOwner, errPollerInterval, errPollerLookback},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.Scrapers = map[string]internal.Config{
+				githubscraper.TypeStr: (&githubscraper.Factory{}).CreateDefaultConfig(),
+			}
+			cfg.WebHook.Client = test.client
+			cfg.WebHook.Poller = test.poller
+
+			err := cfg.Validate()
+			if len(test.expectedErr) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, expected := range test.expectedErr {
+				require.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
+
 func TestConfig_Unmarshal(t *testing.T) {
 	type fields struct {
 		ControllerConfig     scraperhelper.ControllerConfig
@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// deliveriesStorageKey is the storage key the delivery IDs are persisted
+// under.
+const deliveriesStorageKey = "deliveries"
+
+// deliveriesPersistInterval is how often the deliveries processed since they
+// were last persisted are written to the storage extension.
+const deliveriesPersistInterval = 10 * time.Second
+
+var errNotStorageExtension = errors.New("extension is not a storage extension")
+
+// deliveryCache holds the IDs of the most recently processed webhook
+// deliveries, as set by GitHub in the X-GitHub-Delivery header, so that
+// retried and redelivered events are not processed twice.
+type deliveryCache struct {
+	cache  *lru.Cache[string, struct{}]
+	client storage.Client
+
+	// dirty is set when deliveries were processed since they were last
+	// persisted.
+	dirty  atomic.Bool
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	logger *zap.Logger
+}
+
+func newDeliveryCache(size int) (*deliveryCache, error) {
+	cache, err := lru.New[string, struct{}](size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deliveryCache{cache: cache}, nil
+}
+
+// claim records the delivery as processed, evicting the least recently
+// processed delivery when the cache is full, and returns false when it was
+// already processed. Checking and recording the delivery at once ensures
+// concurrent deliveries with the same ID are processed once.
+func (c *deliveryCache) claim(id string) bool {
+	if ok, _ := c.cache.ContainsOrAdd(id, struct{}{}); ok {
+		return false
+	}
+
+	c.dirty.Store(true)
+	return true
+}
+
+// release forgets a claimed delivery which failed to be processed, so that it
+// is processed again when GitHub retries it.
+func (c *deliveryCache) release(id string) {
+	c.cache.Remove(id)
+}
+
+// load restores the deliveries persisted by a previous run from the storage
+// extension, which is then used to persist the deliveries.
+func (c *deliveryCache) load(ctx context.Context, host component.Host, storageID component.ID, receiverID component.ID) error {
+	client, err := getStorageClient(ctx, host, storageID, receiverID, "deliveries")
+	if err != nil {
+		return err
+	}
+	c.client = client
+
+	data, err := client.Get(ctx, deliveriesStorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to get deliveries from storage: %w", err)
+	}
+	if data == nil {
+		return nil
+	}
+
+	var ids []string
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return fmt.Errorf("failed to unmarshal deliveries: %w", err)
+	}
+
+	// ids are persisted from the oldest to the newest delivery, so adding
+	// them in order preserves the eviction order.
+	for _, id := range ids {
+		c.cache.Add(id, struct{}{})
+	}
+
+	return nil
+}
+
+// start persists the processed deliveries in the background until save is
+// called, so that a crash only loses the deliveries processed within the
+// last interval.
+func (c *deliveryCache) start(logger *zap.Logger) {
+	if c.client == nil {
+		return
+	}
+
+	var ctx context.Context
+	ctx, c.cancel = context.WithCancel(context.Background())
+	c.logger = logger
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(deliveriesPersistInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.persist(ctx); err != nil {
+					c.logger.Warn("failed to persist webhook deliveries", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// persist writes the deliveries to the storage extension when some were
+// processed since they were last persisted.
+func (c *deliveryCache) persist(ctx context.Context) error {
+	if !c.dirty.Swap(false) {
+		return nil
+	}
+
+	data, err := json.Marshal(c.cache.Keys())
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.Set(ctx, deliveriesStorageKey, data); err != nil {
+		// persisted again on the next interval
+		c.dirty.Store(true)
+		return err
+	}
+
+	return nil
+}
+
+// getStorageClient returns a client of the storage extension with the provided
+// ID. Each user of the storage within the receiver uses its own name.
+func getStorageClient(ctx context.Context, host component.Host, storageID component.ID, receiverID component.ID, name string) (storage.Client, error) {
+	ext, ok := host.GetExtensions()[storageID]
+	if !ok {
+		return nil, fmt.Errorf("storage extension %q not found", storageID)
+	}
+
+	storageExt, ok := ext.(storage.Extension)
+	if !ok {
+		return nil, fmt.Errorf("%q: %w", storageID, errNotStorageExtension)
+	}
+
+	client, err := storageExt.GetClient(ctx, component.KindReceiver, receiverID, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage client: %w", err)
+	}
+
+	return client, nil
+}
+
+// save stops persisting the deliveries in the background, persists them a
+// last time to the storage extension, if one was loaded, and closes the
+// storage client.
+func (c *deliveryCache) save(ctx context.Context) error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+
+	if c.client == nil {
+		return nil
+	}
+
+	c.dirty.Store(true)
+	err := c.persist(ctx)
+
+	client := c.client
+	c.client = nil
+
+	return multierr.Combine(err, client.Close(ctx))
+}
+
+// statusRecorder records the status code written to a http.ResponseWriter so
+// a delivery is only recorded as processed when it was handled successfully.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,235 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+// memoryStorage is a storage extension keeping its data in memory across
+// clients, simulating a persistent storage extension.
+type memoryStorage struct {
+	component.StartFunc
+	component.ShutdownFunc
+	data map[string][]byte
+}
+
+func (s *memoryStorage) GetClient(context.Context, component.Kind, component.ID, string) (storage.Client, error) {
+	return &memoryClient{data: s.data}, nil
+}
+
+type memoryClient struct {
+	data map[string][]byte
+}
+
+func (c *memoryClient) Get(_ context.Context, key string) ([]byte, error) {
+	return c.data[key], nil
+}
+
+func (c *memoryClient) Set(_ context.Context, key string, value []byte) error {
+	c.data[key] = value
+	return nil
+}
+
+func (c *memoryClient) Delete(_ context.Context, key string) error {
+	delete(c.data, key)
+	return nil
+}
+
+func (*memoryClient) Batch(context.Context, ...*storage.Operation) error {
+	return errors.New("not implemented")
+}
+
+func (*memoryClient) Close(context.Context) error {
+	return nil
+}
+
+type storageHost struct {
+	extensions map[component.ID]component.Component
+}
+
+func (h *storageHost) GetExtensions() map[component.ID]component.Component {
+	return h.extensions
+}
+
+func newDeliveryReq(body []byte, deliveryID string) *http.Request {
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(github.EventTypeHeader, "workflow_run")
+	if deliveryID != "" {
+		req.Header.Set(github.DeliveryIDHeader, deliveryID)
+	}
+	return req
+}
+
+func TestHandleReqDeduplicatesDeliveries(t *testing.T) {
+	run, err := os.ReadFile(filepath.Join("testdata", "workflow-run-completed.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc          string
+		deliveryIDs   []string
+		cacheSize     int
+		expectedSpans int
+	}{
+		{
+			desc:          "distinct deliveries",
+			deliveryIDs:   []string{"a", "b"},
+			cacheSize:     defaultDedupCacheSize,
+			expectedSpans: 2,
+		},
+		{
+			desc:          "redelivery is skipped",
+			deliveryIDs:   []string{"a", "a"},
+			cacheSize:     defaultDedupCacheSize,
+			expectedSpans: 1,
+		},
+		{
+			desc:          "deliveries without an id are not deduplicated",
+			deliveryIDs:   []string{"", ""},
+			cacheSize:     defaultDedupCacheSize,
+			expectedSpans: 2,
+		},
+		{
+			desc:          "evicted deliveries are processed again",
+			deliveryIDs:   []string{"a", "b", "a"},
+			cacheSize:     1,
+			expectedSpans: 3,
+		},
+		{
+			desc:          "dedup disabled",
+			deliveryIDs:   []string{"a", "a"},
+			expectedSpans: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.WebHook.Dedup.CacheSize = test.cacheSize
+
+			sink := new(consumertest.TracesSink)
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+			require.NoError(t, err)
+
+			for _, id := range test.deliveryIDs {
+				w := httptest.NewRecorder()
+				receiver.handleReq(w, newDeliveryReq(run, id))
+				require.Equal(t, http.StatusOK, w.Result().StatusCode)
+			}
+
+			require.Equal(t, test.expectedSpans, sink.SpanCount())
+		})
+	}
+}
+
+// TestHandleReqFailedDeliveryIsRetried ensures a delivery GitHub retries after a
+// server error is processed again.
+func TestHandleReqFailedDeliveryIsRetried(t *testing.T) {
+	run, err := os.ReadFile(filepath.Join("testdata", "workflow-run-completed.json"))
+	require.NoError(t, err)
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), consumertest.NewErr(errors.New("export failed")))
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	receiver.handleReq(w, newDeliveryReq(run, "a"))
+	require.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+	require.False(t, receiver.deliveries.cache.Contains("a"))
+
+	sink := new(consumertest.TracesSink)
+	receiver.traceConsumer = sink
+
+	w = httptest.NewRecorder()
+	receiver.handleReq(w, newDeliveryReq(run, "a"))
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	require.Equal(t, 1, sink.SpanCount())
+	require.True(t, receiver.deliveries.cache.Contains("a"))
+}
+
+func TestDeliveryCacheClaim(t *testing.T) {
+	cache, err := newDeliveryCache(10)
+	require.NoError(t, err)
+
+	// a delivery is claimed once, even by concurrent deliveries
+	var claimed atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if cache.claim("a") {
+				claimed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), claimed.Load())
+
+	// a released delivery can be claimed again
+	cache.release("a")
+	require.True(t, cache.claim("a"))
+}
+
+func TestDeliveryCachePersistence(t *testing.T) {
+	storageID := component.MustNewID("memory_storage")
+	host := &storageHost{extensions: map[component.ID]component.Component{
+		storageID: &memoryStorage{data: map[string][]byte{}},
+	}}
+
+	first, err := newDeliveryCache(2)
+	require.NoError(t, err)
+	require.NoError(t, first.load(context.Background(), host, storageID, component.MustNewID("github")))
+	first.claim("a")
+	first.claim("b")
+	require.NoError(t, first.persist(context.Background()))
+
+	// the deliveries persisted in the background survive a crash
+	crashed, err := newDeliveryCache(2)
+	require.NoError(t, err)
+	require.NoError(t, crashed.load(context.Background(), host, storageID, component.MustNewID("github")))
+	require.True(t, crashed.cache.Contains("a"))
+	require.True(t, crashed.cache.Contains("b"))
+
+	first.claim("c")
+	require.NoError(t, first.save(context.Background()))
+
+	second, err := newDeliveryCache(2)
+	require.NoError(t, err)
+	require.NoError(t, second.load(context.Background(), host, storageID, component.MustNewID("github")))
+	require.False(t, second.cache.Contains("a"))
+	require.True(t, second.cache.Contains("b"))
+	require.True(t, second.cache.Contains("c"))
+
+	// the least recently processed delivery is evicted first
+	second.claim("d")
+	require.False(t, second.cache.Contains("b"))
+	require.True(t, second.cache.Contains("c"))
+
+	// missing and unexpected extensions fail to load
+	host.extensions[component.MustNewID("other")] = &struct {
+		component.StartFunc
+		component.ShutdownFunc
+	}{}
+	require.Error(t, second.load(context.Background(), host, component.MustNewID("missing"), component.MustNewID("github")))
+	require.ErrorIs(t, second.load(context.Background(), host, component.MustNewID("other"), component.MustNewID("github")), errNotStorageExtension)
+}
@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.uber.org/zap"
+)
+
+// deploymentStatusEvent extends the go-github DeploymentStatusEvent with the
+// workflow run that created the deployment. GitHub sends the workflow run in
+// the webhook payload but go-github does not expose it.
+type deploymentStatusEvent struct {
+	github.DeploymentStatusEvent
+	WorkflowRun *github.WorkflowRun `json:"workflow_run,omitempty"`
+}
+
+// parseDeploymentStatusEvent parses the validated payload of a
+// `deployment_status` event.
+func parseDeploymentStatusEvent(payload []byte) (*deploymentStatusEvent, error) {
+	e := &deploymentStatusEvent{}
+	if err := json.Unmarshal(payload, e); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// isDeploymentFinished returns true when the deployment status state is final.
+// GitHub deployment states of pending, queued, and in_progress are
+// intermediate, while inactive is set on previous deployments once superseded.
+func isDeploymentFinished(state string) bool {
+	switch strings.ToLower(state) {
+	case "success", "failure", "error":
+		return true
+	default:
+		return false
+	}
+}
+
+// handleDeploymentStatus creates a span for a GitHub Deployment once it reaches
+// a final status. The span covers the time from when the deployment was
+// created until the final status was reported, and is linked to the workflow
+// run trace that created the deployment when available.
+func (gtr *githubTracesReceiver) handleDeploymentStatus(e *deploymentStatusEvent) (ptrace.Traces, error) {
+	t := ptrace.NewTraces()
+	r := t.ResourceSpans().AppendEmpty()
+
+	repo := e.GetRepo()
+	err := gtr.getRepositoryAttrs(r.Resource(), repo.CustomProperties["service_name"], repo.GetName(), repo.GetHTMLURL(), repo.GetOwner().GetLogin())
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to get deployment attributes: %w", err)
+	}
+
+	err = gtr.createDeploymentSpan(r, e)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to create deployment span", zap.Error(err))
+		return ptrace.Traces{}, fmt.Errorf("failed to create deployment span: %w", err)
+	}
+
+	return t, nil
+}
+
+// createDeploymentSpan creates the span of a deployment based on the provided
+// event.
+func (gtr *githubTracesReceiver) createDeploymentSpan(
+	resourceSpans ptrace.ResourceSpans,
+	event *deploymentStatusEvent,
+) error {
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	span := scopeSpans.Spans().AppendEmpty()
+
+	deployment := event.GetDeployment()
+	status := event.GetDeploymentStatus()
+
+	traceID, err := newDeploymentTraceID(deployment.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to generate deployment trace ID: %w", err)
+	}
+
+	spanID, err := newDeploymentSpanID(deployment.GetID(), status.GetID())
+	if err != nil {
+		return fmt.Errorf("failed to generate deployment span ID: %w", err)
+	}
+
+	span.SetTraceID(traceID)
+	span.SetSpanID(spanID)
+	span.SetName(fmt.Sprintf("deploy %s", deployment.GetEnvironment()))
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(deployment.GetCreatedAt().Time))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(status.GetCreatedAt().Time))
+
+	attrs := span.Attributes()
+	attrs.PutStr(string(semconv.DeploymentIDKey), strconv.FormatInt(deployment.GetID(), 10))
+	attrs.PutStr(string(semconv.DeploymentEnvironmentNameKey), deployment.GetEnvironment())
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), deployment.GetRef())
+	attrs.PutStr(string(semconv.VCSRefHeadRevisionKey), deployment.GetSHA())
+	attrs.PutStr(string(AttributeDeploymentCreatorLoginKey), deployment.GetCreator().GetLogin())
+	attrs.PutStr(string(AttributeGitHubDeploymentStateKey), strings.ToLower(status.GetState()))
+	if deployment.GetTask() != "" {
+		attrs.PutStr(string(AttributeGitHubDeploymentTaskKey), deployment.GetTask())
+	}
+	if status.GetLogURL() != "" {
+		attrs.PutStr(string(AttributeDeploymentURLFullKey), status.GetLogURL())
+	}
+
+	switch strings.ToLower(status.GetState()) {
+	case "success":
+		attrs.PutStr(string(semconv.DeploymentStatusKey), semconv.DeploymentStatusSucceeded.Value.AsString())
+		span.Status().SetCode(ptrace.StatusCodeOk)
+	case "failure", "error":
+		attrs.PutStr(string(semconv.DeploymentStatusKey), semconv.DeploymentStatusFailed.Value.AsString())
+		span.Status().SetCode(ptrace.StatusCodeError)
+	default:
+		span.Status().SetCode(ptrace.StatusCodeUnset)
+	}
+
+	span.Status().SetMessage(status.GetState())
+
+	// Link to the workflow run trace that created the deployment, if any.
+	if run := event.WorkflowRun; run != nil {
+		runTraceID, err := newTraceID(run.GetID(), run.GetRunAttempt())
+		if err != nil {
+			return fmt.Errorf("failed to generate workflow run trace ID: %w", err)
+		}
+
+		runSpanID, err := newParentSpanID(run.GetID(), run.GetRunAttempt())
+		if err != nil {
+			return fmt.Errorf("failed to generate workflow run span ID: %w", err)
+		}
+
+		link := span.Links().AppendEmpty()
+		link.SetTraceID(runTraceID)
+		link.SetSpanID(runSpanID)
+		gtr.logger.Debug("successfully linked deployment to workflow run", zap.String("traceID", runTraceID.String()))
+	}
+
+	return nil
+}
+
+// newDeploymentTraceID creates a deterministic Trace ID based on the provided
+// deploymentID. The `deployment` prefix differentiates the input from the
+// workflow run based IDs and `t` is appended to the end of the input to
+// differentiate between the traceID and the spanID.
+func newDeploymentTraceID(deploymentID int64) (pcommon.TraceID, error) {
+	input := fmt.Sprintf("deployment%dt", deploymentID)
+	return newTraceIDFromInput(input)
+}
+
+// newDeploymentSpanID creates a deterministic Span ID based on the provided
+// deploymentID and the ID of its final status, so that a deployment which
+// receives several final statuses, such as an error followed by a success,
+// emits a span per status rather than duplicate spans. `s` is appended to
+// the end of the input to differentiate between the traceID and the spanID.
+func newDeploymentSpanID(deploymentID int64, statusID int64) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("deployment%d_%ds", deploymentID, statusID)
+	return newSpanID(input)
+}
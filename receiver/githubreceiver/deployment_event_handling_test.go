@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+)
+
+func TestHandleDeploymentStatusWithGoldenFile(t *testing.T) {
+	defaultConfig := createDefaultConfig().(*Config)
+	defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+	consumer := consumertest.NewNop()
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumer)
+	require.NoError(t, err, "failed to create receiver")
+
+	data, err := os.ReadFile(filepath.Join("testdata", "deployment-status-success.json"))
+	require.NoError(t, err, "Failed to read test data file")
+
+	event, err := parseDeploymentStatusEvent(data)
+	require.NoError(t, err, "Failed to parse deployment status event")
+
+	traces, err := receiver.handleDeploymentStatus(event)
+	require.NoError(t, err, "Failed to handle deployment status event")
+
+	expectedFile := filepath.Join("testdata", "deployment-status-expected.yaml")
+
+	// Uncomment the following line to update the golden file
+	// golden.WriteTraces(t, expectedFile, traces)
+
+	expectedTraces, err := golden.ReadTraces(expectedFile)
+	require.NoError(t, err, "Failed to read expected traces")
+
+	require.NoError(t, ptracetest.CompareTraces(expectedTraces, traces))
+}
+
+// TestDeploymentSpanLinksToWorkflowRun ensures the deployment span links to the
+// root span of the workflow run that created the deployment.
+func TestDeploymentSpanLinksToWorkflowRun(t *testing.T) {
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), consumertest.NewNop())
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join("testdata", "deployment-status-success.json"))
+	require.NoError(t, err)
+
+	event, err := parseDeploymentStatusEvent(data)
+	require.NoError(t, err)
+	require.NotNil(t, event.WorkflowRun)
+
+	traces, err := receiver.handleDeploymentStatus(event)
+	require.NoError(t, err)
+
+	span := traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+	require.Equal(t, 1, span.Links().Len())
+
+	runTraceID, err := newTraceID(event.WorkflowRun.GetID(), event.WorkflowRun.GetRunAttempt())
+	require.NoError(t, err)
+	runSpanID, err := newParentSpanID(event.WorkflowRun.GetID(), event.WorkflowRun.GetRunAttempt())
+	require.NoError(t, err)
+
+	require.Equal(t, runTraceID, span.Links().At(0).TraceID())
+	require.Equal(t, runSpanID, span.Links().At(0).SpanID())
+
+	// deployments created outside of a workflow run are not linked
+	event.WorkflowRun = nil
+	traces, err = receiver.handleDeploymentStatus(event)
+	require.NoError(t, err)
+	require.Equal(t, 0, traces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0).Links().Len())
+}
+
+func TestHandleDeploymentReq(t *testing.T) {
+	status, err := os.ReadFile(filepath.Join("testdata", "deployment-status-success.json"))
+	require.NoError(t, err)
+	inProgress := bytes.Replace(status, []byte(`"state": "success"`), []byte(`"state": "in_progress"`), 1)
+	failure := bytes.Replace(status, []byte(`"state": "success"`), []byte(`"state": "failure"`), 1)
+
+	tests := []struct {
+		desc           string
+		event          string
+		body           []byte
+		expectedStatus int
+		expectedSpans  int
+	}{
+		{
+			desc:           "deployment created is skipped",
+			event:          "deployment",
+			body:           []byte(`{"deployment": {"id": 2028401125}}`),
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			desc:           "deployment in progress is skipped",
+			event:          "deployment_status",
+			body:           inProgress,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			desc:           "deployment success",
+			event:          "deployment_status",
+			body:           status,
+			expectedStatus: http.StatusOK,
+			expectedSpans:  1,
+		},
+		{
+			desc:           "deployment failure",
+			event:          "deployment_status",
+			body:           failure,
+			expectedStatus: http.StatusOK,
+			expectedSpans:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			sink := new(consumertest.TracesSink)
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), sink)
+			require.NoError(t, err)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(github.EventTypeHeader, test.event)
+
+			w := httptest.NewRecorder()
+			receiver.handleReq(w, req)
+
+			require.Equal(t, test.expectedStatus, w.Result().StatusCode)
+			require.Equal(t, test.expectedSpans, sink.SpanCount())
+		})
+	}
+}
+
+func TestNewDeploymentIDs(t *testing.T) {
+	traceID, err := newDeploymentTraceID(2028401125)
+	require.NoError(t, err)
+	again, err := newDeploymentTraceID(2028401125)
+	require.NoError(t, err)
+	other, err := newDeploymentTraceID(2028401126)
+	require.NoError(t, err)
+
+	require.Equal(t, traceID, again)
+	require.NotEqual(t, traceID, other)
+
+	// a deployment ID must not collide with a workflow run using the same ID
+	runTraceID, err := newTraceID(2028401125, 1)
+	require.NoError(t, err)
+	require.NotEqual(t, traceID, runTraceID)
+
+	spanID, err := newDeploymentSpanID(2028401125, 1)
+	require.NoError(t, err)
+	require.False(t, spanID.IsEmpty())
+
+	// each final status of a deployment is a span of its own
+	nextSpanID, err := newDeploymentSpanID(2028401125, 2)
+	require.NoError(t, err)
+	require.NotEqual(t, spanID, nextSpanID)
+}
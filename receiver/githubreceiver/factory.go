@@ -16,10 +16,14 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/scraper"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/multierr"
 
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubdeploymentscraper"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubrunnerscraper"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubscraper"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/sharedcomponent"
 )
 
 // This file implements a factory for the github receiver
@@ -34,10 +38,85 @@ const (
 
 var (
 	scraperFactories = map[string]internal.ScraperFactory{
-		githubscraper.TypeStr: &githubscraper.Factory{},
+		githubscraper.TypeStr:           &githubscraper.Factory{},
+		githubrunnerscraper.TypeStr:     &githubrunnerscraper.Factory{},
+		githubdeploymentscraper.TypeStr: &githubdeploymentscraper.Factory{},
 	}
 
+	// defaultHistogramBuckets are the bucket boundaries, in seconds, of the
+	// duration histograms derived from webhook events.
+	defaultHistogramBuckets = []float64{5, 10, 30, 60, 120, 300, 600, 900, 1800, 3600, 7200}
+
+	// defaultJobStateTTL is how long a queued or in progress workflow job is
+	// tracked without receiving another event for it.
+	defaultJobStateTTL = 24 * time.Hour
+
+	// defaultDedupCacheSize is the number of most recent webhook delivery IDs
+	// remembered to deduplicate deliveries.
+	defaultDedupCacheSize = 10000
+
+	// defaultReconcileInterval is how often failed webhook deliveries are
+	// polled for.
+	defaultReconcileInterval = 5 * time.Minute
+
+	// defaultReconcileLookback is how far back failed webhook deliveries are
+	// looked for when no checkpoint has been persisted.
+	defaultReconcileLookback = time.Hour
+
+	// defaultReconcileMaxAttempts is how many times a failed webhook
+	// delivery is recovered before giving up on it.
+	defaultReconcileMaxAttempts = 5
+
+	// defaultAsyncQueueSize is the number of deliveries which can wait to be
+	// processed when processing asynchronously.
+	defaultAsyncQueueSize = 1000
+
+	// defaultAsyncNumWorkers is the number of deliveries processed
+	// concurrently when processing asynchronously.
+	defaultAsyncNumWorkers = 4
+
+	// defaultJobLogsConclusions are the conclusions of the workflow jobs
+	// whose logs are downloaded.
+	defaultJobLogsConclusions = []string{"failure"}
+
+	// defaultJobLogsMaxSize is the maximum number of bytes of a workflow job
+	// log downloaded.
+	defaultJobLogsMaxSize int64 = 1 << 20
+
+	// defaultTestResultsArtifactPattern is the glob pattern the names of the
+	// artifacts containing JUnit XML reports must match.
+	defaultTestResultsArtifactPattern = "*junit*"
+
+	// defaultTestResultsMaxSize is the maximum number of bytes of a test
+	// results artifact downloaded.
+	defaultTestResultsMaxSize int64 = 10 << 20
+
+	// defaultTestResultsMaxUncompressedSize is the maximum number of bytes of
+	// the reports read from the artifacts of a workflow run attempt.
+	defaultTestResultsMaxUncompressedSize int64 = 50 << 20
+
+	// defaultTestResultsMaxFiles is the maximum number of reports read from
+	// the artifacts of a workflow run attempt.
+	defaultTestResultsMaxFiles = 100
+
+	// defaultPollInterval is how often workflow runs are polled for.
+	defaultPollInterval = 5 * time.Minute
+
+	// defaultPollLookback is how far back workflow runs are backfilled for a
+	// repository without a persisted checkpoint.
+	defaultPollLookback = 24 * time.Hour
+
+	// webhookScraperType is the type of the scraper reporting the state of
+	// the workflow jobs received by the webhook.
+	webhookScraperType = component.MustNewType("webhook")
+
 	errConfigNotValid = errors.New("configuration is not valid for the github receiver")
+
+	// webhookReceivers holds the webhook receiver created for each
+	// configuration. The signals are served from the same webhook endpoint
+	// so they must share a single receiver and server, which is started by
+	// the first signal started and shut down once every signal is shut down.
+	webhookReceivers = sharedcomponent.NewMap[*Config, *githubTracesReceiver]()
 )
 
 // NewFactory creates a factory for the github receiver
@@ -47,6 +126,7 @@ func NewFactory() receiver.Factory {
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
 		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
+		receiver.WithLogs(createLogsReceiver, metadata.LogsStability),
 	)
 }
 
@@ -86,6 +166,43 @@ func createDefaultConfig() component.Config {
 			},
 			Path:       defaultPath,
 			HealthPath: defaultHealthPath,
+			Metrics: WebHookMetrics{
+				HistogramBuckets: defaultHistogramBuckets,
+				Histograms: WebHookHistograms{
+					PipelineRunDuration:          HistogramConfig{Enabled: true},
+					PipelineTaskRunDuration:      HistogramConfig{Enabled: true},
+					PipelineTaskRunQueueDuration: HistogramConfig{Enabled: true},
+				},
+				JobStateTTL: defaultJobStateTTL,
+			},
+			Dedup: WebHookDedup{
+				CacheSize: defaultDedupCacheSize,
+			},
+			Reconciler: WebHookReconciler{
+				Interval:    defaultReconcileInterval,
+				Lookback:    defaultReconcileLookback,
+				MaxAttempts: defaultReconcileMaxAttempts,
+				Mode:        reconcileModeRedeliver,
+			},
+			Async: WebHookAsync{
+				QueueSize:  defaultAsyncQueueSize,
+				NumWorkers: defaultAsyncNumWorkers,
+				Overflow:   overflowBlock,
+			},
+			JobLogs: WebHookJobLogs{
+				Conclusions: defaultJobLogsConclusions,
+				MaxSize:     defaultJobLogsMaxSize,
+			},
+			TestResults: WebHookTestResults{
+				ArtifactPattern:     defaultTestResultsArtifactPattern,
+				MaxSize:             defaultTestResultsMaxSize,
+				MaxUncompressedSize: defaultTestResultsMaxUncompressedSize,
+				MaxFiles:            defaultTestResultsMaxFiles,
+			},
+			Poller: WebHookPoller{
+				Interval: defaultPollInterval,
+				Lookback: defaultPollLookback,
+			},
 		},
 	}
 }
@@ -109,12 +226,59 @@ func createMetricsReceiver(
 		return nil, err
 	}
 
-	return scraperhelper.NewMetricsController(
+	if !conf.WebHook.Metrics.Enabled {
+		return scraperhelper.NewMetricsController(
+			&conf.ControllerConfig,
+			params,
+			consumer,
+			addScraperOpts...,
+		)
+	}
+
+	gtr, err := getOrCreateWebhookReceiver(params, conf)
+	if err != nil {
+		return nil, err
+	}
+	gtr.Unwrap().metricsConsumer = consumer
+
+	// the number of queued and in progress jobs is reported at the collection
+	// interval alongside the scraped metrics.
+	jobState, err := scraper.NewMetrics(gtr.Unwrap().scrapeJobState)
+	if err != nil {
+		return nil, err
+	}
+	addScraperOpts = append(addScraperOpts, scraperhelper.AddMetricsScraper(webhookScraperType, jobState))
+
+	controller, err := scraperhelper.NewMetricsController(
 		&conf.ControllerConfig,
 		params,
 		consumer,
 		addScraperOpts...,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webhookMetricsReceiver{Metrics: controller, webhook: gtr}, nil
+}
+
+// webhookMetricsReceiver runs the scrapers alongside the webhook receiver when
+// metrics derived from webhook events are enabled.
+type webhookMetricsReceiver struct {
+	receiver.Metrics
+	webhook *sharedcomponent.Component[*githubTracesReceiver]
+}
+
+func (r *webhookMetricsReceiver) Start(ctx context.Context, host component.Host) error {
+	if err := r.Metrics.Start(ctx, host); err != nil {
+		return err
+	}
+
+	return r.webhook.Start(ctx, host)
+}
+
+func (r *webhookMetricsReceiver) Shutdown(ctx context.Context) error {
+	return multierr.Combine(r.Metrics.Shutdown(ctx), r.webhook.Shutdown(ctx))
 }
 
 func createTracesReceiver(
@@ -129,7 +293,42 @@ func createTracesReceiver(
 		return nil, errConfigNotValid
 	}
 
-	return newTracesReceiver(params, conf, consumer)
+	gtr, err := getOrCreateWebhookReceiver(params, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	gtr.Unwrap().traceConsumer = consumer
+	return gtr, nil
+}
+
+func createLogsReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Logs,
+) (receiver.Logs, error) {
+	// check that the configuration is valid
+	conf, ok := cfg.(*Config)
+	if !ok {
+		return nil, errConfigNotValid
+	}
+
+	gtr, err := getOrCreateWebhookReceiver(params, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	gtr.Unwrap().logConsumer = consumer
+	return gtr, nil
+}
+
+// getOrCreateWebhookReceiver returns the webhook receiver for the provided
+// configuration, creating it if one does not exist yet.
+func getOrCreateWebhookReceiver(params receiver.Settings, conf *Config) (*sharedcomponent.Component[*githubTracesReceiver], error) {
+	return webhookReceivers.LoadOrStore(conf, func() (*githubTracesReceiver, error) {
+		return newTracesReceiver(params, conf, nil)
+	})
 }
 
 func createAddScraperOpts(
@@ -11,11 +11,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/consumer/consumertest"
-	"go.opentelemetry.io/collector/pipeline"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/sharedcomponent"
 )
 
 var creationSet = receivertest.NewNopSettings(metadata.Type)
@@ -41,9 +41,66 @@ func TestCreateReceiver(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, mReceiver)
 
-	tLogs, err := factory.CreateLogs(context.Background(), creationSet, cfg, consumertest.NewNop())
-	assert.Equal(t, err, pipeline.ErrSignalNotSupported)
-	assert.Nil(t, tLogs)
+	lReceiver, err := factory.CreateLogs(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.NotNil(t, lReceiver)
+}
+
+func TestCreateReceiver_SharedWebhook(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.WebHook.NetAddr.Endpoint = "localhost:0"
+
+	tReceiver, err := factory.CreateTraces(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+
+	lReceiver, err := factory.CreateLogs(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+
+	// the traces and logs signals are served by the same webhook server
+	assert.Same(t, tReceiver, lReceiver)
+
+	assert.NoError(t, tReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, lReceiver.Start(context.Background(), componenttest.NewNopHost()))
+
+	// the server keeps serving the logs signal once the traces signal is
+	// shut down
+	gtr := tReceiver.(*sharedcomponent.Component[*githubTracesReceiver]).Unwrap()
+	assert.NoError(t, tReceiver.Shutdown(context.Background()))
+	assert.NotNil(t, gtr.server)
+
+	assert.NoError(t, lReceiver.Shutdown(context.Background()))
+	assert.Nil(t, gtr.server)
+}
+
+func TestCreateReceiver_WebhookMetrics(t *testing.T) {
+	factory := NewFactory()
+	cfg := factory.CreateDefaultConfig().(*Config)
+	cfg.WebHook.NetAddr.Endpoint = "localhost:0"
+
+	// webhook metrics are disabled by default, only the scrapers are run
+	mReceiver, err := factory.CreateMetrics(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+	assert.NotNil(t, mReceiver)
+	_, ok := mReceiver.(*webhookMetricsReceiver)
+	assert.False(t, ok)
+
+	cfg.WebHook.Metrics.Enabled = true
+
+	tReceiver, err := factory.CreateTraces(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+
+	mReceiver, err = factory.CreateMetrics(context.Background(), creationSet, cfg, consumertest.NewNop())
+	assert.NoError(t, err)
+
+	wmr, ok := mReceiver.(*webhookMetricsReceiver)
+	assert.True(t, ok)
+	assert.Same(t, tReceiver, wmr.webhook)
+
+	assert.NoError(t, tReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, mReceiver.Start(context.Background(), componenttest.NewNopHost()))
+	assert.NoError(t, mReceiver.Shutdown(context.Background()))
+	assert.NoError(t, tReceiver.Shutdown(context.Background()))
 }
 
 func TestCreateReceiver_ScraperKeyConfigError(t *testing.T) {
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// fetchQueueSize is the maximum number of fetches waiting to be run.
+	fetchQueueSize = 100
+
+	// fetchNumWorkers is the number of fetches run concurrently.
+	fetchNumWorkers = 4
+)
+
+// fetchQueue runs the fetches of data which is not included in webhook
+// payloads, such as job logs, in the background with a fixed number of
+// workers. Deliveries are acknowledged without waiting on the GitHub API, so
+// fetches are dropped rather than holding up deliveries when the queue is full.
+type fetchQueue struct {
+	fetches chan func(context.Context)
+	logger  *zap.Logger
+
+	// ctx is canceled when the queue fails to drain on shutdown.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu guards closing the fetches channel while fetches are queued.
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func newFetchQueue(logger *zap.Logger) *fetchQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &fetchQueue{
+		fetches: make(chan func(context.Context), fetchQueueSize),
+		logger:  logger,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// start starts the workers running the queued fetches.
+func (q *fetchQueue) start() {
+	for range fetchNumWorkers {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			for fetch := range q.fetches {
+				fetch(q.ctx)
+			}
+		}()
+	}
+}
+
+// enqueue queues the fetch, dropping it when the queue is full or shut down.
+func (q *fetchQueue) enqueue(fetch func(context.Context)) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		q.logger.Debug("fetch queue is shut down, dropping fetch")
+		return
+	}
+
+	select {
+	case q.fetches <- fetch:
+	default:
+		q.logger.Warn("fetch queue is full, dropping fetch")
+	}
+}
+
+// shutdown stops accepting fetches and waits for the queued fetches to run.
+// Fetches still running when the context is done are canceled.
+func (q *fetchQueue) shutdown(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.fetches)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		<-done
+		return fmt.Errorf("failed to drain fetch queue: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+func TestFetchQueue(t *testing.T) {
+	q := newFetchQueue(zap.NewNop())
+
+	// fetches are dropped rather than blocking once the queue is full
+	var ran atomic.Int64
+	for range fetchQueueSize + 1 {
+		q.enqueue(func(context.Context) {
+			ran.Add(1)
+		})
+	}
+
+	// shutting down the queue waits for the queued fetches to run
+	q.start()
+	require.NoError(t, q.shutdown(context.Background()))
+	require.Equal(t, int64(fetchQueueSize), ran.Load())
+
+	q.enqueue(func(context.Context) {
+		ran.Add(1)
+	})
+	require.Equal(t, int64(fetchQueueSize), ran.Load())
+}
+
+func TestFetchQueueShutdownTimeout(t *testing.T) {
+	q := newFetchQueue(zap.NewNop())
+	q.start()
+
+	// fetches still running when the shutdown times out are canceled
+	started := make(chan struct{})
+	q.enqueue(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+	})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, q.shutdown(ctx), context.DeadlineExceeded)
+}
+
+func TestDownload(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the file is downloaded without the headers of the client
+		require.Empty(t, r.Header.Get("X-Api-Key"))
+		if r.URL.Path == "/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte("log"))
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.Client = &confighttp.ClientConfig{
+		Endpoint: server.URL,
+		Timeout:  50 * time.Millisecond,
+		Headers:  configopaque.MapList{{Name: "X-Api-Key", Value: "secret"}},
+	}
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	receiver.downloadClient, err = receiver.newDownloadClient(context.Background(), nil)
+	require.NoError(t, err)
+
+	u, err := url.Parse(server.URL + "/download")
+	require.NoError(t, err)
+	body, err := receiver.download(context.Background(), u)
+	require.NoError(t, err)
+	require.NoError(t, body.Close())
+
+	// downloads time out with the client
+	u, err = url.Parse(server.URL + "/slow")
+	require.NoError(t, err)
+	_, err = receiver.download(context.Background(), u)
+	require.Error(t, err)
+}
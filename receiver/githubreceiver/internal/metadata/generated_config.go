@@ -9,6 +9,607 @@ import (
 	"go.opentelemetry.io/collector/filter"
 )
 
+// CicdPipelineRunCountMetricAttributeKey specifies the key of an attribute for the cicd.pipeline.run.count metric.
+type CicdPipelineRunCountMetricAttributeKey string
+
+const (
+	CicdPipelineRunCountMetricAttributeKeyVcsRepositoryName  CicdPipelineRunCountMetricAttributeKey = "vcs.repository.name"
+	CicdPipelineRunCountMetricAttributeKeyCicdPipelineName   CicdPipelineRunCountMetricAttributeKey = "cicd.pipeline.name"
+	CicdPipelineRunCountMetricAttributeKeyVcsRefHeadName     CicdPipelineRunCountMetricAttributeKey = "vcs.ref.head.name"
+	CicdPipelineRunCountMetricAttributeKeyCicdPipelineResult CicdPipelineRunCountMetricAttributeKey = "cicd.pipeline.result"
+)
+
+// CicdPipelineRunCountMetricConfig provides config for the cicd.pipeline.run.count metric.
+type CicdPipelineRunCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                   `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []CicdPipelineRunCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *CicdPipelineRunCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *CicdPipelineRunCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case CicdPipelineRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineResult:
+		default:
+			return fmt.Errorf("metric cicd.pipeline.run.count doesn't have an attribute %v, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, cicd.pipeline.result]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// CicdPipelineTaskRunActiveMetricAttributeKey specifies the key of an attribute for the cicd.pipeline.task.run.active metric.
+type CicdPipelineTaskRunActiveMetricAttributeKey string
+
+const (
+	CicdPipelineTaskRunActiveMetricAttributeKeyVcsRepositoryName        CicdPipelineTaskRunActiveMetricAttributeKey = "vcs.repository.name"
+	CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineWorkerLabels CicdPipelineTaskRunActiveMetricAttributeKey = "cicd.pipeline.worker.labels"
+	CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineRunState     CicdPipelineTaskRunActiveMetricAttributeKey = "cicd.pipeline.run.state"
+)
+
+// CicdPipelineTaskRunActiveMetricConfig provides config for the cicd.pipeline.task.run.active metric.
+type CicdPipelineTaskRunActiveMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []CicdPipelineTaskRunActiveMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *CicdPipelineTaskRunActiveMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *CicdPipelineTaskRunActiveMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case CicdPipelineTaskRunActiveMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineRunState:
+		default:
+			return fmt.Errorf("metric cicd.pipeline.task.run.active doesn't have an attribute %v, valid attributes: [vcs.repository.name, cicd.pipeline.worker.labels, cicd.pipeline.run.state]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// CicdPipelineTaskRunCountMetricAttributeKey specifies the key of an attribute for the cicd.pipeline.task.run.count metric.
+type CicdPipelineTaskRunCountMetricAttributeKey string
+
+const (
+	CicdPipelineTaskRunCountMetricAttributeKeyVcsRepositoryName        CicdPipelineTaskRunCountMetricAttributeKey = "vcs.repository.name"
+	CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineName         CicdPipelineTaskRunCountMetricAttributeKey = "cicd.pipeline.name"
+	CicdPipelineTaskRunCountMetricAttributeKeyVcsRefHeadName           CicdPipelineTaskRunCountMetricAttributeKey = "vcs.ref.head.name"
+	CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineWorkerLabels CicdPipelineTaskRunCountMetricAttributeKey = "cicd.pipeline.worker.labels"
+	CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineResult       CicdPipelineTaskRunCountMetricAttributeKey = "cicd.pipeline.result"
+)
+
+// CicdPipelineTaskRunCountMetricConfig provides config for the cicd.pipeline.task.run.count metric.
+type CicdPipelineTaskRunCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                       `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []CicdPipelineTaskRunCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *CicdPipelineTaskRunCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *CicdPipelineTaskRunCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case CicdPipelineTaskRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineTaskRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineResult:
+		default:
+			return fmt.Errorf("metric cicd.pipeline.task.run.count doesn't have an attribute %v, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, cicd.pipeline.worker.labels, cicd.pipeline.result]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// CicdPipelineTestCaseCountMetricAttributeKey specifies the key of an attribute for the cicd.pipeline.test.case.count metric.
+type CicdPipelineTestCaseCountMetricAttributeKey string
+
+const (
+	CicdPipelineTestCaseCountMetricAttributeKeyVcsRepositoryName    CicdPipelineTestCaseCountMetricAttributeKey = "vcs.repository.name"
+	CicdPipelineTestCaseCountMetricAttributeKeyCicdPipelineName     CicdPipelineTestCaseCountMetricAttributeKey = "cicd.pipeline.name"
+	CicdPipelineTestCaseCountMetricAttributeKeyVcsRefHeadName       CicdPipelineTestCaseCountMetricAttributeKey = "vcs.ref.head.name"
+	CicdPipelineTestCaseCountMetricAttributeKeyTestCaseResultStatus CicdPipelineTestCaseCountMetricAttributeKey = "test.case.result.status"
+)
+
+// CicdPipelineTestCaseCountMetricConfig provides config for the cicd.pipeline.test.case.count metric.
+type CicdPipelineTestCaseCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []CicdPipelineTestCaseCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *CicdPipelineTestCaseCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *CicdPipelineTestCaseCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case CicdPipelineTestCaseCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseCountMetricAttributeKeyTestCaseResultStatus:
+		default:
+			return fmt.Errorf("metric cicd.pipeline.test.case.count doesn't have an attribute %v, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, test.case.result.status]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// CicdPipelineTestCaseFlakyCountMetricAttributeKey specifies the key of an attribute for the cicd.pipeline.test.case.flaky.count metric.
+type CicdPipelineTestCaseFlakyCountMetricAttributeKey string
+
+const (
+	CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRepositoryName CicdPipelineTestCaseFlakyCountMetricAttributeKey = "vcs.repository.name"
+	CicdPipelineTestCaseFlakyCountMetricAttributeKeyCicdPipelineName  CicdPipelineTestCaseFlakyCountMetricAttributeKey = "cicd.pipeline.name"
+	CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRefHeadName    CicdPipelineTestCaseFlakyCountMetricAttributeKey = "vcs.ref.head.name"
+	CicdPipelineTestCaseFlakyCountMetricAttributeKeyTestSuiteName     CicdPipelineTestCaseFlakyCountMetricAttributeKey = "test.suite.name"
+)
+
+// CicdPipelineTestCaseFlakyCountMetricConfig provides config for the cicd.pipeline.test.case.flaky.count metric.
+type CicdPipelineTestCaseFlakyCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                             `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []CicdPipelineTestCaseFlakyCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *CicdPipelineTestCaseFlakyCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *CicdPipelineTestCaseFlakyCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyTestSuiteName:
+		default:
+			return fmt.Errorf("metric cicd.pipeline.test.case.flaky.count doesn't have an attribute %v, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, test.suite.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// CicdWorkerCountMetricAttributeKey specifies the key of an attribute for the cicd.worker.count metric.
+type CicdWorkerCountMetricAttributeKey string
+
+const (
+	CicdWorkerCountMetricAttributeKeyCicdWorkerState     CicdWorkerCountMetricAttributeKey = "cicd.worker.state"
+	CicdWorkerCountMetricAttributeKeyCicdWorkerGroupName CicdWorkerCountMetricAttributeKey = "cicd.worker.group.name"
+	CicdWorkerCountMetricAttributeKeyVcsRepositoryName   CicdWorkerCountMetricAttributeKey = "vcs.repository.name"
+)
+
+// CicdWorkerCountMetricConfig provides config for the cicd.worker.count metric.
+type CicdWorkerCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                              `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []CicdWorkerCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *CicdWorkerCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *CicdWorkerCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case CicdWorkerCountMetricAttributeKeyCicdWorkerState, CicdWorkerCountMetricAttributeKeyCicdWorkerGroupName, CicdWorkerCountMetricAttributeKeyVcsRepositoryName:
+		default:
+			return fmt.Errorf("metric cicd.worker.count doesn't have an attribute %v, valid attributes: [cicd.worker.state, cicd.worker.group.name, vcs.repository.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// CicdWorkerLabelCountMetricAttributeKey specifies the key of an attribute for the cicd.worker.label.count metric.
+type CicdWorkerLabelCountMetricAttributeKey string
+
+const (
+	CicdWorkerLabelCountMetricAttributeKeyCicdWorkerState   CicdWorkerLabelCountMetricAttributeKey = "cicd.worker.state"
+	CicdWorkerLabelCountMetricAttributeKeyCicdWorkerLabel   CicdWorkerLabelCountMetricAttributeKey = "cicd.worker.label"
+	CicdWorkerLabelCountMetricAttributeKeyVcsRepositoryName CicdWorkerLabelCountMetricAttributeKey = "vcs.repository.name"
+)
+
+// CicdWorkerLabelCountMetricConfig provides config for the cicd.worker.label.count metric.
+type CicdWorkerLabelCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                   `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []CicdWorkerLabelCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *CicdWorkerLabelCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *CicdWorkerLabelCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case CicdWorkerLabelCountMetricAttributeKeyCicdWorkerState, CicdWorkerLabelCountMetricAttributeKeyCicdWorkerLabel, CicdWorkerLabelCountMetricAttributeKeyVcsRepositoryName:
+		default:
+			return fmt.Errorf("metric cicd.worker.label.count doesn't have an attribute %v, valid attributes: [cicd.worker.state, cicd.worker.label, vcs.repository.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// DeployDeploymentChangeFailureRateMetricAttributeKey specifies the key of an attribute for the deploy.deployment.change_failure_rate metric.
+type DeployDeploymentChangeFailureRateMetricAttributeKey string
+
+const (
+	DeployDeploymentChangeFailureRateMetricAttributeKeyServiceName               DeployDeploymentChangeFailureRateMetricAttributeKey = "service.name"
+	DeployDeploymentChangeFailureRateMetricAttributeKeyDeploymentEnvironmentName DeployDeploymentChangeFailureRateMetricAttributeKey = "deployment.environment.name"
+)
+
+// DeployDeploymentChangeFailureRateMetricConfig provides config for the deploy.deployment.change_failure_rate metric.
+type DeployDeploymentChangeFailureRateMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                                `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []DeployDeploymentChangeFailureRateMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *DeployDeploymentChangeFailureRateMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *DeployDeploymentChangeFailureRateMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case DeployDeploymentChangeFailureRateMetricAttributeKeyServiceName, DeployDeploymentChangeFailureRateMetricAttributeKeyDeploymentEnvironmentName:
+		default:
+			return fmt.Errorf("metric deploy.deployment.change_failure_rate doesn't have an attribute %v, valid attributes: [service.name, deployment.environment.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// DeployDeploymentCountMetricAttributeKey specifies the key of an attribute for the deploy.deployment.count metric.
+type DeployDeploymentCountMetricAttributeKey string
+
+const (
+	DeployDeploymentCountMetricAttributeKeyServiceName               DeployDeploymentCountMetricAttributeKey = "service.name"
+	DeployDeploymentCountMetricAttributeKeyDeploymentEnvironmentName DeployDeploymentCountMetricAttributeKey = "deployment.environment.name"
+	DeployDeploymentCountMetricAttributeKeyDeploymentStatus          DeployDeploymentCountMetricAttributeKey = "deployment.status"
+)
+
+// DeployDeploymentCountMetricConfig provides config for the deploy.deployment.count metric.
+type DeployDeploymentCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                    `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []DeployDeploymentCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *DeployDeploymentCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *DeployDeploymentCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case DeployDeploymentCountMetricAttributeKeyServiceName, DeployDeploymentCountMetricAttributeKeyDeploymentEnvironmentName, DeployDeploymentCountMetricAttributeKeyDeploymentStatus:
+		default:
+			return fmt.Errorf("metric deploy.deployment.count doesn't have an attribute %v, valid attributes: [service.name, deployment.environment.name, deployment.status]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// DeployDeploymentFrequencyMetricAttributeKey specifies the key of an attribute for the deploy.deployment.frequency metric.
+type DeployDeploymentFrequencyMetricAttributeKey string
+
+const (
+	DeployDeploymentFrequencyMetricAttributeKeyServiceName               DeployDeploymentFrequencyMetricAttributeKey = "service.name"
+	DeployDeploymentFrequencyMetricAttributeKeyDeploymentEnvironmentName DeployDeploymentFrequencyMetricAttributeKey = "deployment.environment.name"
+)
+
+// DeployDeploymentFrequencyMetricConfig provides config for the deploy.deployment.frequency metric.
+type DeployDeploymentFrequencyMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []DeployDeploymentFrequencyMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *DeployDeploymentFrequencyMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *DeployDeploymentFrequencyMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case DeployDeploymentFrequencyMetricAttributeKeyServiceName, DeployDeploymentFrequencyMetricAttributeKeyDeploymentEnvironmentName:
+		default:
+			return fmt.Errorf("metric deploy.deployment.frequency doesn't have an attribute %v, valid attributes: [service.name, deployment.environment.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// DeployDeploymentLeadTimeMetricAttributeKey specifies the key of an attribute for the deploy.deployment.lead_time metric.
+type DeployDeploymentLeadTimeMetricAttributeKey string
+
+const (
+	DeployDeploymentLeadTimeMetricAttributeKeyServiceName               DeployDeploymentLeadTimeMetricAttributeKey = "service.name"
+	DeployDeploymentLeadTimeMetricAttributeKeyDeploymentEnvironmentName DeployDeploymentLeadTimeMetricAttributeKey = "deployment.environment.name"
+)
+
+// DeployDeploymentLeadTimeMetricConfig provides config for the deploy.deployment.lead_time metric.
+type DeployDeploymentLeadTimeMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                       `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []DeployDeploymentLeadTimeMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *DeployDeploymentLeadTimeMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *DeployDeploymentLeadTimeMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case DeployDeploymentLeadTimeMetricAttributeKeyServiceName, DeployDeploymentLeadTimeMetricAttributeKeyDeploymentEnvironmentName:
+		default:
+			return fmt.Errorf("metric deploy.deployment.lead_time doesn't have an attribute %v, valid attributes: [service.name, deployment.environment.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// DeployDeploymentTimeToRestoreMetricAttributeKey specifies the key of an attribute for the deploy.deployment.time_to_restore metric.
+type DeployDeploymentTimeToRestoreMetricAttributeKey string
+
+const (
+	DeployDeploymentTimeToRestoreMetricAttributeKeyServiceName               DeployDeploymentTimeToRestoreMetricAttributeKey = "service.name"
+	DeployDeploymentTimeToRestoreMetricAttributeKeyDeploymentEnvironmentName DeployDeploymentTimeToRestoreMetricAttributeKey = "deployment.environment.name"
+)
+
+// DeployDeploymentTimeToRestoreMetricConfig provides config for the deploy.deployment.time_to_restore metric.
+type DeployDeploymentTimeToRestoreMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                            `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []DeployDeploymentTimeToRestoreMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *DeployDeploymentTimeToRestoreMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *DeployDeploymentTimeToRestoreMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case DeployDeploymentTimeToRestoreMetricAttributeKeyServiceName, DeployDeploymentTimeToRestoreMetricAttributeKeyDeploymentEnvironmentName:
+		default:
+			return fmt.Errorf("metric deploy.deployment.time_to_restore doesn't have an attribute %v, valid attributes: [service.name, deployment.environment.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // VcsChangeCountMetricAttributeKey specifies the key of an attribute for the vcs.change.count metric.
 type VcsChangeCountMetricAttributeKey string
 
@@ -18,16 +619,270 @@ const (
 	VcsChangeCountMetricAttributeKeyVcsRepositoryName    VcsChangeCountMetricAttributeKey = "vcs.repository.name"
 )
 
-// VcsChangeCountMetricConfig provides config for the vcs.change.count metric.
-type VcsChangeCountMetricConfig struct {
+// VcsChangeCountMetricConfig provides config for the vcs.change.count metric.
+type VcsChangeCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                             `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsChangeCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsChangeCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsChangeCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeCountMetricAttributeKeyVcsChangeState, VcsChangeCountMetricAttributeKeyVcsRepositoryName:
+		default:
+			return fmt.Errorf("metric vcs.change.count doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.change.state, vcs.repository.name]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VcsChangeDurationMetricAttributeKey specifies the key of an attribute for the vcs.change.duration metric.
+type VcsChangeDurationMetricAttributeKey string
+
+const (
+	VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull VcsChangeDurationMetricAttributeKey = "vcs.repository.url.full"
+	VcsChangeDurationMetricAttributeKeyVcsRepositoryName    VcsChangeDurationMetricAttributeKey = "vcs.repository.name"
+	VcsChangeDurationMetricAttributeKeyVcsRefHeadName       VcsChangeDurationMetricAttributeKey = "vcs.ref.head.name"
+	VcsChangeDurationMetricAttributeKeyVcsChangeState       VcsChangeDurationMetricAttributeKey = "vcs.change.state"
+)
+
+// VcsChangeDurationMetricConfig provides config for the vcs.change.duration metric.
+type VcsChangeDurationMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeDurationMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsChangeDurationMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsChangeDurationMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull, VcsChangeDurationMetricAttributeKeyVcsRepositoryName, VcsChangeDurationMetricAttributeKeyVcsRefHeadName, VcsChangeDurationMetricAttributeKeyVcsChangeState:
+		default:
+			return fmt.Errorf("metric vcs.change.duration doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VcsChangeReviewCommentCountMetricAttributeKey specifies the key of an attribute for the vcs.change.review.comment.count metric.
+type VcsChangeReviewCommentCountMetricAttributeKey string
+
+const (
+	VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryURLFull VcsChangeReviewCommentCountMetricAttributeKey = "vcs.repository.url.full"
+	VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryName    VcsChangeReviewCommentCountMetricAttributeKey = "vcs.repository.name"
+	VcsChangeReviewCommentCountMetricAttributeKeyVcsRefHeadName       VcsChangeReviewCommentCountMetricAttributeKey = "vcs.ref.head.name"
+	VcsChangeReviewCommentCountMetricAttributeKeyVcsChangeState       VcsChangeReviewCommentCountMetricAttributeKey = "vcs.change.state"
+)
+
+// VcsChangeReviewCommentCountMetricConfig provides config for the vcs.change.review.comment.count metric.
+type VcsChangeReviewCommentCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                          `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeReviewCommentCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsChangeReviewCommentCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsChangeReviewCommentCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewCommentCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewCommentCountMetricAttributeKeyVcsChangeState:
+		default:
+			return fmt.Errorf("metric vcs.change.review.comment.count doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VcsChangeReviewRoundCountMetricAttributeKey specifies the key of an attribute for the vcs.change.review.round.count metric.
+type VcsChangeReviewRoundCountMetricAttributeKey string
+
+const (
+	VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryURLFull VcsChangeReviewRoundCountMetricAttributeKey = "vcs.repository.url.full"
+	VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryName    VcsChangeReviewRoundCountMetricAttributeKey = "vcs.repository.name"
+	VcsChangeReviewRoundCountMetricAttributeKeyVcsRefHeadName       VcsChangeReviewRoundCountMetricAttributeKey = "vcs.ref.head.name"
+	VcsChangeReviewRoundCountMetricAttributeKeyVcsChangeState       VcsChangeReviewRoundCountMetricAttributeKey = "vcs.change.state"
+)
+
+// VcsChangeReviewRoundCountMetricConfig provides config for the vcs.change.review.round.count metric.
+type VcsChangeReviewRoundCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeReviewRoundCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsChangeReviewRoundCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsChangeReviewRoundCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewRoundCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewRoundCountMetricAttributeKeyVcsChangeState:
+		default:
+			return fmt.Errorf("metric vcs.change.review.round.count doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VcsChangeReviewerCountMetricAttributeKey specifies the key of an attribute for the vcs.change.reviewer.count metric.
+type VcsChangeReviewerCountMetricAttributeKey string
+
+const (
+	VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryURLFull VcsChangeReviewerCountMetricAttributeKey = "vcs.repository.url.full"
+	VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryName    VcsChangeReviewerCountMetricAttributeKey = "vcs.repository.name"
+	VcsChangeReviewerCountMetricAttributeKeyVcsRefHeadName       VcsChangeReviewerCountMetricAttributeKey = "vcs.ref.head.name"
+	VcsChangeReviewerCountMetricAttributeKeyVcsChangeState       VcsChangeReviewerCountMetricAttributeKey = "vcs.change.state"
+)
+
+// VcsChangeReviewerCountMetricConfig provides config for the vcs.change.reviewer.count metric.
+type VcsChangeReviewerCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                     `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeReviewerCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsChangeReviewerCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsChangeReviewerCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewerCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewerCountMetricAttributeKeyVcsChangeState:
+		default:
+			return fmt.Errorf("metric vcs.change.reviewer.count doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VcsChangeTimeFromApprovalToMergeMetricAttributeKey specifies the key of an attribute for the vcs.change.time_from_approval_to_merge metric.
+type VcsChangeTimeFromApprovalToMergeMetricAttributeKey string
+
+const (
+	VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryURLFull VcsChangeTimeFromApprovalToMergeMetricAttributeKey = "vcs.repository.url.full"
+	VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryName    VcsChangeTimeFromApprovalToMergeMetricAttributeKey = "vcs.repository.name"
+	VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRefHeadName       VcsChangeTimeFromApprovalToMergeMetricAttributeKey = "vcs.ref.head.name"
+)
+
+// VcsChangeTimeFromApprovalToMergeMetricConfig provides config for the vcs.change.time_from_approval_to_merge metric.
+type VcsChangeTimeFromApprovalToMergeMetricConfig struct {
 	Enabled          bool `mapstructure:"enabled"`
 	enabledSetByUser bool
 
-	AggregationStrategy string                             `mapstructure:"aggregation_strategy"`
-	EnabledAttributes   []VcsChangeCountMetricAttributeKey `mapstructure:"attributes"`
+	AggregationStrategy string                                               `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeTimeFromApprovalToMergeMetricAttributeKey `mapstructure:"attributes"`
 }
 
-func (ms *VcsChangeCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+func (ms *VcsChangeTimeFromApprovalToMergeMetricConfig) Unmarshal(parser *confmap.Conf) error {
 	if parser == nil {
 		return nil
 	}
@@ -41,12 +896,12 @@ func (ms *VcsChangeCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
 	return nil
 }
 
-func (ms *VcsChangeCountMetricConfig) Validate() error {
+func (ms *VcsChangeTimeFromApprovalToMergeMetricConfig) Validate() error {
 	for _, val := range ms.EnabledAttributes {
 		switch val {
-		case VcsChangeCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeCountMetricAttributeKeyVcsChangeState, VcsChangeCountMetricAttributeKeyVcsRepositoryName:
+		case VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryName, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRefHeadName:
 		default:
-			return fmt.Errorf("metric vcs.change.count doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.change.state, vcs.repository.name]", val)
+			return fmt.Errorf("metric vcs.change.time_from_approval_to_merge doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name]", val)
 		}
 	}
 
@@ -59,26 +914,25 @@ func (ms *VcsChangeCountMetricConfig) Validate() error {
 	return nil
 }
 
-// VcsChangeDurationMetricAttributeKey specifies the key of an attribute for the vcs.change.duration metric.
-type VcsChangeDurationMetricAttributeKey string
+// VcsChangeTimeToApprovalMetricAttributeKey specifies the key of an attribute for the vcs.change.time_to_approval metric.
+type VcsChangeTimeToApprovalMetricAttributeKey string
 
 const (
-	VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull VcsChangeDurationMetricAttributeKey = "vcs.repository.url.full"
-	VcsChangeDurationMetricAttributeKeyVcsRepositoryName    VcsChangeDurationMetricAttributeKey = "vcs.repository.name"
-	VcsChangeDurationMetricAttributeKeyVcsRefHeadName       VcsChangeDurationMetricAttributeKey = "vcs.ref.head.name"
-	VcsChangeDurationMetricAttributeKeyVcsChangeState       VcsChangeDurationMetricAttributeKey = "vcs.change.state"
+	VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull VcsChangeTimeToApprovalMetricAttributeKey = "vcs.repository.url.full"
+	VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName    VcsChangeTimeToApprovalMetricAttributeKey = "vcs.repository.name"
+	VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName       VcsChangeTimeToApprovalMetricAttributeKey = "vcs.ref.head.name"
 )
 
-// VcsChangeDurationMetricConfig provides config for the vcs.change.duration metric.
-type VcsChangeDurationMetricConfig struct {
+// VcsChangeTimeToApprovalMetricConfig provides config for the vcs.change.time_to_approval metric.
+type VcsChangeTimeToApprovalMetricConfig struct {
 	Enabled          bool `mapstructure:"enabled"`
 	enabledSetByUser bool
 
-	AggregationStrategy string                                `mapstructure:"aggregation_strategy"`
-	EnabledAttributes   []VcsChangeDurationMetricAttributeKey `mapstructure:"attributes"`
+	AggregationStrategy string                                      `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeTimeToApprovalMetricAttributeKey `mapstructure:"attributes"`
 }
 
-func (ms *VcsChangeDurationMetricConfig) Unmarshal(parser *confmap.Conf) error {
+func (ms *VcsChangeTimeToApprovalMetricConfig) Unmarshal(parser *confmap.Conf) error {
 	if parser == nil {
 		return nil
 	}
@@ -92,12 +946,12 @@ func (ms *VcsChangeDurationMetricConfig) Unmarshal(parser *confmap.Conf) error {
 	return nil
 }
 
-func (ms *VcsChangeDurationMetricConfig) Validate() error {
+func (ms *VcsChangeTimeToApprovalMetricConfig) Validate() error {
 	for _, val := range ms.EnabledAttributes {
 		switch val {
-		case VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull, VcsChangeDurationMetricAttributeKeyVcsRepositoryName, VcsChangeDurationMetricAttributeKeyVcsRefHeadName, VcsChangeDurationMetricAttributeKeyVcsChangeState:
+		case VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName:
 		default:
-			return fmt.Errorf("metric vcs.change.duration doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]", val)
+			return fmt.Errorf("metric vcs.change.time_to_approval doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name]", val)
 		}
 	}
 
@@ -110,25 +964,26 @@ func (ms *VcsChangeDurationMetricConfig) Validate() error {
 	return nil
 }
 
-// VcsChangeTimeToApprovalMetricAttributeKey specifies the key of an attribute for the vcs.change.time_to_approval metric.
-type VcsChangeTimeToApprovalMetricAttributeKey string
+// VcsChangeTimeToFirstReviewMetricAttributeKey specifies the key of an attribute for the vcs.change.time_to_first_review metric.
+type VcsChangeTimeToFirstReviewMetricAttributeKey string
 
 const (
-	VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull VcsChangeTimeToApprovalMetricAttributeKey = "vcs.repository.url.full"
-	VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName    VcsChangeTimeToApprovalMetricAttributeKey = "vcs.repository.name"
-	VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName       VcsChangeTimeToApprovalMetricAttributeKey = "vcs.ref.head.name"
+	VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryURLFull VcsChangeTimeToFirstReviewMetricAttributeKey = "vcs.repository.url.full"
+	VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryName    VcsChangeTimeToFirstReviewMetricAttributeKey = "vcs.repository.name"
+	VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRefHeadName       VcsChangeTimeToFirstReviewMetricAttributeKey = "vcs.ref.head.name"
+	VcsChangeTimeToFirstReviewMetricAttributeKeyVcsChangeState       VcsChangeTimeToFirstReviewMetricAttributeKey = "vcs.change.state"
 )
 
-// VcsChangeTimeToApprovalMetricConfig provides config for the vcs.change.time_to_approval metric.
-type VcsChangeTimeToApprovalMetricConfig struct {
+// VcsChangeTimeToFirstReviewMetricConfig provides config for the vcs.change.time_to_first_review metric.
+type VcsChangeTimeToFirstReviewMetricConfig struct {
 	Enabled          bool `mapstructure:"enabled"`
 	enabledSetByUser bool
 
-	AggregationStrategy string                                      `mapstructure:"aggregation_strategy"`
-	EnabledAttributes   []VcsChangeTimeToApprovalMetricAttributeKey `mapstructure:"attributes"`
+	AggregationStrategy string                                         `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsChangeTimeToFirstReviewMetricAttributeKey `mapstructure:"attributes"`
 }
 
-func (ms *VcsChangeTimeToApprovalMetricConfig) Unmarshal(parser *confmap.Conf) error {
+func (ms *VcsChangeTimeToFirstReviewMetricConfig) Unmarshal(parser *confmap.Conf) error {
 	if parser == nil {
 		return nil
 	}
@@ -142,12 +997,12 @@ func (ms *VcsChangeTimeToApprovalMetricConfig) Unmarshal(parser *confmap.Conf) e
 	return nil
 }
 
-func (ms *VcsChangeTimeToApprovalMetricConfig) Validate() error {
+func (ms *VcsChangeTimeToFirstReviewMetricConfig) Validate() error {
 	for _, val := range ms.EnabledAttributes {
 		switch val {
-		case VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName:
+		case VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRefHeadName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsChangeState:
 		default:
-			return fmt.Errorf("metric vcs.change.time_to_approval doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name]", val)
+			return fmt.Errorf("metric vcs.change.time_to_first_review doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]", val)
 		}
 	}
 
@@ -259,6 +1114,58 @@ func (ms *VcsContributorCountMetricConfig) Validate() error {
 	return nil
 }
 
+// VcsCveAgeMetricAttributeKey specifies the key of an attribute for the vcs.cve.age metric.
+type VcsCveAgeMetricAttributeKey string
+
+const (
+	VcsCveAgeMetricAttributeKeyVcsRepositoryURLFull VcsCveAgeMetricAttributeKey = "vcs.repository.url.full"
+	VcsCveAgeMetricAttributeKeyVcsRepositoryName    VcsCveAgeMetricAttributeKey = "vcs.repository.name"
+	VcsCveAgeMetricAttributeKeyCveSeverity          VcsCveAgeMetricAttributeKey = "cve.severity"
+	VcsCveAgeMetricAttributeKeyCveSource            VcsCveAgeMetricAttributeKey = "cve.source"
+	VcsCveAgeMetricAttributeKeyCvePackageEcosystem  VcsCveAgeMetricAttributeKey = "cve.package.ecosystem"
+)
+
+// VcsCveAgeMetricConfig provides config for the vcs.cve.age metric.
+type VcsCveAgeMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                        `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsCveAgeMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsCveAgeMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsCveAgeMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsCveAgeMetricAttributeKeyVcsRepositoryURLFull, VcsCveAgeMetricAttributeKeyVcsRepositoryName, VcsCveAgeMetricAttributeKeyCveSeverity, VcsCveAgeMetricAttributeKeyCveSource, VcsCveAgeMetricAttributeKeyCvePackageEcosystem:
+		default:
+			return fmt.Errorf("metric vcs.cve.age doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, cve.severity, cve.source, cve.package.ecosystem]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // VcsCveCountMetricAttributeKey specifies the key of an attribute for the vcs.cve.count metric.
 type VcsCveCountMetricAttributeKey string
 
@@ -309,6 +1216,59 @@ func (ms *VcsCveCountMetricConfig) Validate() error {
 	return nil
 }
 
+// VcsCveTimeToRemediateMetricAttributeKey specifies the key of an attribute for the vcs.cve.time_to_remediate metric.
+type VcsCveTimeToRemediateMetricAttributeKey string
+
+const (
+	VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryURLFull VcsCveTimeToRemediateMetricAttributeKey = "vcs.repository.url.full"
+	VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryName    VcsCveTimeToRemediateMetricAttributeKey = "vcs.repository.name"
+	VcsCveTimeToRemediateMetricAttributeKeyCveSeverity          VcsCveTimeToRemediateMetricAttributeKey = "cve.severity"
+	VcsCveTimeToRemediateMetricAttributeKeyCveSource            VcsCveTimeToRemediateMetricAttributeKey = "cve.source"
+	VcsCveTimeToRemediateMetricAttributeKeyCvePackageEcosystem  VcsCveTimeToRemediateMetricAttributeKey = "cve.package.ecosystem"
+	VcsCveTimeToRemediateMetricAttributeKeyCveResolution        VcsCveTimeToRemediateMetricAttributeKey = "cve.resolution"
+)
+
+// VcsCveTimeToRemediateMetricConfig provides config for the vcs.cve.time_to_remediate metric.
+type VcsCveTimeToRemediateMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                    `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsCveTimeToRemediateMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsCveTimeToRemediateMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsCveTimeToRemediateMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryURLFull, VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryName, VcsCveTimeToRemediateMetricAttributeKeyCveSeverity, VcsCveTimeToRemediateMetricAttributeKeyCveSource, VcsCveTimeToRemediateMetricAttributeKeyCvePackageEcosystem, VcsCveTimeToRemediateMetricAttributeKeyCveResolution:
+		default:
+			return fmt.Errorf("metric vcs.cve.time_to_remediate doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, cve.severity, cve.source, cve.package.ecosystem, cve.resolution]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // VcsRefCountMetricAttributeKey specifies the key of an attribute for the vcs.ref.count metric.
 type VcsRefCountMetricAttributeKey string
 
@@ -536,23 +1496,205 @@ func (ms *VcsRepositoryCountMetricConfig) Unmarshal(parser *confmap.Conf) error
 	return nil
 }
 
+// VcsSecretCountMetricAttributeKey specifies the key of an attribute for the vcs.secret.count metric.
+type VcsSecretCountMetricAttributeKey string
+
+const (
+	VcsSecretCountMetricAttributeKeyVcsRepositoryURLFull VcsSecretCountMetricAttributeKey = "vcs.repository.url.full"
+	VcsSecretCountMetricAttributeKeyVcsRepositoryName    VcsSecretCountMetricAttributeKey = "vcs.repository.name"
+	VcsSecretCountMetricAttributeKeySecretType           VcsSecretCountMetricAttributeKey = "secret.type"
+	VcsSecretCountMetricAttributeKeySecretValidity       VcsSecretCountMetricAttributeKey = "secret.validity"
+)
+
+// VcsSecretCountMetricConfig provides config for the vcs.secret.count metric.
+type VcsSecretCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                             `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsSecretCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsSecretCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsSecretCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsSecretCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretCountMetricAttributeKeyVcsRepositoryName, VcsSecretCountMetricAttributeKeySecretType, VcsSecretCountMetricAttributeKeySecretValidity:
+		default:
+			return fmt.Errorf("metric vcs.secret.count doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, secret.type, secret.validity]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
+// VcsSecretPushProtectionBypassCountMetricAttributeKey specifies the key of an attribute for the vcs.secret.push_protection_bypass.count metric.
+type VcsSecretPushProtectionBypassCountMetricAttributeKey string
+
+const (
+	VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryURLFull VcsSecretPushProtectionBypassCountMetricAttributeKey = "vcs.repository.url.full"
+	VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryName    VcsSecretPushProtectionBypassCountMetricAttributeKey = "vcs.repository.name"
+	VcsSecretPushProtectionBypassCountMetricAttributeKeySecretType           VcsSecretPushProtectionBypassCountMetricAttributeKey = "secret.type"
+)
+
+// VcsSecretPushProtectionBypassCountMetricConfig provides config for the vcs.secret.push_protection_bypass.count metric.
+type VcsSecretPushProtectionBypassCountMetricConfig struct {
+	Enabled          bool `mapstructure:"enabled"`
+	enabledSetByUser bool
+
+	AggregationStrategy string                                                 `mapstructure:"aggregation_strategy"`
+	EnabledAttributes   []VcsSecretPushProtectionBypassCountMetricAttributeKey `mapstructure:"attributes"`
+}
+
+func (ms *VcsSecretPushProtectionBypassCountMetricConfig) Unmarshal(parser *confmap.Conf) error {
+	if parser == nil {
+		return nil
+	}
+
+	err := parser.Unmarshal(ms)
+	if err != nil {
+		return err
+	}
+
+	ms.enabledSetByUser = parser.IsSet("enabled")
+	return nil
+}
+
+func (ms *VcsSecretPushProtectionBypassCountMetricConfig) Validate() error {
+	for _, val := range ms.EnabledAttributes {
+		switch val {
+		case VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryName, VcsSecretPushProtectionBypassCountMetricAttributeKeySecretType:
+		default:
+			return fmt.Errorf("metric vcs.secret.push_protection_bypass.count doesn't have an attribute %v, valid attributes: [vcs.repository.url.full, vcs.repository.name, secret.type]", val)
+		}
+	}
+
+	switch ms.AggregationStrategy {
+	case AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax:
+	default:
+		return fmt.Errorf("invalid aggregation strategy %q, valid strategies: [%s, %s, %s, %s]", ms.AggregationStrategy, AggregationStrategySum, AggregationStrategyAvg, AggregationStrategyMin, AggregationStrategyMax)
+	}
+
+	return nil
+}
+
 // MetricsConfig provides config for github metrics.
 type MetricsConfig struct {
-	VcsChangeCount          VcsChangeCountMetricConfig          `mapstructure:"vcs.change.count"`
-	VcsChangeDuration       VcsChangeDurationMetricConfig       `mapstructure:"vcs.change.duration"`
-	VcsChangeTimeToApproval VcsChangeTimeToApprovalMetricConfig `mapstructure:"vcs.change.time_to_approval"`
-	VcsChangeTimeToMerge    VcsChangeTimeToMergeMetricConfig    `mapstructure:"vcs.change.time_to_merge"`
-	VcsContributorCount     VcsContributorCountMetricConfig     `mapstructure:"vcs.contributor.count"`
-	VcsCveCount             VcsCveCountMetricConfig             `mapstructure:"vcs.cve.count"`
-	VcsRefCount             VcsRefCountMetricConfig             `mapstructure:"vcs.ref.count"`
-	VcsRefLinesDelta        VcsRefLinesDeltaMetricConfig        `mapstructure:"vcs.ref.lines_delta"`
-	VcsRefRevisionsDelta    VcsRefRevisionsDeltaMetricConfig    `mapstructure:"vcs.ref.revisions_delta"`
-	VcsRefTime              VcsRefTimeMetricConfig              `mapstructure:"vcs.ref.time"`
-	VcsRepositoryCount      VcsRepositoryCountMetricConfig      `mapstructure:"vcs.repository.count"`
+	CicdPipelineRunCount               CicdPipelineRunCountMetricConfig               `mapstructure:"cicd.pipeline.run.count"`
+	CicdPipelineTaskRunActive          CicdPipelineTaskRunActiveMetricConfig          `mapstructure:"cicd.pipeline.task.run.active"`
+	CicdPipelineTaskRunCount           CicdPipelineTaskRunCountMetricConfig           `mapstructure:"cicd.pipeline.task.run.count"`
+	CicdPipelineTestCaseCount          CicdPipelineTestCaseCountMetricConfig          `mapstructure:"cicd.pipeline.test.case.count"`
+	CicdPipelineTestCaseFlakyCount     CicdPipelineTestCaseFlakyCountMetricConfig     `mapstructure:"cicd.pipeline.test.case.flaky.count"`
+	CicdWorkerCount                    CicdWorkerCountMetricConfig                    `mapstructure:"cicd.worker.count"`
+	CicdWorkerLabelCount               CicdWorkerLabelCountMetricConfig               `mapstructure:"cicd.worker.label.count"`
+	DeployDeploymentChangeFailureRate  DeployDeploymentChangeFailureRateMetricConfig  `mapstructure:"deploy.deployment.change_failure_rate"`
+	DeployDeploymentCount              DeployDeploymentCountMetricConfig              `mapstructure:"deploy.deployment.count"`
+	DeployDeploymentFrequency          DeployDeploymentFrequencyMetricConfig          `mapstructure:"deploy.deployment.frequency"`
+	DeployDeploymentLeadTime           DeployDeploymentLeadTimeMetricConfig           `mapstructure:"deploy.deployment.lead_time"`
+	DeployDeploymentTimeToRestore      DeployDeploymentTimeToRestoreMetricConfig      `mapstructure:"deploy.deployment.time_to_restore"`
+	VcsChangeCount                     VcsChangeCountMetricConfig                     `mapstructure:"vcs.change.count"`
+	VcsChangeDuration                  VcsChangeDurationMetricConfig                  `mapstructure:"vcs.change.duration"`
+	VcsChangeReviewCommentCount        VcsChangeReviewCommentCountMetricConfig        `mapstructure:"vcs.change.review.comment.count"`
+	VcsChangeReviewRoundCount          VcsChangeReviewRoundCountMetricConfig          `mapstructure:"vcs.change.review.round.count"`
+	VcsChangeReviewerCount             VcsChangeReviewerCountMetricConfig             `mapstructure:"vcs.change.reviewer.count"`
+	VcsChangeTimeFromApprovalToMerge   VcsChangeTimeFromApprovalToMergeMetricConfig   `mapstructure:"vcs.change.time_from_approval_to_merge"`
+	VcsChangeTimeToApproval            VcsChangeTimeToApprovalMetricConfig            `mapstructure:"vcs.change.time_to_approval"`
+	VcsChangeTimeToFirstReview         VcsChangeTimeToFirstReviewMetricConfig         `mapstructure:"vcs.change.time_to_first_review"`
+	VcsChangeTimeToMerge               VcsChangeTimeToMergeMetricConfig               `mapstructure:"vcs.change.time_to_merge"`
+	VcsContributorCount                VcsContributorCountMetricConfig                `mapstructure:"vcs.contributor.count"`
+	VcsCveAge                          VcsCveAgeMetricConfig                          `mapstructure:"vcs.cve.age"`
+	VcsCveCount                        VcsCveCountMetricConfig                        `mapstructure:"vcs.cve.count"`
+	VcsCveTimeToRemediate              VcsCveTimeToRemediateMetricConfig              `mapstructure:"vcs.cve.time_to_remediate"`
+	VcsRefCount                        VcsRefCountMetricConfig                        `mapstructure:"vcs.ref.count"`
+	VcsRefLinesDelta                   VcsRefLinesDeltaMetricConfig                   `mapstructure:"vcs.ref.lines_delta"`
+	VcsRefRevisionsDelta               VcsRefRevisionsDeltaMetricConfig               `mapstructure:"vcs.ref.revisions_delta"`
+	VcsRefTime                         VcsRefTimeMetricConfig                         `mapstructure:"vcs.ref.time"`
+	VcsRepositoryCount                 VcsRepositoryCountMetricConfig                 `mapstructure:"vcs.repository.count"`
+	VcsSecretCount                     VcsSecretCountMetricConfig                     `mapstructure:"vcs.secret.count"`
+	VcsSecretPushProtectionBypassCount VcsSecretPushProtectionBypassCountMetricConfig `mapstructure:"vcs.secret.push_protection_bypass.count"`
 }
 
 func DefaultMetricsConfig() MetricsConfig {
 	return MetricsConfig{
+		CicdPipelineRunCount: CicdPipelineRunCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []CicdPipelineRunCountMetricAttributeKey{CicdPipelineRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineResult},
+		},
+		CicdPipelineTaskRunActive: CicdPipelineTaskRunActiveMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []CicdPipelineTaskRunActiveMetricAttributeKey{CicdPipelineTaskRunActiveMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineRunState},
+		},
+		CicdPipelineTaskRunCount: CicdPipelineTaskRunCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []CicdPipelineTaskRunCountMetricAttributeKey{CicdPipelineTaskRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineTaskRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineResult},
+		},
+		CicdPipelineTestCaseCount: CicdPipelineTestCaseCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []CicdPipelineTestCaseCountMetricAttributeKey{CicdPipelineTestCaseCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseCountMetricAttributeKeyTestCaseResultStatus},
+		},
+		CicdPipelineTestCaseFlakyCount: CicdPipelineTestCaseFlakyCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategySum,
+			EnabledAttributes:   []CicdPipelineTestCaseFlakyCountMetricAttributeKey{CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyTestSuiteName},
+		},
+		CicdWorkerCount: CicdWorkerCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []CicdWorkerCountMetricAttributeKey{CicdWorkerCountMetricAttributeKeyCicdWorkerState, CicdWorkerCountMetricAttributeKeyCicdWorkerGroupName, CicdWorkerCountMetricAttributeKeyVcsRepositoryName},
+		},
+		CicdWorkerLabelCount: CicdWorkerLabelCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []CicdWorkerLabelCountMetricAttributeKey{CicdWorkerLabelCountMetricAttributeKeyCicdWorkerState, CicdWorkerLabelCountMetricAttributeKeyCicdWorkerLabel, CicdWorkerLabelCountMetricAttributeKeyVcsRepositoryName},
+		},
+		DeployDeploymentChangeFailureRate: DeployDeploymentChangeFailureRateMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []DeployDeploymentChangeFailureRateMetricAttributeKey{DeployDeploymentChangeFailureRateMetricAttributeKeyServiceName, DeployDeploymentChangeFailureRateMetricAttributeKeyDeploymentEnvironmentName},
+		},
+		DeployDeploymentCount: DeployDeploymentCountMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []DeployDeploymentCountMetricAttributeKey{DeployDeploymentCountMetricAttributeKeyServiceName, DeployDeploymentCountMetricAttributeKeyDeploymentEnvironmentName, DeployDeploymentCountMetricAttributeKeyDeploymentStatus},
+		},
+		DeployDeploymentFrequency: DeployDeploymentFrequencyMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []DeployDeploymentFrequencyMetricAttributeKey{DeployDeploymentFrequencyMetricAttributeKeyServiceName, DeployDeploymentFrequencyMetricAttributeKeyDeploymentEnvironmentName},
+		},
+		DeployDeploymentLeadTime: DeployDeploymentLeadTimeMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []DeployDeploymentLeadTimeMetricAttributeKey{DeployDeploymentLeadTimeMetricAttributeKeyServiceName, DeployDeploymentLeadTimeMetricAttributeKeyDeploymentEnvironmentName},
+		},
+		DeployDeploymentTimeToRestore: DeployDeploymentTimeToRestoreMetricConfig{
+			Enabled:             true,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []DeployDeploymentTimeToRestoreMetricAttributeKey{DeployDeploymentTimeToRestoreMetricAttributeKeyServiceName, DeployDeploymentTimeToRestoreMetricAttributeKeyDeploymentEnvironmentName},
+		},
 		VcsChangeCount: VcsChangeCountMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
@@ -563,11 +1705,36 @@ func DefaultMetricsConfig() MetricsConfig {
 			AggregationStrategy: AggregationStrategyAvg,
 			EnabledAttributes:   []VcsChangeDurationMetricAttributeKey{VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull, VcsChangeDurationMetricAttributeKeyVcsRepositoryName, VcsChangeDurationMetricAttributeKeyVcsRefHeadName, VcsChangeDurationMetricAttributeKeyVcsChangeState},
 		},
+		VcsChangeReviewCommentCount: VcsChangeReviewCommentCountMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsChangeReviewCommentCountMetricAttributeKey{VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewCommentCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewCommentCountMetricAttributeKeyVcsChangeState},
+		},
+		VcsChangeReviewRoundCount: VcsChangeReviewRoundCountMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsChangeReviewRoundCountMetricAttributeKey{VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewRoundCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewRoundCountMetricAttributeKeyVcsChangeState},
+		},
+		VcsChangeReviewerCount: VcsChangeReviewerCountMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsChangeReviewerCountMetricAttributeKey{VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewerCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewerCountMetricAttributeKeyVcsChangeState},
+		},
+		VcsChangeTimeFromApprovalToMerge: VcsChangeTimeFromApprovalToMergeMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsChangeTimeFromApprovalToMergeMetricAttributeKey{VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryName, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRefHeadName},
+		},
 		VcsChangeTimeToApproval: VcsChangeTimeToApprovalMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
 			EnabledAttributes:   []VcsChangeTimeToApprovalMetricAttributeKey{VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName},
 		},
+		VcsChangeTimeToFirstReview: VcsChangeTimeToFirstReviewMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsChangeTimeToFirstReviewMetricAttributeKey{VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRefHeadName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsChangeState},
+		},
 		VcsChangeTimeToMerge: VcsChangeTimeToMergeMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
@@ -578,11 +1745,21 @@ func DefaultMetricsConfig() MetricsConfig {
 			AggregationStrategy: AggregationStrategyAvg,
 			EnabledAttributes:   []VcsContributorCountMetricAttributeKey{VcsContributorCountMetricAttributeKeyVcsRepositoryURLFull, VcsContributorCountMetricAttributeKeyVcsRepositoryName},
 		},
+		VcsCveAge: VcsCveAgeMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsCveAgeMetricAttributeKey{VcsCveAgeMetricAttributeKeyVcsRepositoryURLFull, VcsCveAgeMetricAttributeKeyVcsRepositoryName, VcsCveAgeMetricAttributeKeyCveSeverity, VcsCveAgeMetricAttributeKeyCveSource, VcsCveAgeMetricAttributeKeyCvePackageEcosystem},
+		},
 		VcsCveCount: VcsCveCountMetricConfig{
 			Enabled:             false,
 			AggregationStrategy: AggregationStrategyAvg,
 			EnabledAttributes:   []VcsCveCountMetricAttributeKey{VcsCveCountMetricAttributeKeyVcsRepositoryURLFull, VcsCveCountMetricAttributeKeyVcsRepositoryName, VcsCveCountMetricAttributeKeyCveSeverity},
 		},
+		VcsCveTimeToRemediate: VcsCveTimeToRemediateMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsCveTimeToRemediateMetricAttributeKey{VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryURLFull, VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryName, VcsCveTimeToRemediateMetricAttributeKeyCveSeverity, VcsCveTimeToRemediateMetricAttributeKeyCveSource, VcsCveTimeToRemediateMetricAttributeKeyCvePackageEcosystem, VcsCveTimeToRemediateMetricAttributeKeyCveResolution},
+		},
 		VcsRefCount: VcsRefCountMetricConfig{
 			Enabled:             true,
 			AggregationStrategy: AggregationStrategyAvg,
@@ -606,6 +1783,16 @@ func DefaultMetricsConfig() MetricsConfig {
 		VcsRepositoryCount: VcsRepositoryCountMetricConfig{
 			Enabled: true,
 		},
+		VcsSecretCount: VcsSecretCountMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsSecretCountMetricAttributeKey{VcsSecretCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretCountMetricAttributeKeyVcsRepositoryName, VcsSecretCountMetricAttributeKeySecretType, VcsSecretCountMetricAttributeKeySecretValidity},
+		},
+		VcsSecretPushProtectionBypassCount: VcsSecretPushProtectionBypassCountMetricConfig{
+			Enabled:             false,
+			AggregationStrategy: AggregationStrategyAvg,
+			EnabledAttributes:   []VcsSecretPushProtectionBypassCountMetricAttributeKey{VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryName, VcsSecretPushProtectionBypassCountMetricAttributeKeySecretType},
+		},
 	}
 }
 
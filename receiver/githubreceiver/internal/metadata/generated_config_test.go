@@ -26,6 +26,66 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "all_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					CicdPipelineRunCount: CicdPipelineRunCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineRunCountMetricAttributeKey{CicdPipelineRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineResult},
+					},
+					CicdPipelineTaskRunActive: CicdPipelineTaskRunActiveMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []CicdPipelineTaskRunActiveMetricAttributeKey{CicdPipelineTaskRunActiveMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineRunState},
+					},
+					CicdPipelineTaskRunCount: CicdPipelineTaskRunCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineTaskRunCountMetricAttributeKey{CicdPipelineTaskRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineTaskRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineResult},
+					},
+					CicdPipelineTestCaseCount: CicdPipelineTestCaseCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineTestCaseCountMetricAttributeKey{CicdPipelineTestCaseCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseCountMetricAttributeKeyTestCaseResultStatus},
+					},
+					CicdPipelineTestCaseFlakyCount: CicdPipelineTestCaseFlakyCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineTestCaseFlakyCountMetricAttributeKey{CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyTestSuiteName},
+					},
+					CicdWorkerCount: CicdWorkerCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []CicdWorkerCountMetricAttributeKey{CicdWorkerCountMetricAttributeKeyCicdWorkerState, CicdWorkerCountMetricAttributeKeyCicdWorkerGroupName, CicdWorkerCountMetricAttributeKeyVcsRepositoryName},
+					},
+					CicdWorkerLabelCount: CicdWorkerLabelCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []CicdWorkerLabelCountMetricAttributeKey{CicdWorkerLabelCountMetricAttributeKeyCicdWorkerState, CicdWorkerLabelCountMetricAttributeKeyCicdWorkerLabel, CicdWorkerLabelCountMetricAttributeKeyVcsRepositoryName},
+					},
+					DeployDeploymentChangeFailureRate: DeployDeploymentChangeFailureRateMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentChangeFailureRateMetricAttributeKey{DeployDeploymentChangeFailureRateMetricAttributeKeyServiceName, DeployDeploymentChangeFailureRateMetricAttributeKeyDeploymentEnvironmentName},
+					},
+					DeployDeploymentCount: DeployDeploymentCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentCountMetricAttributeKey{DeployDeploymentCountMetricAttributeKeyServiceName, DeployDeploymentCountMetricAttributeKeyDeploymentEnvironmentName, DeployDeploymentCountMetricAttributeKeyDeploymentStatus},
+					},
+					DeployDeploymentFrequency: DeployDeploymentFrequencyMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentFrequencyMetricAttributeKey{DeployDeploymentFrequencyMetricAttributeKeyServiceName, DeployDeploymentFrequencyMetricAttributeKeyDeploymentEnvironmentName},
+					},
+					DeployDeploymentLeadTime: DeployDeploymentLeadTimeMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentLeadTimeMetricAttributeKey{DeployDeploymentLeadTimeMetricAttributeKeyServiceName, DeployDeploymentLeadTimeMetricAttributeKeyDeploymentEnvironmentName},
+					},
+					DeployDeploymentTimeToRestore: DeployDeploymentTimeToRestoreMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentTimeToRestoreMetricAttributeKey{DeployDeploymentTimeToRestoreMetricAttributeKeyServiceName, DeployDeploymentTimeToRestoreMetricAttributeKeyDeploymentEnvironmentName},
+					},
 					VcsChangeCount: VcsChangeCountMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -36,11 +96,36 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsChangeDurationMetricAttributeKey{VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull, VcsChangeDurationMetricAttributeKeyVcsRepositoryName, VcsChangeDurationMetricAttributeKeyVcsRefHeadName, VcsChangeDurationMetricAttributeKeyVcsChangeState},
 					},
+					VcsChangeReviewCommentCount: VcsChangeReviewCommentCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeReviewCommentCountMetricAttributeKey{VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewCommentCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewCommentCountMetricAttributeKeyVcsChangeState},
+					},
+					VcsChangeReviewRoundCount: VcsChangeReviewRoundCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeReviewRoundCountMetricAttributeKey{VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewRoundCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewRoundCountMetricAttributeKeyVcsChangeState},
+					},
+					VcsChangeReviewerCount: VcsChangeReviewerCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeReviewerCountMetricAttributeKey{VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewerCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewerCountMetricAttributeKeyVcsChangeState},
+					},
+					VcsChangeTimeFromApprovalToMerge: VcsChangeTimeFromApprovalToMergeMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeTimeFromApprovalToMergeMetricAttributeKey{VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryName, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRefHeadName},
+					},
 					VcsChangeTimeToApproval: VcsChangeTimeToApprovalMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsChangeTimeToApprovalMetricAttributeKey{VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName},
 					},
+					VcsChangeTimeToFirstReview: VcsChangeTimeToFirstReviewMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeTimeToFirstReviewMetricAttributeKey{VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRefHeadName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsChangeState},
+					},
 					VcsChangeTimeToMerge: VcsChangeTimeToMergeMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -51,11 +136,21 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsContributorCountMetricAttributeKey{VcsContributorCountMetricAttributeKeyVcsRepositoryURLFull, VcsContributorCountMetricAttributeKeyVcsRepositoryName},
 					},
+					VcsCveAge: VcsCveAgeMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsCveAgeMetricAttributeKey{VcsCveAgeMetricAttributeKeyVcsRepositoryURLFull, VcsCveAgeMetricAttributeKeyVcsRepositoryName, VcsCveAgeMetricAttributeKeyCveSeverity, VcsCveAgeMetricAttributeKeyCveSource, VcsCveAgeMetricAttributeKeyCvePackageEcosystem},
+					},
 					VcsCveCount: VcsCveCountMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsCveCountMetricAttributeKey{VcsCveCountMetricAttributeKeyVcsRepositoryURLFull, VcsCveCountMetricAttributeKeyVcsRepositoryName, VcsCveCountMetricAttributeKeyCveSeverity},
 					},
+					VcsCveTimeToRemediate: VcsCveTimeToRemediateMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsCveTimeToRemediateMetricAttributeKey{VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryURLFull, VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryName, VcsCveTimeToRemediateMetricAttributeKeyCveSeverity, VcsCveTimeToRemediateMetricAttributeKeyCveSource, VcsCveTimeToRemediateMetricAttributeKeyCvePackageEcosystem, VcsCveTimeToRemediateMetricAttributeKeyCveResolution},
+					},
 					VcsRefCount: VcsRefCountMetricConfig{
 						Enabled:             true,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -79,6 +174,16 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcsRepositoryCount: VcsRepositoryCountMetricConfig{
 						Enabled: true,
 					},
+					VcsSecretCount: VcsSecretCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsSecretCountMetricAttributeKey{VcsSecretCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretCountMetricAttributeKeyVcsRepositoryName, VcsSecretCountMetricAttributeKeySecretType, VcsSecretCountMetricAttributeKeySecretValidity},
+					},
+					VcsSecretPushProtectionBypassCount: VcsSecretPushProtectionBypassCountMetricConfig{
+						Enabled:             true,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsSecretPushProtectionBypassCountMetricAttributeKey{VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryName, VcsSecretPushProtectionBypassCountMetricAttributeKeySecretType},
+					},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					OrganizationName: ResourceAttributeConfig{Enabled: true},
@@ -91,6 +196,66 @@ func TestMetricsBuilderConfig(t *testing.T) {
 			name: "none_set",
 			want: MetricsBuilderConfig{
 				Metrics: MetricsConfig{
+					CicdPipelineRunCount: CicdPipelineRunCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineRunCountMetricAttributeKey{CicdPipelineRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineRunCountMetricAttributeKeyCicdPipelineResult},
+					},
+					CicdPipelineTaskRunActive: CicdPipelineTaskRunActiveMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []CicdPipelineTaskRunActiveMetricAttributeKey{CicdPipelineTaskRunActiveMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineRunState},
+					},
+					CicdPipelineTaskRunCount: CicdPipelineTaskRunCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineTaskRunCountMetricAttributeKey{CicdPipelineTaskRunCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineName, CicdPipelineTaskRunCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineWorkerLabels, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineResult},
+					},
+					CicdPipelineTestCaseCount: CicdPipelineTestCaseCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineTestCaseCountMetricAttributeKey{CicdPipelineTestCaseCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseCountMetricAttributeKeyTestCaseResultStatus},
+					},
+					CicdPipelineTestCaseFlakyCount: CicdPipelineTestCaseFlakyCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategySum,
+						EnabledAttributes:   []CicdPipelineTestCaseFlakyCountMetricAttributeKey{CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRepositoryName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyCicdPipelineName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRefHeadName, CicdPipelineTestCaseFlakyCountMetricAttributeKeyTestSuiteName},
+					},
+					CicdWorkerCount: CicdWorkerCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []CicdWorkerCountMetricAttributeKey{CicdWorkerCountMetricAttributeKeyCicdWorkerState, CicdWorkerCountMetricAttributeKeyCicdWorkerGroupName, CicdWorkerCountMetricAttributeKeyVcsRepositoryName},
+					},
+					CicdWorkerLabelCount: CicdWorkerLabelCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []CicdWorkerLabelCountMetricAttributeKey{CicdWorkerLabelCountMetricAttributeKeyCicdWorkerState, CicdWorkerLabelCountMetricAttributeKeyCicdWorkerLabel, CicdWorkerLabelCountMetricAttributeKeyVcsRepositoryName},
+					},
+					DeployDeploymentChangeFailureRate: DeployDeploymentChangeFailureRateMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentChangeFailureRateMetricAttributeKey{DeployDeploymentChangeFailureRateMetricAttributeKeyServiceName, DeployDeploymentChangeFailureRateMetricAttributeKeyDeploymentEnvironmentName},
+					},
+					DeployDeploymentCount: DeployDeploymentCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentCountMetricAttributeKey{DeployDeploymentCountMetricAttributeKeyServiceName, DeployDeploymentCountMetricAttributeKeyDeploymentEnvironmentName, DeployDeploymentCountMetricAttributeKeyDeploymentStatus},
+					},
+					DeployDeploymentFrequency: DeployDeploymentFrequencyMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentFrequencyMetricAttributeKey{DeployDeploymentFrequencyMetricAttributeKeyServiceName, DeployDeploymentFrequencyMetricAttributeKeyDeploymentEnvironmentName},
+					},
+					DeployDeploymentLeadTime: DeployDeploymentLeadTimeMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentLeadTimeMetricAttributeKey{DeployDeploymentLeadTimeMetricAttributeKeyServiceName, DeployDeploymentLeadTimeMetricAttributeKeyDeploymentEnvironmentName},
+					},
+					DeployDeploymentTimeToRestore: DeployDeploymentTimeToRestoreMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []DeployDeploymentTimeToRestoreMetricAttributeKey{DeployDeploymentTimeToRestoreMetricAttributeKeyServiceName, DeployDeploymentTimeToRestoreMetricAttributeKeyDeploymentEnvironmentName},
+					},
 					VcsChangeCount: VcsChangeCountMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -101,11 +266,36 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsChangeDurationMetricAttributeKey{VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull, VcsChangeDurationMetricAttributeKeyVcsRepositoryName, VcsChangeDurationMetricAttributeKeyVcsRefHeadName, VcsChangeDurationMetricAttributeKeyVcsChangeState},
 					},
+					VcsChangeReviewCommentCount: VcsChangeReviewCommentCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeReviewCommentCountMetricAttributeKey{VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewCommentCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewCommentCountMetricAttributeKeyVcsChangeState},
+					},
+					VcsChangeReviewRoundCount: VcsChangeReviewRoundCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeReviewRoundCountMetricAttributeKey{VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewRoundCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewRoundCountMetricAttributeKeyVcsChangeState},
+					},
+					VcsChangeReviewerCount: VcsChangeReviewerCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeReviewerCountMetricAttributeKey{VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryURLFull, VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryName, VcsChangeReviewerCountMetricAttributeKeyVcsRefHeadName, VcsChangeReviewerCountMetricAttributeKeyVcsChangeState},
+					},
+					VcsChangeTimeFromApprovalToMerge: VcsChangeTimeFromApprovalToMergeMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeTimeFromApprovalToMergeMetricAttributeKey{VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryName, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRefHeadName},
+					},
 					VcsChangeTimeToApproval: VcsChangeTimeToApprovalMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsChangeTimeToApprovalMetricAttributeKey{VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName},
 					},
+					VcsChangeTimeToFirstReview: VcsChangeTimeToFirstReviewMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsChangeTimeToFirstReviewMetricAttributeKey{VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryURLFull, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRefHeadName, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsChangeState},
+					},
 					VcsChangeTimeToMerge: VcsChangeTimeToMergeMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -116,11 +306,21 @@ func TestMetricsBuilderConfig(t *testing.T) {
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsContributorCountMetricAttributeKey{VcsContributorCountMetricAttributeKeyVcsRepositoryURLFull, VcsContributorCountMetricAttributeKeyVcsRepositoryName},
 					},
+					VcsCveAge: VcsCveAgeMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsCveAgeMetricAttributeKey{VcsCveAgeMetricAttributeKeyVcsRepositoryURLFull, VcsCveAgeMetricAttributeKeyVcsRepositoryName, VcsCveAgeMetricAttributeKeyCveSeverity, VcsCveAgeMetricAttributeKeyCveSource, VcsCveAgeMetricAttributeKeyCvePackageEcosystem},
+					},
 					VcsCveCount: VcsCveCountMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
 						EnabledAttributes:   []VcsCveCountMetricAttributeKey{VcsCveCountMetricAttributeKeyVcsRepositoryURLFull, VcsCveCountMetricAttributeKeyVcsRepositoryName, VcsCveCountMetricAttributeKeyCveSeverity},
 					},
+					VcsCveTimeToRemediate: VcsCveTimeToRemediateMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsCveTimeToRemediateMetricAttributeKey{VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryURLFull, VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryName, VcsCveTimeToRemediateMetricAttributeKeyCveSeverity, VcsCveTimeToRemediateMetricAttributeKeyCveSource, VcsCveTimeToRemediateMetricAttributeKeyCvePackageEcosystem, VcsCveTimeToRemediateMetricAttributeKeyCveResolution},
+					},
 					VcsRefCount: VcsRefCountMetricConfig{
 						Enabled:             false,
 						AggregationStrategy: AggregationStrategyAvg,
@@ -144,6 +344,16 @@ func TestMetricsBuilderConfig(t *testing.T) {
 					VcsRepositoryCount: VcsRepositoryCountMetricConfig{
 						Enabled: false,
 					},
+					VcsSecretCount: VcsSecretCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsSecretCountMetricAttributeKey{VcsSecretCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretCountMetricAttributeKeyVcsRepositoryName, VcsSecretCountMetricAttributeKeySecretType, VcsSecretCountMetricAttributeKeySecretValidity},
+					},
+					VcsSecretPushProtectionBypassCount: VcsSecretPushProtectionBypassCountMetricConfig{
+						Enabled:             false,
+						AggregationStrategy: AggregationStrategyAvg,
+						EnabledAttributes:   []VcsSecretPushProtectionBypassCountMetricAttributeKey{VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryURLFull, VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryName, VcsSecretPushProtectionBypassCountMetricAttributeKeySecretType},
+					},
 				},
 				ResourceAttributes: ResourceAttributesConfig{
 					OrganizationName: ResourceAttributeConfig{Enabled: false},
@@ -156,11 +366,155 @@ func TestMetricsBuilderConfig(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			cfg := loadMetricsBuilderConfig(t, tt.name)
-			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(VcsChangeCountMetricConfig{}, VcsChangeDurationMetricConfig{}, VcsChangeTimeToApprovalMetricConfig{}, VcsChangeTimeToMergeMetricConfig{}, VcsContributorCountMetricConfig{}, VcsCveCountMetricConfig{}, VcsRefCountMetricConfig{}, VcsRefLinesDeltaMetricConfig{}, VcsRefRevisionsDeltaMetricConfig{}, VcsRefTimeMetricConfig{}, VcsRepositoryCountMetricConfig{}, ResourceAttributeConfig{}))
+			diff := cmp.Diff(tt.want, cfg, cmpopts.IgnoreUnexported(CicdPipelineRunCountMetricConfig{}, CicdPipelineTaskRunActiveMetricConfig{}, CicdPipelineTaskRunCountMetricConfig{}, CicdPipelineTestCaseCountMetricConfig{}, CicdPipelineTestCaseFlakyCountMetricConfig{}, CicdWorkerCountMetricConfig{}, CicdWorkerLabelCountMetricConfig{}, DeployDeploymentChangeFailureRateMetricConfig{}, DeployDeploymentCountMetricConfig{}, DeployDeploymentFrequencyMetricConfig{}, DeployDeploymentLeadTimeMetricConfig{}, DeployDeploymentTimeToRestoreMetricConfig{}, VcsChangeCountMetricConfig{}, VcsChangeDurationMetricConfig{}, VcsChangeReviewCommentCountMetricConfig{}, VcsChangeReviewRoundCountMetricConfig{}, VcsChangeReviewerCountMetricConfig{}, VcsChangeTimeFromApprovalToMergeMetricConfig{}, VcsChangeTimeToApprovalMetricConfig{}, VcsChangeTimeToFirstReviewMetricConfig{}, VcsChangeTimeToMergeMetricConfig{}, VcsContributorCountMetricConfig{}, VcsCveAgeMetricConfig{}, VcsCveCountMetricConfig{}, VcsCveTimeToRemediateMetricConfig{}, VcsRefCountMetricConfig{}, VcsRefLinesDeltaMetricConfig{}, VcsRefRevisionsDeltaMetricConfig{}, VcsRefTimeMetricConfig{}, VcsRepositoryCountMetricConfig{}, VcsSecretCountMetricConfig{}, VcsSecretPushProtectionBypassCountMetricConfig{}, ResourceAttributeConfig{}))
 			require.Emptyf(t, diff, "Config mismatch (-expected +actual):\n%s", diff)
 		})
 	}
 }
+func TestCicdPipelineRunCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().CicdPipelineRunCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []CicdPipelineRunCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric cicd.pipeline.run.count doesn't have an attribute invalid, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, cicd.pipeline.result]")
+
+	cfg = DefaultMetricsConfig().CicdPipelineRunCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestCicdPipelineTaskRunActiveMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().CicdPipelineTaskRunActive
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []CicdPipelineTaskRunActiveMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric cicd.pipeline.task.run.active doesn't have an attribute invalid, valid attributes: [vcs.repository.name, cicd.pipeline.worker.labels, cicd.pipeline.run.state]")
+
+	cfg = DefaultMetricsConfig().CicdPipelineTaskRunActive
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestCicdPipelineTaskRunCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().CicdPipelineTaskRunCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []CicdPipelineTaskRunCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric cicd.pipeline.task.run.count doesn't have an attribute invalid, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, cicd.pipeline.worker.labels, cicd.pipeline.result]")
+
+	cfg = DefaultMetricsConfig().CicdPipelineTaskRunCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestCicdPipelineTestCaseCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().CicdPipelineTestCaseCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []CicdPipelineTestCaseCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric cicd.pipeline.test.case.count doesn't have an attribute invalid, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, test.case.result.status]")
+
+	cfg = DefaultMetricsConfig().CicdPipelineTestCaseCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestCicdPipelineTestCaseFlakyCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().CicdPipelineTestCaseFlakyCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []CicdPipelineTestCaseFlakyCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric cicd.pipeline.test.case.flaky.count doesn't have an attribute invalid, valid attributes: [vcs.repository.name, cicd.pipeline.name, vcs.ref.head.name, test.suite.name]")
+
+	cfg = DefaultMetricsConfig().CicdPipelineTestCaseFlakyCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestCicdWorkerCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().CicdWorkerCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []CicdWorkerCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric cicd.worker.count doesn't have an attribute invalid, valid attributes: [cicd.worker.state, cicd.worker.group.name, vcs.repository.name]")
+
+	cfg = DefaultMetricsConfig().CicdWorkerCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestCicdWorkerLabelCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().CicdWorkerLabelCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []CicdWorkerLabelCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric cicd.worker.label.count doesn't have an attribute invalid, valid attributes: [cicd.worker.state, cicd.worker.label, vcs.repository.name]")
+
+	cfg = DefaultMetricsConfig().CicdWorkerLabelCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestDeployDeploymentChangeFailureRateMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().DeployDeploymentChangeFailureRate
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []DeployDeploymentChangeFailureRateMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric deploy.deployment.change_failure_rate doesn't have an attribute invalid, valid attributes: [service.name, deployment.environment.name]")
+
+	cfg = DefaultMetricsConfig().DeployDeploymentChangeFailureRate
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestDeployDeploymentCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().DeployDeploymentCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []DeployDeploymentCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric deploy.deployment.count doesn't have an attribute invalid, valid attributes: [service.name, deployment.environment.name, deployment.status]")
+
+	cfg = DefaultMetricsConfig().DeployDeploymentCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestDeployDeploymentFrequencyMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().DeployDeploymentFrequency
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []DeployDeploymentFrequencyMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric deploy.deployment.frequency doesn't have an attribute invalid, valid attributes: [service.name, deployment.environment.name]")
+
+	cfg = DefaultMetricsConfig().DeployDeploymentFrequency
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestDeployDeploymentLeadTimeMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().DeployDeploymentLeadTime
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []DeployDeploymentLeadTimeMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric deploy.deployment.lead_time doesn't have an attribute invalid, valid attributes: [service.name, deployment.environment.name]")
+
+	cfg = DefaultMetricsConfig().DeployDeploymentLeadTime
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestDeployDeploymentTimeToRestoreMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().DeployDeploymentTimeToRestore
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []DeployDeploymentTimeToRestoreMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric deploy.deployment.time_to_restore doesn't have an attribute invalid, valid attributes: [service.name, deployment.environment.name]")
+
+	cfg = DefaultMetricsConfig().DeployDeploymentTimeToRestore
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestVcsChangeCountMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().VcsChangeCount
 	require.NoError(t, cfg.Validate())
@@ -185,6 +539,54 @@ func TestVcsChangeDurationMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestVcsChangeReviewCommentCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsChangeReviewCommentCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsChangeReviewCommentCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.change.review.comment.count doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]")
+
+	cfg = DefaultMetricsConfig().VcsChangeReviewCommentCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVcsChangeReviewRoundCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsChangeReviewRoundCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsChangeReviewRoundCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.change.review.round.count doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]")
+
+	cfg = DefaultMetricsConfig().VcsChangeReviewRoundCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVcsChangeReviewerCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsChangeReviewerCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsChangeReviewerCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.change.reviewer.count doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]")
+
+	cfg = DefaultMetricsConfig().VcsChangeReviewerCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVcsChangeTimeFromApprovalToMergeMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsChangeTimeFromApprovalToMerge
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsChangeTimeFromApprovalToMergeMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.change.time_from_approval_to_merge doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name]")
+
+	cfg = DefaultMetricsConfig().VcsChangeTimeFromApprovalToMerge
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestVcsChangeTimeToApprovalMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().VcsChangeTimeToApproval
 	require.NoError(t, cfg.Validate())
@@ -197,6 +599,18 @@ func TestVcsChangeTimeToApprovalMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestVcsChangeTimeToFirstReviewMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsChangeTimeToFirstReview
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsChangeTimeToFirstReviewMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.change.time_to_first_review doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, vcs.ref.head.name, vcs.change.state]")
+
+	cfg = DefaultMetricsConfig().VcsChangeTimeToFirstReview
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestVcsChangeTimeToMergeMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().VcsChangeTimeToMerge
 	require.NoError(t, cfg.Validate())
@@ -221,6 +635,18 @@ func TestVcsContributorCountMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestVcsCveAgeMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsCveAge
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsCveAgeMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.cve.age doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, cve.severity, cve.source, cve.package.ecosystem]")
+
+	cfg = DefaultMetricsConfig().VcsCveAge
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestVcsCveCountMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().VcsCveCount
 	require.NoError(t, cfg.Validate())
@@ -233,6 +659,18 @@ func TestVcsCveCountMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestVcsCveTimeToRemediateMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsCveTimeToRemediate
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsCveTimeToRemediateMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.cve.time_to_remediate doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, cve.severity, cve.source, cve.package.ecosystem, cve.resolution]")
+
+	cfg = DefaultMetricsConfig().VcsCveTimeToRemediate
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func TestVcsRefCountMetricsConfig_Validate(t *testing.T) {
 	cfg := DefaultMetricsConfig().VcsRefCount
 	require.NoError(t, cfg.Validate())
@@ -281,6 +719,30 @@ func TestVcsRefTimeMetricsConfig_Validate(t *testing.T) {
 	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
 }
 
+func TestVcsSecretCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsSecretCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsSecretCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.secret.count doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, secret.type, secret.validity]")
+
+	cfg = DefaultMetricsConfig().VcsSecretCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
+func TestVcsSecretPushProtectionBypassCountMetricsConfig_Validate(t *testing.T) {
+	cfg := DefaultMetricsConfig().VcsSecretPushProtectionBypassCount
+	require.NoError(t, cfg.Validate())
+
+	cfg.EnabledAttributes = []VcsSecretPushProtectionBypassCountMetricAttributeKey{"invalid"}
+	require.ErrorContains(t, cfg.Validate(), "metric vcs.secret.push_protection_bypass.count doesn't have an attribute invalid, valid attributes: [vcs.repository.url.full, vcs.repository.name, secret.type]")
+
+	cfg = DefaultMetricsConfig().VcsSecretPushProtectionBypassCount
+	cfg.AggregationStrategy = "invalid"
+	require.ErrorContains(t, cfg.Validate(), "invalid aggregation strategy")
+}
+
 func loadMetricsBuilderConfig(t *testing.T, name string) MetricsBuilderConfig {
 	cm, err := confmaptest.LoadConf(filepath.Join("testdata", "config.yaml"))
 	require.NoError(t, err)
@@ -21,6 +21,88 @@ const (
 	AggregationStrategyMax = "max"
 )
 
+// AttributeCicdPipelineRunState specifies the value cicd.pipeline.run.state attribute.
+type AttributeCicdPipelineRunState int
+
+const (
+	_ AttributeCicdPipelineRunState = iota
+	AttributeCicdPipelineRunStatePending
+	AttributeCicdPipelineRunStateExecuting
+)
+
+// String returns the string representation of the AttributeCicdPipelineRunState.
+func (av AttributeCicdPipelineRunState) String() string {
+	switch av {
+	case AttributeCicdPipelineRunStatePending:
+		return "pending"
+	case AttributeCicdPipelineRunStateExecuting:
+		return "executing"
+	}
+	return ""
+}
+
+// MapAttributeCicdPipelineRunState is a helper map of string to AttributeCicdPipelineRunState attribute value.
+var MapAttributeCicdPipelineRunState = map[string]AttributeCicdPipelineRunState{
+	"pending":   AttributeCicdPipelineRunStatePending,
+	"executing": AttributeCicdPipelineRunStateExecuting,
+}
+
+// AttributeCicdWorkerState specifies the value cicd.worker.state attribute.
+type AttributeCicdWorkerState int
+
+const (
+	_ AttributeCicdWorkerState = iota
+	AttributeCicdWorkerStateAvailable
+	AttributeCicdWorkerStateBusy
+	AttributeCicdWorkerStateOffline
+)
+
+// String returns the string representation of the AttributeCicdWorkerState.
+func (av AttributeCicdWorkerState) String() string {
+	switch av {
+	case AttributeCicdWorkerStateAvailable:
+		return "available"
+	case AttributeCicdWorkerStateBusy:
+		return "busy"
+	case AttributeCicdWorkerStateOffline:
+		return "offline"
+	}
+	return ""
+}
+
+// MapAttributeCicdWorkerState is a helper map of string to AttributeCicdWorkerState attribute value.
+var MapAttributeCicdWorkerState = map[string]AttributeCicdWorkerState{
+	"available": AttributeCicdWorkerStateAvailable,
+	"busy":      AttributeCicdWorkerStateBusy,
+	"offline":   AttributeCicdWorkerStateOffline,
+}
+
+// AttributeCveResolution specifies the value cve.resolution attribute.
+type AttributeCveResolution int
+
+const (
+	_ AttributeCveResolution = iota
+	AttributeCveResolutionFixed
+	AttributeCveResolutionDismissed
+)
+
+// String returns the string representation of the AttributeCveResolution.
+func (av AttributeCveResolution) String() string {
+	switch av {
+	case AttributeCveResolutionFixed:
+		return "fixed"
+	case AttributeCveResolutionDismissed:
+		return "dismissed"
+	}
+	return ""
+}
+
+// MapAttributeCveResolution is a helper map of string to AttributeCveResolution attribute value.
+var MapAttributeCveResolution = map[string]AttributeCveResolution{
+	"fixed":     AttributeCveResolutionFixed,
+	"dismissed": AttributeCveResolutionDismissed,
+}
+
 // AttributeCveSeverity specifies the value cve.severity attribute.
 type AttributeCveSeverity int
 
@@ -59,6 +141,118 @@ var MapAttributeCveSeverity = map[string]AttributeCveSeverity{
 	"none":     AttributeCveSeverityNone,
 }
 
+// AttributeCveSource specifies the value cve.source attribute.
+type AttributeCveSource int
+
+const (
+	_ AttributeCveSource = iota
+	AttributeCveSourceDependabot
+	AttributeCveSourceCodeScanning
+)
+
+// String returns the string representation of the AttributeCveSource.
+func (av AttributeCveSource) String() string {
+	switch av {
+	case AttributeCveSourceDependabot:
+		return "dependabot"
+	case AttributeCveSourceCodeScanning:
+		return "code_scanning"
+	}
+	return ""
+}
+
+// MapAttributeCveSource is a helper map of string to AttributeCveSource attribute value.
+var MapAttributeCveSource = map[string]AttributeCveSource{
+	"dependabot":    AttributeCveSourceDependabot,
+	"code_scanning": AttributeCveSourceCodeScanning,
+}
+
+// AttributeDeploymentStatus specifies the value deployment.status attribute.
+type AttributeDeploymentStatus int
+
+const (
+	_ AttributeDeploymentStatus = iota
+	AttributeDeploymentStatusSucceeded
+	AttributeDeploymentStatusFailed
+)
+
+// String returns the string representation of the AttributeDeploymentStatus.
+func (av AttributeDeploymentStatus) String() string {
+	switch av {
+	case AttributeDeploymentStatusSucceeded:
+		return "succeeded"
+	case AttributeDeploymentStatusFailed:
+		return "failed"
+	}
+	return ""
+}
+
+// MapAttributeDeploymentStatus is a helper map of string to AttributeDeploymentStatus attribute value.
+var MapAttributeDeploymentStatus = map[string]AttributeDeploymentStatus{
+	"succeeded": AttributeDeploymentStatusSucceeded,
+	"failed":    AttributeDeploymentStatusFailed,
+}
+
+// AttributeSecretValidity specifies the value secret.validity attribute.
+type AttributeSecretValidity int
+
+const (
+	_ AttributeSecretValidity = iota
+	AttributeSecretValidityActive
+	AttributeSecretValidityInactive
+	AttributeSecretValidityUnknown
+)
+
+// String returns the string representation of the AttributeSecretValidity.
+func (av AttributeSecretValidity) String() string {
+	switch av {
+	case AttributeSecretValidityActive:
+		return "active"
+	case AttributeSecretValidityInactive:
+		return "inactive"
+	case AttributeSecretValidityUnknown:
+		return "unknown"
+	}
+	return ""
+}
+
+// MapAttributeSecretValidity is a helper map of string to AttributeSecretValidity attribute value.
+var MapAttributeSecretValidity = map[string]AttributeSecretValidity{
+	"active":   AttributeSecretValidityActive,
+	"inactive": AttributeSecretValidityInactive,
+	"unknown":  AttributeSecretValidityUnknown,
+}
+
+// AttributeTestCaseResultStatus specifies the value test.case.result.status attribute.
+type AttributeTestCaseResultStatus int
+
+const (
+	_ AttributeTestCaseResultStatus = iota
+	AttributeTestCaseResultStatusPass
+	AttributeTestCaseResultStatusFail
+	AttributeTestCaseResultStatusSkip
+)
+
+// String returns the string representation of the AttributeTestCaseResultStatus.
+func (av AttributeTestCaseResultStatus) String() string {
+	switch av {
+	case AttributeTestCaseResultStatusPass:
+		return "pass"
+	case AttributeTestCaseResultStatusFail:
+		return "fail"
+	case AttributeTestCaseResultStatusSkip:
+		return "skip"
+	}
+	return ""
+}
+
+// MapAttributeTestCaseResultStatus is a helper map of string to AttributeTestCaseResultStatus attribute value.
+var MapAttributeTestCaseResultStatus = map[string]AttributeTestCaseResultStatus{
+	"pass": AttributeTestCaseResultStatusPass,
+	"fail": AttributeTestCaseResultStatusFail,
+	"skip": AttributeTestCaseResultStatusSkip,
+}
+
 // AttributeVcsChangeState specifies the value vcs.change.state attribute.
 type AttributeVcsChangeState int
 
@@ -66,6 +260,7 @@ const (
 	_ AttributeVcsChangeState = iota
 	AttributeVcsChangeStateOpen
 	AttributeVcsChangeStateMerged
+	AttributeVcsChangeStateClosed
 )
 
 // String returns the string representation of the AttributeVcsChangeState.
@@ -75,6 +270,8 @@ func (av AttributeVcsChangeState) String() string {
 		return "open"
 	case AttributeVcsChangeStateMerged:
 		return "merged"
+	case AttributeVcsChangeStateClosed:
+		return "closed"
 	}
 	return ""
 }
@@ -83,6 +280,7 @@ func (av AttributeVcsChangeState) String() string {
 var MapAttributeVcsChangeState = map[string]AttributeVcsChangeState{
 	"open":   AttributeVcsChangeStateOpen,
 	"merged": AttributeVcsChangeStateMerged,
+	"closed": AttributeVcsChangeStateClosed,
 }
 
 // AttributeVcsLineChangeType specifies the value vcs.line_change.type attribute.
@@ -190,6 +388,54 @@ var MapAttributeVcsRevisionDeltaDirection = map[string]AttributeVcsRevisionDelta
 }
 
 var MetricsInfo = metricsInfo{
+	CicdPipelineRunCount: metricInfo{
+		Name:       "cicd.pipeline.run.count",
+		Attributes: []string{"vcs.repository.name", "cicd.pipeline.name", "vcs.ref.head.name", "cicd.pipeline.result"},
+	},
+	CicdPipelineTaskRunActive: metricInfo{
+		Name:       "cicd.pipeline.task.run.active",
+		Attributes: []string{"vcs.repository.name", "cicd.pipeline.worker.labels", "cicd.pipeline.run.state"},
+	},
+	CicdPipelineTaskRunCount: metricInfo{
+		Name:       "cicd.pipeline.task.run.count",
+		Attributes: []string{"vcs.repository.name", "cicd.pipeline.name", "vcs.ref.head.name", "cicd.pipeline.worker.labels", "cicd.pipeline.result"},
+	},
+	CicdPipelineTestCaseCount: metricInfo{
+		Name:       "cicd.pipeline.test.case.count",
+		Attributes: []string{"vcs.repository.name", "cicd.pipeline.name", "vcs.ref.head.name", "test.case.result.status"},
+	},
+	CicdPipelineTestCaseFlakyCount: metricInfo{
+		Name:       "cicd.pipeline.test.case.flaky.count",
+		Attributes: []string{"vcs.repository.name", "cicd.pipeline.name", "vcs.ref.head.name", "test.suite.name"},
+	},
+	CicdWorkerCount: metricInfo{
+		Name:       "cicd.worker.count",
+		Attributes: []string{"cicd.worker.state", "cicd.worker.group.name", "vcs.repository.name"},
+	},
+	CicdWorkerLabelCount: metricInfo{
+		Name:       "cicd.worker.label.count",
+		Attributes: []string{"cicd.worker.state", "cicd.worker.label", "vcs.repository.name"},
+	},
+	DeployDeploymentChangeFailureRate: metricInfo{
+		Name:       "deploy.deployment.change_failure_rate",
+		Attributes: []string{"service.name", "deployment.environment.name"},
+	},
+	DeployDeploymentCount: metricInfo{
+		Name:       "deploy.deployment.count",
+		Attributes: []string{"service.name", "deployment.environment.name", "deployment.status"},
+	},
+	DeployDeploymentFrequency: metricInfo{
+		Name:       "deploy.deployment.frequency",
+		Attributes: []string{"service.name", "deployment.environment.name"},
+	},
+	DeployDeploymentLeadTime: metricInfo{
+		Name:       "deploy.deployment.lead_time",
+		Attributes: []string{"service.name", "deployment.environment.name"},
+	},
+	DeployDeploymentTimeToRestore: metricInfo{
+		Name:       "deploy.deployment.time_to_restore",
+		Attributes: []string{"service.name", "deployment.environment.name"},
+	},
 	VcsChangeCount: metricInfo{
 		Name:       "vcs.change.count",
 		Attributes: []string{"vcs.repository.url.full", "vcs.change.state", "vcs.repository.name"},
@@ -198,10 +444,30 @@ var MetricsInfo = metricsInfo{
 		Name:       "vcs.change.duration",
 		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name", "vcs.change.state"},
 	},
+	VcsChangeReviewCommentCount: metricInfo{
+		Name:       "vcs.change.review.comment.count",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name", "vcs.change.state"},
+	},
+	VcsChangeReviewRoundCount: metricInfo{
+		Name:       "vcs.change.review.round.count",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name", "vcs.change.state"},
+	},
+	VcsChangeReviewerCount: metricInfo{
+		Name:       "vcs.change.reviewer.count",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name", "vcs.change.state"},
+	},
+	VcsChangeTimeFromApprovalToMerge: metricInfo{
+		Name:       "vcs.change.time_from_approval_to_merge",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name"},
+	},
 	VcsChangeTimeToApproval: metricInfo{
 		Name:       "vcs.change.time_to_approval",
 		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name"},
 	},
+	VcsChangeTimeToFirstReview: metricInfo{
+		Name:       "vcs.change.time_to_first_review",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name", "vcs.change.state"},
+	},
 	VcsChangeTimeToMerge: metricInfo{
 		Name:       "vcs.change.time_to_merge",
 		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.name"},
@@ -210,10 +476,18 @@ var MetricsInfo = metricsInfo{
 		Name:       "vcs.contributor.count",
 		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name"},
 	},
+	VcsCveAge: metricInfo{
+		Name:       "vcs.cve.age",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "cve.severity", "cve.source", "cve.package.ecosystem"},
+	},
 	VcsCveCount: metricInfo{
 		Name:       "vcs.cve.count",
 		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "cve.severity"},
 	},
+	VcsCveTimeToRemediate: metricInfo{
+		Name:       "vcs.cve.time_to_remediate",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "cve.severity", "cve.source", "cve.package.ecosystem", "cve.resolution"},
+	},
 	VcsRefCount: metricInfo{
 		Name:       "vcs.ref.count",
 		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "vcs.ref.head.type"},
@@ -233,20 +507,49 @@ var MetricsInfo = metricsInfo{
 	VcsRepositoryCount: metricInfo{
 		Name: "vcs.repository.count",
 	},
+	VcsSecretCount: metricInfo{
+		Name:       "vcs.secret.count",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "secret.type", "secret.validity"},
+	},
+	VcsSecretPushProtectionBypassCount: metricInfo{
+		Name:       "vcs.secret.push_protection_bypass.count",
+		Attributes: []string{"vcs.repository.url.full", "vcs.repository.name", "secret.type"},
+	},
 }
 
 type metricsInfo struct {
-	VcsChangeCount          metricInfo
-	VcsChangeDuration       metricInfo
-	VcsChangeTimeToApproval metricInfo
-	VcsChangeTimeToMerge    metricInfo
-	VcsContributorCount     metricInfo
-	VcsCveCount             metricInfo
-	VcsRefCount             metricInfo
-	VcsRefLinesDelta        metricInfo
-	VcsRefRevisionsDelta    metricInfo
-	VcsRefTime              metricInfo
-	VcsRepositoryCount      metricInfo
+	CicdPipelineRunCount               metricInfo
+	CicdPipelineTaskRunActive          metricInfo
+	CicdPipelineTaskRunCount           metricInfo
+	CicdPipelineTestCaseCount          metricInfo
+	CicdPipelineTestCaseFlakyCount     metricInfo
+	CicdWorkerCount                    metricInfo
+	CicdWorkerLabelCount               metricInfo
+	DeployDeploymentChangeFailureRate  metricInfo
+	DeployDeploymentCount              metricInfo
+	DeployDeploymentFrequency          metricInfo
+	DeployDeploymentLeadTime           metricInfo
+	DeployDeploymentTimeToRestore      metricInfo
+	VcsChangeCount                     metricInfo
+	VcsChangeDuration                  metricInfo
+	VcsChangeReviewCommentCount        metricInfo
+	VcsChangeReviewRoundCount          metricInfo
+	VcsChangeReviewerCount             metricInfo
+	VcsChangeTimeFromApprovalToMerge   metricInfo
+	VcsChangeTimeToApproval            metricInfo
+	VcsChangeTimeToFirstReview         metricInfo
+	VcsChangeTimeToMerge               metricInfo
+	VcsContributorCount                metricInfo
+	VcsCveAge                          metricInfo
+	VcsCveCount                        metricInfo
+	VcsCveTimeToRemediate              metricInfo
+	VcsRefCount                        metricInfo
+	VcsRefLinesDelta                   metricInfo
+	VcsRefRevisionsDelta               metricInfo
+	VcsRefTime                         metricInfo
+	VcsRepositoryCount                 metricInfo
+	VcsSecretCount                     metricInfo
+	VcsSecretPushProtectionBypassCount metricInfo
 }
 
 type metricInfo struct {
@@ -254,24 +557,26 @@ type metricInfo struct {
 	Attributes []string
 }
 
-type metricVcsChangeCount struct {
-	data          pmetric.Metric             // data buffer for generated metric.
-	config        VcsChangeCountMetricConfig // metric config provided by user.
-	capacity      int                        // max observed number of data points added to the metric.
-	aggDataPoints []int64                    // slice containing number of aggregated datapoints at each index
+type metricCicdPipelineRunCount struct {
+	data          pmetric.Metric                   // data buffer for generated metric.
+	config        CicdPipelineRunCountMetricConfig // metric config provided by user.
+	capacity      int                              // max observed number of data points added to the metric.
+	aggDataPoints []int64                          // slice containing number of aggregated datapoints at each index
 }
 
-// init fills vcs.change.count metric with initial data.
-func (m *metricVcsChangeCount) init() {
-	m.data.SetName("vcs.change.count")
-	m.data.SetDescription("The number of changes (pull requests) in a repository, categorized by their state (either open or merged).")
-	m.data.SetUnit("{change}")
-	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+// init fills cicd.pipeline.run.count metric with initial data.
+func (m *metricCicdPipelineRunCount) init() {
+	m.data.SetName("cicd.pipeline.run.count")
+	m.data.SetDescription("The number of completed workflow runs, received by the webhook when `webhook.metrics.enabled` is set.")
+	m.data.SetUnit("{run}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 	m.aggDataPoints = m.aggDataPoints[:0]
 }
 
-func (m *metricVcsChangeCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsChangeStateAttributeValue string, vcsRepositoryNameAttributeValue string) {
+func (m *metricCicdPipelineRunCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, cicdPipelineResultAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -279,18 +584,21 @@ func (m *metricVcsChangeCount) recordDataPoint(start pcommon.Timestamp, ts pcomm
 	dp := pmetric.NewNumberDataPoint()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeCountMetricAttributeKeyVcsRepositoryURLFull) {
-		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineRunCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeCountMetricAttributeKeyVcsChangeState) {
-		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineRunCountMetricAttributeKeyCicdPipelineName) {
+		dp.Attributes().PutStr("cicd.pipeline.name", cicdPipelineNameAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeCountMetricAttributeKeyVcsRepositoryName) {
-		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineRunCountMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineRunCountMetricAttributeKeyCicdPipelineResult) {
+		dp.Attributes().PutStr("cicd.pipeline.result", cicdPipelineResultAttributeValue)
 	}
 
 	var s string
-	dps := m.data.Gauge().DataPoints()
+	dps := m.data.Sum().DataPoints()
 	for i := 0; i < dps.Len(); i++ {
 		dpi := dps.At(i)
 		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
@@ -319,18 +627,18 @@ func (m *metricVcsChangeCount) recordDataPoint(start pcommon.Timestamp, ts pcomm
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricVcsChangeCount) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricCicdPipelineRunCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricVcsChangeCount) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricCicdPipelineRunCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		if m.config.AggregationStrategy == AggregationStrategyAvg {
 			for i, aggCount := range m.aggDataPoints {
-				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
 			}
 		}
 		m.updateCapacity()
@@ -339,8 +647,8 @@ func (m *metricVcsChangeCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricVcsChangeCount(cfg VcsChangeCountMetricConfig) metricVcsChangeCount {
-	m := metricVcsChangeCount{config: cfg}
+func newMetricCicdPipelineRunCount(cfg CicdPipelineRunCountMetricConfig) metricCicdPipelineRunCount {
+	m := metricCicdPipelineRunCount{config: cfg}
 
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
@@ -349,24 +657,24 @@ func newMetricVcsChangeCount(cfg VcsChangeCountMetricConfig) metricVcsChangeCoun
 	return m
 }
 
-type metricVcsChangeDuration struct {
-	data          pmetric.Metric                // data buffer for generated metric.
-	config        VcsChangeDurationMetricConfig // metric config provided by user.
-	capacity      int                           // max observed number of data points added to the metric.
-	aggDataPoints []int64                       // slice containing number of aggregated datapoints at each index
+type metricCicdPipelineTaskRunActive struct {
+	data          pmetric.Metric                        // data buffer for generated metric.
+	config        CicdPipelineTaskRunActiveMetricConfig // metric config provided by user.
+	capacity      int                                   // max observed number of data points added to the metric.
+	aggDataPoints []int64                               // slice containing number of aggregated datapoints at each index
 }
 
-// init fills vcs.change.duration metric with initial data.
-func (m *metricVcsChangeDuration) init() {
-	m.data.SetName("vcs.change.duration")
-	m.data.SetDescription("The time duration a change (pull request/merge request/changelist) has been in an open state.")
-	m.data.SetUnit("s")
+// init fills cicd.pipeline.task.run.active metric with initial data.
+func (m *metricCicdPipelineTaskRunActive) init() {
+	m.data.SetName("cicd.pipeline.task.run.active")
+	m.data.SetDescription("The number of workflow jobs waiting for or running on a runner, tracked by the webhook when `webhook.metrics.enabled` is set.")
+	m.data.SetUnit("{job}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 	m.aggDataPoints = m.aggDataPoints[:0]
 }
 
-func (m *metricVcsChangeDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue string) {
+func (m *metricCicdPipelineTaskRunActive) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineWorkerLabelsAttributeValue []any, cicdPipelineRunStateAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -374,17 +682,14 @@ func (m *metricVcsChangeDuration) recordDataPoint(start pcommon.Timestamp, ts pc
 	dp := pmetric.NewNumberDataPoint()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull) {
-		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
-	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsRepositoryName) {
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunActiveMetricAttributeKeyVcsRepositoryName) {
 		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsRefHeadName) {
-		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineWorkerLabels) {
+		dp.Attributes().PutEmptySlice("cicd.pipeline.worker.labels").FromRaw(cicdPipelineWorkerLabelsAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsChangeState) {
-		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunActiveMetricAttributeKeyCicdPipelineRunState) {
+		dp.Attributes().PutStr("cicd.pipeline.run.state", cicdPipelineRunStateAttributeValue)
 	}
 
 	var s string
@@ -417,14 +722,14 @@ func (m *metricVcsChangeDuration) recordDataPoint(start pcommon.Timestamp, ts pc
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricVcsChangeDuration) updateCapacity() {
+func (m *metricCicdPipelineTaskRunActive) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricVcsChangeDuration) emit(metrics pmetric.MetricSlice) {
+func (m *metricCicdPipelineTaskRunActive) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		if m.config.AggregationStrategy == AggregationStrategyAvg {
 			for i, aggCount := range m.aggDataPoints {
@@ -437,8 +742,8 @@ func (m *metricVcsChangeDuration) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricVcsChangeDuration(cfg VcsChangeDurationMetricConfig) metricVcsChangeDuration {
-	m := metricVcsChangeDuration{config: cfg}
+func newMetricCicdPipelineTaskRunActive(cfg CicdPipelineTaskRunActiveMetricConfig) metricCicdPipelineTaskRunActive {
+	m := metricCicdPipelineTaskRunActive{config: cfg}
 
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
@@ -447,24 +752,26 @@ func newMetricVcsChangeDuration(cfg VcsChangeDurationMetricConfig) metricVcsChan
 	return m
 }
 
-type metricVcsChangeTimeToApproval struct {
-	data          pmetric.Metric                      // data buffer for generated metric.
-	config        VcsChangeTimeToApprovalMetricConfig // metric config provided by user.
-	capacity      int                                 // max observed number of data points added to the metric.
-	aggDataPoints []int64                             // slice containing number of aggregated datapoints at each index
+type metricCicdPipelineTaskRunCount struct {
+	data          pmetric.Metric                       // data buffer for generated metric.
+	config        CicdPipelineTaskRunCountMetricConfig // metric config provided by user.
+	capacity      int                                  // max observed number of data points added to the metric.
+	aggDataPoints []int64                              // slice containing number of aggregated datapoints at each index
 }
 
-// init fills vcs.change.time_to_approval metric with initial data.
-func (m *metricVcsChangeTimeToApproval) init() {
-	m.data.SetName("vcs.change.time_to_approval")
-	m.data.SetDescription("The amount of time it took a change (pull request) to go from open to approved.")
-	m.data.SetUnit("s")
-	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+// init fills cicd.pipeline.task.run.count metric with initial data.
+func (m *metricCicdPipelineTaskRunCount) init() {
+	m.data.SetName("cicd.pipeline.task.run.count")
+	m.data.SetDescription("The number of completed workflow jobs, received by the webhook when `webhook.metrics.enabled` is set.")
+	m.data.SetUnit("{job}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 	m.aggDataPoints = m.aggDataPoints[:0]
 }
 
-func (m *metricVcsChangeTimeToApproval) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
+func (m *metricCicdPipelineTaskRunCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, cicdPipelineWorkerLabelsAttributeValue []any, cicdPipelineResultAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -472,18 +779,24 @@ func (m *metricVcsChangeTimeToApproval) recordDataPoint(start pcommon.Timestamp,
 	dp := pmetric.NewNumberDataPoint()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull) {
-		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
-	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName) {
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunCountMetricAttributeKeyVcsRepositoryName) {
 		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName) {
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineName) {
+		dp.Attributes().PutStr("cicd.pipeline.name", cicdPipelineNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunCountMetricAttributeKeyVcsRefHeadName) {
 		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
 	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineWorkerLabels) {
+		dp.Attributes().PutEmptySlice("cicd.pipeline.worker.labels").FromRaw(cicdPipelineWorkerLabelsAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTaskRunCountMetricAttributeKeyCicdPipelineResult) {
+		dp.Attributes().PutStr("cicd.pipeline.result", cicdPipelineResultAttributeValue)
+	}
 
 	var s string
-	dps := m.data.Gauge().DataPoints()
+	dps := m.data.Sum().DataPoints()
 	for i := 0; i < dps.Len(); i++ {
 		dpi := dps.At(i)
 		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
@@ -512,18 +825,18 @@ func (m *metricVcsChangeTimeToApproval) recordDataPoint(start pcommon.Timestamp,
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricVcsChangeTimeToApproval) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricCicdPipelineTaskRunCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricVcsChangeTimeToApproval) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricCicdPipelineTaskRunCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		if m.config.AggregationStrategy == AggregationStrategyAvg {
 			for i, aggCount := range m.aggDataPoints {
-				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
 			}
 		}
 		m.updateCapacity()
@@ -532,8 +845,8 @@ func (m *metricVcsChangeTimeToApproval) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricVcsChangeTimeToApproval(cfg VcsChangeTimeToApprovalMetricConfig) metricVcsChangeTimeToApproval {
-	m := metricVcsChangeTimeToApproval{config: cfg}
+func newMetricCicdPipelineTaskRunCount(cfg CicdPipelineTaskRunCountMetricConfig) metricCicdPipelineTaskRunCount {
+	m := metricCicdPipelineTaskRunCount{config: cfg}
 
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
@@ -542,24 +855,26 @@ func newMetricVcsChangeTimeToApproval(cfg VcsChangeTimeToApprovalMetricConfig) m
 	return m
 }
 
-type metricVcsChangeTimeToMerge struct {
-	data          pmetric.Metric                   // data buffer for generated metric.
-	config        VcsChangeTimeToMergeMetricConfig // metric config provided by user.
-	capacity      int                              // max observed number of data points added to the metric.
-	aggDataPoints []int64                          // slice containing number of aggregated datapoints at each index
+type metricCicdPipelineTestCaseCount struct {
+	data          pmetric.Metric                        // data buffer for generated metric.
+	config        CicdPipelineTestCaseCountMetricConfig // metric config provided by user.
+	capacity      int                                   // max observed number of data points added to the metric.
+	aggDataPoints []int64                               // slice containing number of aggregated datapoints at each index
 }
 
-// init fills vcs.change.time_to_merge metric with initial data.
-func (m *metricVcsChangeTimeToMerge) init() {
-	m.data.SetName("vcs.change.time_to_merge")
-	m.data.SetDescription("The amount of time it took a change (pull request) to go from open to merged.")
-	m.data.SetUnit("s")
-	m.data.SetEmptyGauge()
-	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+// init fills cicd.pipeline.test.case.count metric with initial data.
+func (m *metricCicdPipelineTestCaseCount) init() {
+	m.data.SetName("cicd.pipeline.test.case.count")
+	m.data.SetDescription("The number of test cases of a completed workflow run attempt by result, read from its test results when `webhook.test_results.enabled` and `webhook.metrics.enabled` are set.")
+	m.data.SetUnit("{test}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
 	m.aggDataPoints = m.aggDataPoints[:0]
 }
 
-func (m *metricVcsChangeTimeToMerge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
+func (m *metricCicdPipelineTestCaseCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, testCaseResultStatusAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -567,18 +882,21 @@ func (m *metricVcsChangeTimeToMerge) recordDataPoint(start pcommon.Timestamp, ts
 	dp := pmetric.NewNumberDataPoint()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToMergeMetricAttributeKeyVcsRepositoryURLFull) {
-		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
-	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToMergeMetricAttributeKeyVcsRepositoryName) {
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseCountMetricAttributeKeyVcsRepositoryName) {
 		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToMergeMetricAttributeKeyVcsRefHeadName) {
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseCountMetricAttributeKeyCicdPipelineName) {
+		dp.Attributes().PutStr("cicd.pipeline.name", cicdPipelineNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseCountMetricAttributeKeyVcsRefHeadName) {
 		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
 	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseCountMetricAttributeKeyTestCaseResultStatus) {
+		dp.Attributes().PutStr("test.case.result.status", testCaseResultStatusAttributeValue)
+	}
 
 	var s string
-	dps := m.data.Gauge().DataPoints()
+	dps := m.data.Sum().DataPoints()
 	for i := 0; i < dps.Len(); i++ {
 		dpi := dps.At(i)
 		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
@@ -607,18 +925,18 @@ func (m *metricVcsChangeTimeToMerge) recordDataPoint(start pcommon.Timestamp, ts
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricVcsChangeTimeToMerge) updateCapacity() {
-	if m.data.Gauge().DataPoints().Len() > m.capacity {
-		m.capacity = m.data.Gauge().DataPoints().Len()
+func (m *metricCicdPipelineTestCaseCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricVcsChangeTimeToMerge) emit(metrics pmetric.MetricSlice) {
-	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+func (m *metricCicdPipelineTestCaseCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
 		if m.config.AggregationStrategy == AggregationStrategyAvg {
 			for i, aggCount := range m.aggDataPoints {
-				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
 			}
 		}
 		m.updateCapacity()
@@ -627,8 +945,8 @@ func (m *metricVcsChangeTimeToMerge) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricVcsChangeTimeToMerge(cfg VcsChangeTimeToMergeMetricConfig) metricVcsChangeTimeToMerge {
-	m := metricVcsChangeTimeToMerge{config: cfg}
+func newMetricCicdPipelineTestCaseCount(cfg CicdPipelineTestCaseCountMetricConfig) metricCicdPipelineTestCaseCount {
+	m := metricCicdPipelineTestCaseCount{config: cfg}
 
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
@@ -637,24 +955,1840 @@ func newMetricVcsChangeTimeToMerge(cfg VcsChangeTimeToMergeMetricConfig) metricV
 	return m
 }
 
-type metricVcsContributorCount struct {
-	data          pmetric.Metric                  // data buffer for generated metric.
-	config        VcsContributorCountMetricConfig // metric config provided by user.
-	capacity      int                             // max observed number of data points added to the metric.
-	aggDataPoints []int64                         // slice containing number of aggregated datapoints at each index
+type metricCicdPipelineTestCaseFlakyCount struct {
+	data          pmetric.Metric                             // data buffer for generated metric.
+	config        CicdPipelineTestCaseFlakyCountMetricConfig // metric config provided by user.
+	capacity      int                                        // max observed number of data points added to the metric.
+	aggDataPoints []int64                                    // slice containing number of aggregated datapoints at each index
+}
+
+// init fills cicd.pipeline.test.case.flaky.count metric with initial data.
+func (m *metricCicdPipelineTestCaseFlakyCount) init() {
+	m.data.SetName("cicd.pipeline.test.case.flaky.count")
+	m.data.SetDescription("The number of test cases of a test suite which passed on a retry of a workflow run after failing on a previous attempt, read from its test results when `webhook.test_results.enabled` and `webhook.metrics.enabled` are set.")
+	m.data.SetUnit("{test}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricCicdPipelineTestCaseFlakyCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, testSuiteNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseFlakyCountMetricAttributeKeyCicdPipelineName) {
+		dp.Attributes().PutStr("cicd.pipeline.name", cicdPipelineNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseFlakyCountMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdPipelineTestCaseFlakyCountMetricAttributeKeyTestSuiteName) {
+		dp.Attributes().PutStr("test.suite.name", testSuiteNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Sum().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCicdPipelineTestCaseFlakyCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCicdPipelineTestCaseFlakyCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Sum().DataPoints().At(i).SetIntValue(m.data.Sum().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCicdPipelineTestCaseFlakyCount(cfg CicdPipelineTestCaseFlakyCountMetricConfig) metricCicdPipelineTestCaseFlakyCount {
+	m := metricCicdPipelineTestCaseFlakyCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCicdWorkerCount struct {
+	data          pmetric.Metric              // data buffer for generated metric.
+	config        CicdWorkerCountMetricConfig // metric config provided by user.
+	capacity      int                         // max observed number of data points added to the metric.
+	aggDataPoints []int64                     // slice containing number of aggregated datapoints at each index
+}
+
+// init fills cicd.worker.count metric with initial data.
+func (m *metricCicdWorkerCount) init() {
+	m.data.SetName("cicd.worker.count")
+	m.data.SetDescription("The number of self-hosted runners by state and runner group.")
+	m.data.SetUnit("{count}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricCicdWorkerCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cicdWorkerStateAttributeValue string, cicdWorkerGroupNameAttributeValue string, vcsRepositoryNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, CicdWorkerCountMetricAttributeKeyCicdWorkerState) {
+		dp.Attributes().PutStr("cicd.worker.state", cicdWorkerStateAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdWorkerCountMetricAttributeKeyCicdWorkerGroupName) {
+		dp.Attributes().PutStr("cicd.worker.group.name", cicdWorkerGroupNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdWorkerCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCicdWorkerCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCicdWorkerCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCicdWorkerCount(cfg CicdWorkerCountMetricConfig) metricCicdWorkerCount {
+	m := metricCicdWorkerCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricCicdWorkerLabelCount struct {
+	data          pmetric.Metric                   // data buffer for generated metric.
+	config        CicdWorkerLabelCountMetricConfig // metric config provided by user.
+	capacity      int                              // max observed number of data points added to the metric.
+	aggDataPoints []int64                          // slice containing number of aggregated datapoints at each index
+}
+
+// init fills cicd.worker.label.count metric with initial data.
+func (m *metricCicdWorkerLabelCount) init() {
+	m.data.SetName("cicd.worker.label.count")
+	m.data.SetDescription("The number of self-hosted runners with a label by state.")
+	m.data.SetUnit("{count}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricCicdWorkerLabelCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, cicdWorkerStateAttributeValue string, cicdWorkerLabelAttributeValue string, vcsRepositoryNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, CicdWorkerLabelCountMetricAttributeKeyCicdWorkerState) {
+		dp.Attributes().PutStr("cicd.worker.state", cicdWorkerStateAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdWorkerLabelCountMetricAttributeKeyCicdWorkerLabel) {
+		dp.Attributes().PutStr("cicd.worker.label", cicdWorkerLabelAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, CicdWorkerLabelCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricCicdWorkerLabelCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricCicdWorkerLabelCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricCicdWorkerLabelCount(cfg CicdWorkerLabelCountMetricConfig) metricCicdWorkerLabelCount {
+	m := metricCicdWorkerLabelCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricDeployDeploymentChangeFailureRate struct {
+	data          pmetric.Metric                                // data buffer for generated metric.
+	config        DeployDeploymentChangeFailureRateMetricConfig // metric config provided by user.
+	capacity      int                                           // max observed number of data points added to the metric.
+	aggDataPoints []float64                                     // slice containing number of aggregated datapoints at each index
+}
+
+// init fills deploy.deployment.change_failure_rate metric with initial data.
+func (m *metricDeployDeploymentChangeFailureRate) init() {
+	m.data.SetName("deploy.deployment.change_failure_rate")
+	m.data.SetDescription("The ratio of failed deployments to all completed deployments for a given service and environment over the lookback_days period.")
+	m.data.SetUnit("1")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricDeployDeploymentChangeFailureRate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentChangeFailureRateMetricAttributeKeyServiceName) {
+		dp.Attributes().PutStr("service.name", serviceNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentChangeFailureRateMetricAttributeKeyDeploymentEnvironmentName) {
+		dp.Attributes().PutStr("deployment.environment.name", deploymentEnvironmentNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetDoubleValue(dpi.DoubleValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.DoubleValue() > val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.DoubleValue() < val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetDoubleValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricDeployDeploymentChangeFailureRate) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricDeployDeploymentChangeFailureRate) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetDoubleValue(m.data.Gauge().DataPoints().At(i).DoubleValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricDeployDeploymentChangeFailureRate(cfg DeployDeploymentChangeFailureRateMetricConfig) metricDeployDeploymentChangeFailureRate {
+	m := metricDeployDeploymentChangeFailureRate{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricDeployDeploymentCount struct {
+	data          pmetric.Metric                    // data buffer for generated metric.
+	config        DeployDeploymentCountMetricConfig // metric config provided by user.
+	capacity      int                               // max observed number of data points added to the metric.
+	aggDataPoints []int64                           // slice containing number of aggregated datapoints at each index
+}
+
+// init fills deploy.deployment.count metric with initial data.
+func (m *metricDeployDeploymentCount) init() {
+	m.data.SetName("deploy.deployment.count")
+	m.data.SetDescription("The number of deployments by service, environment, and status over the lookback_days period.")
+	m.data.SetUnit("{deployment}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricDeployDeploymentCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string, deploymentStatusAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentCountMetricAttributeKeyServiceName) {
+		dp.Attributes().PutStr("service.name", serviceNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentCountMetricAttributeKeyDeploymentEnvironmentName) {
+		dp.Attributes().PutStr("deployment.environment.name", deploymentEnvironmentNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentCountMetricAttributeKeyDeploymentStatus) {
+		dp.Attributes().PutStr("deployment.status", deploymentStatusAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricDeployDeploymentCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricDeployDeploymentCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricDeployDeploymentCount(cfg DeployDeploymentCountMetricConfig) metricDeployDeploymentCount {
+	m := metricDeployDeploymentCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricDeployDeploymentFrequency struct {
+	data          pmetric.Metric                        // data buffer for generated metric.
+	config        DeployDeploymentFrequencyMetricConfig // metric config provided by user.
+	capacity      int                                   // max observed number of data points added to the metric.
+	aggDataPoints []float64                             // slice containing number of aggregated datapoints at each index
+}
+
+// init fills deploy.deployment.frequency metric with initial data.
+func (m *metricDeployDeploymentFrequency) init() {
+	m.data.SetName("deploy.deployment.frequency")
+	m.data.SetDescription("The average number of successful deployments per day for a given service and environment over the lookback_days period.")
+	m.data.SetUnit("{deployment}/d")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricDeployDeploymentFrequency) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentFrequencyMetricAttributeKeyServiceName) {
+		dp.Attributes().PutStr("service.name", serviceNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentFrequencyMetricAttributeKeyDeploymentEnvironmentName) {
+		dp.Attributes().PutStr("deployment.environment.name", deploymentEnvironmentNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetDoubleValue(dpi.DoubleValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.DoubleValue() > val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.DoubleValue() < val {
+					dpi.SetDoubleValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetDoubleValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricDeployDeploymentFrequency) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricDeployDeploymentFrequency) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetDoubleValue(m.data.Gauge().DataPoints().At(i).DoubleValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricDeployDeploymentFrequency(cfg DeployDeploymentFrequencyMetricConfig) metricDeployDeploymentFrequency {
+	m := metricDeployDeploymentFrequency{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricDeployDeploymentLeadTime struct {
+	data          pmetric.Metric                       // data buffer for generated metric.
+	config        DeployDeploymentLeadTimeMetricConfig // metric config provided by user.
+	capacity      int                                  // max observed number of data points added to the metric.
+	aggDataPoints []int64                              // slice containing number of aggregated datapoints at each index
+}
+
+// init fills deploy.deployment.lead_time metric with initial data.
+func (m *metricDeployDeploymentLeadTime) init() {
+	m.data.SetName("deploy.deployment.lead_time")
+	m.data.SetDescription("Average time from the commit of a successful deployment to the success of the deployment for a given service and environment over the lookback_days period.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricDeployDeploymentLeadTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentLeadTimeMetricAttributeKeyServiceName) {
+		dp.Attributes().PutStr("service.name", serviceNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentLeadTimeMetricAttributeKeyDeploymentEnvironmentName) {
+		dp.Attributes().PutStr("deployment.environment.name", deploymentEnvironmentNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricDeployDeploymentLeadTime) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricDeployDeploymentLeadTime) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricDeployDeploymentLeadTime(cfg DeployDeploymentLeadTimeMetricConfig) metricDeployDeploymentLeadTime {
+	m := metricDeployDeploymentLeadTime{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricDeployDeploymentTimeToRestore struct {
+	data          pmetric.Metric                            // data buffer for generated metric.
+	config        DeployDeploymentTimeToRestoreMetricConfig // metric config provided by user.
+	capacity      int                                       // max observed number of data points added to the metric.
+	aggDataPoints []int64                                   // slice containing number of aggregated datapoints at each index
+}
+
+// init fills deploy.deployment.time_to_restore metric with initial data.
+func (m *metricDeployDeploymentTimeToRestore) init() {
+	m.data.SetName("deploy.deployment.time_to_restore")
+	m.data.SetDescription("Average time from a failed deployment to the next successful deployment for a given service and environment over the lookback_days period.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricDeployDeploymentTimeToRestore) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentTimeToRestoreMetricAttributeKeyServiceName) {
+		dp.Attributes().PutStr("service.name", serviceNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, DeployDeploymentTimeToRestoreMetricAttributeKeyDeploymentEnvironmentName) {
+		dp.Attributes().PutStr("deployment.environment.name", deploymentEnvironmentNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricDeployDeploymentTimeToRestore) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricDeployDeploymentTimeToRestore) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricDeployDeploymentTimeToRestore(cfg DeployDeploymentTimeToRestoreMetricConfig) metricDeployDeploymentTimeToRestore {
+	m := metricDeployDeploymentTimeToRestore{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeCount struct {
+	data          pmetric.Metric             // data buffer for generated metric.
+	config        VcsChangeCountMetricConfig // metric config provided by user.
+	capacity      int                        // max observed number of data points added to the metric.
+	aggDataPoints []int64                    // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.count metric with initial data.
+func (m *metricVcsChangeCount) init() {
+	m.data.SetName("vcs.change.count")
+	m.data.SetDescription("The number of changes (pull requests) in a repository, categorized by their state (open, merged or closed without merging).")
+	m.data.SetUnit("{change}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsChangeStateAttributeValue string, vcsRepositoryNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeCountMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeCountMetricAttributeKeyVcsChangeState) {
+		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeCount(cfg VcsChangeCountMetricConfig) metricVcsChangeCount {
+	m := metricVcsChangeCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeDuration struct {
+	data          pmetric.Metric                // data buffer for generated metric.
+	config        VcsChangeDurationMetricConfig // metric config provided by user.
+	capacity      int                           // max observed number of data points added to the metric.
+	aggDataPoints []int64                       // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.duration metric with initial data.
+func (m *metricVcsChangeDuration) init() {
+	m.data.SetName("vcs.change.duration")
+	m.data.SetDescription("The time duration a change (pull request/merge request/changelist) has been in a given state (open, or until it was merged or closed).")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeDuration) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeDurationMetricAttributeKeyVcsChangeState) {
+		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeDuration) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeDuration) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeDuration(cfg VcsChangeDurationMetricConfig) metricVcsChangeDuration {
+	m := metricVcsChangeDuration{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeReviewCommentCount struct {
+	data          pmetric.Metric                          // data buffer for generated metric.
+	config        VcsChangeReviewCommentCountMetricConfig // metric config provided by user.
+	capacity      int                                     // max observed number of data points added to the metric.
+	aggDataPoints []int64                                 // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.review.comment.count metric with initial data.
+func (m *metricVcsChangeReviewCommentCount) init() {
+	m.data.SetName("vcs.change.review.comment.count")
+	m.data.SetDescription("The number of review comments left on a change (pull request) by its reviews.")
+	m.data.SetUnit("{comment}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeReviewCommentCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewCommentCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewCommentCountMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewCommentCountMetricAttributeKeyVcsChangeState) {
+		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeReviewCommentCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeReviewCommentCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeReviewCommentCount(cfg VcsChangeReviewCommentCountMetricConfig) metricVcsChangeReviewCommentCount {
+	m := metricVcsChangeReviewCommentCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeReviewRoundCount struct {
+	data          pmetric.Metric                        // data buffer for generated metric.
+	config        VcsChangeReviewRoundCountMetricConfig // metric config provided by user.
+	capacity      int                                   // max observed number of data points added to the metric.
+	aggDataPoints []int64                               // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.review.round.count metric with initial data.
+func (m *metricVcsChangeReviewRoundCount) init() {
+	m.data.SetName("vcs.change.review.round.count")
+	m.data.SetDescription("The number of review rounds of a change (pull request), which is the number of distinct revisions (commits) of the change that were reviewed.")
+	m.data.SetUnit("{round}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeReviewRoundCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewRoundCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewRoundCountMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewRoundCountMetricAttributeKeyVcsChangeState) {
+		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeReviewRoundCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeReviewRoundCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeReviewRoundCount(cfg VcsChangeReviewRoundCountMetricConfig) metricVcsChangeReviewRoundCount {
+	m := metricVcsChangeReviewRoundCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeReviewerCount struct {
+	data          pmetric.Metric                     // data buffer for generated metric.
+	config        VcsChangeReviewerCountMetricConfig // metric config provided by user.
+	capacity      int                                // max observed number of data points added to the metric.
+	aggDataPoints []int64                            // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.reviewer.count metric with initial data.
+func (m *metricVcsChangeReviewerCount) init() {
+	m.data.SetName("vcs.change.reviewer.count")
+	m.data.SetDescription("The number of distinct reviewers, other than its author, who submitted a review of a change (pull request).")
+	m.data.SetUnit("{reviewer}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeReviewerCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewerCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewerCountMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeReviewerCountMetricAttributeKeyVcsChangeState) {
+		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeReviewerCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeReviewerCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeReviewerCount(cfg VcsChangeReviewerCountMetricConfig) metricVcsChangeReviewerCount {
+	m := metricVcsChangeReviewerCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeTimeFromApprovalToMerge struct {
+	data          pmetric.Metric                               // data buffer for generated metric.
+	config        VcsChangeTimeFromApprovalToMergeMetricConfig // metric config provided by user.
+	capacity      int                                          // max observed number of data points added to the metric.
+	aggDataPoints []int64                                      // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.time_from_approval_to_merge metric with initial data.
+func (m *metricVcsChangeTimeFromApprovalToMerge) init() {
+	m.data.SetName("vcs.change.time_from_approval_to_merge")
+	m.data.SetDescription("The amount of time it took a change (pull request) to go from its last approval to merged.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeTimeFromApprovalToMerge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeFromApprovalToMergeMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeTimeFromApprovalToMerge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeTimeFromApprovalToMerge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeTimeFromApprovalToMerge(cfg VcsChangeTimeFromApprovalToMergeMetricConfig) metricVcsChangeTimeFromApprovalToMerge {
+	m := metricVcsChangeTimeFromApprovalToMerge{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeTimeToApproval struct {
+	data          pmetric.Metric                      // data buffer for generated metric.
+	config        VcsChangeTimeToApprovalMetricConfig // metric config provided by user.
+	capacity      int                                 // max observed number of data points added to the metric.
+	aggDataPoints []int64                             // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.time_to_approval metric with initial data.
+func (m *metricVcsChangeTimeToApproval) init() {
+	m.data.SetName("vcs.change.time_to_approval")
+	m.data.SetDescription("The amount of time it took a change (pull request) to go from open to its first approval.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeTimeToApproval) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToApprovalMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToApprovalMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeTimeToApproval) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeTimeToApproval) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeTimeToApproval(cfg VcsChangeTimeToApprovalMetricConfig) metricVcsChangeTimeToApproval {
+	m := metricVcsChangeTimeToApproval{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeTimeToFirstReview struct {
+	data          pmetric.Metric                         // data buffer for generated metric.
+	config        VcsChangeTimeToFirstReviewMetricConfig // metric config provided by user.
+	capacity      int                                    // max observed number of data points added to the metric.
+	aggDataPoints []int64                                // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.time_to_first_review metric with initial data.
+func (m *metricVcsChangeTimeToFirstReview) init() {
+	m.data.SetName("vcs.change.time_to_first_review")
+	m.data.SetDescription("The amount of time it took a change (pull request) to go from open to its first review by someone other than its author.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeTimeToFirstReview) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToFirstReviewMetricAttributeKeyVcsChangeState) {
+		dp.Attributes().PutStr("vcs.change.state", vcsChangeStateAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeTimeToFirstReview) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeTimeToFirstReview) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeTimeToFirstReview(cfg VcsChangeTimeToFirstReviewMetricConfig) metricVcsChangeTimeToFirstReview {
+	m := metricVcsChangeTimeToFirstReview{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsChangeTimeToMerge struct {
+	data          pmetric.Metric                   // data buffer for generated metric.
+	config        VcsChangeTimeToMergeMetricConfig // metric config provided by user.
+	capacity      int                              // max observed number of data points added to the metric.
+	aggDataPoints []int64                          // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.change.time_to_merge metric with initial data.
+func (m *metricVcsChangeTimeToMerge) init() {
+	m.data.SetName("vcs.change.time_to_merge")
+	m.data.SetDescription("The amount of time it took a change (pull request) to go from open to merged.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsChangeTimeToMerge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToMergeMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToMergeMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsChangeTimeToMergeMetricAttributeKeyVcsRefHeadName) {
+		dp.Attributes().PutStr("vcs.ref.head.name", vcsRefHeadNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsChangeTimeToMerge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsChangeTimeToMerge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsChangeTimeToMerge(cfg VcsChangeTimeToMergeMetricConfig) metricVcsChangeTimeToMerge {
+	m := metricVcsChangeTimeToMerge{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsContributorCount struct {
+	data          pmetric.Metric                  // data buffer for generated metric.
+	config        VcsContributorCountMetricConfig // metric config provided by user.
+	capacity      int                             // max observed number of data points added to the metric.
+	aggDataPoints []int64                         // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.contributor.count metric with initial data.
+func (m *metricVcsContributorCount) init() {
+	m.data.SetName("vcs.contributor.count")
+	m.data.SetDescription("The number of unique contributors to a repository.")
+	m.data.SetUnit("{contributor}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsContributorCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsContributorCountMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsContributorCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsContributorCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsContributorCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsContributorCount(cfg VcsContributorCountMetricConfig) metricVcsContributorCount {
+	m := metricVcsContributorCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsCveAge struct {
+	data          pmetric.Metric        // data buffer for generated metric.
+	config        VcsCveAgeMetricConfig // metric config provided by user.
+	capacity      int                   // max observed number of data points added to the metric.
+	aggDataPoints []int64               // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.cve.age metric with initial data.
+func (m *metricVcsCveAge) init() {
+	m.data.SetName("vcs.cve.age")
+	m.data.SetDescription("The age of the oldest open Common Vulnerabilities and Exposures (CVE) alert in the repository.")
+	m.data.SetUnit("s")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsCveAge) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, cveSeverityAttributeValue string, cveSourceAttributeValue string, cvePackageEcosystemAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsCveAgeMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveAgeMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveAgeMetricAttributeKeyCveSeverity) {
+		dp.Attributes().PutStr("cve.severity", cveSeverityAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveAgeMetricAttributeKeyCveSource) {
+		dp.Attributes().PutStr("cve.source", cveSourceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveAgeMetricAttributeKeyCvePackageEcosystem) {
+		dp.Attributes().PutStr("cve.package.ecosystem", cvePackageEcosystemAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsCveAge) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsCveAge) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsCveAge(cfg VcsCveAgeMetricConfig) metricVcsCveAge {
+	m := metricVcsCveAge{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
 }
 
-// init fills vcs.contributor.count metric with initial data.
-func (m *metricVcsContributorCount) init() {
-	m.data.SetName("vcs.contributor.count")
-	m.data.SetDescription("The number of unique contributors to a repository.")
-	m.data.SetUnit("{contributor}")
+type metricVcsCveCount struct {
+	data          pmetric.Metric          // data buffer for generated metric.
+	config        VcsCveCountMetricConfig // metric config provided by user.
+	capacity      int                     // max observed number of data points added to the metric.
+	aggDataPoints []int64                 // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.cve.count metric with initial data.
+func (m *metricVcsCveCount) init() {
+	m.data.SetName("vcs.cve.count")
+	m.data.SetDescription("The number of Common Vulnerabilities and Exposures (CVEs) in the repository.")
+	m.data.SetUnit("{cve}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 	m.aggDataPoints = m.aggDataPoints[:0]
 }
 
-func (m *metricVcsContributorCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string) {
+func (m *metricVcsCveCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, cveSeverityAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -662,12 +2796,15 @@ func (m *metricVcsContributorCount) recordDataPoint(start pcommon.Timestamp, ts
 	dp := pmetric.NewNumberDataPoint()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	if slices.Contains(m.config.EnabledAttributes, VcsContributorCountMetricAttributeKeyVcsRepositoryURLFull) {
+	if slices.Contains(m.config.EnabledAttributes, VcsCveCountMetricAttributeKeyVcsRepositoryURLFull) {
 		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsContributorCountMetricAttributeKeyVcsRepositoryName) {
+	if slices.Contains(m.config.EnabledAttributes, VcsCveCountMetricAttributeKeyVcsRepositoryName) {
 		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
 	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveCountMetricAttributeKeyCveSeverity) {
+		dp.Attributes().PutStr("cve.severity", cveSeverityAttributeValue)
+	}
 
 	var s string
 	dps := m.data.Gauge().DataPoints()
@@ -699,14 +2836,14 @@ func (m *metricVcsContributorCount) recordDataPoint(start pcommon.Timestamp, ts
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricVcsContributorCount) updateCapacity() {
+func (m *metricVcsCveCount) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricVcsContributorCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricVcsCveCount) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		if m.config.AggregationStrategy == AggregationStrategyAvg {
 			for i, aggCount := range m.aggDataPoints {
@@ -719,8 +2856,8 @@ func (m *metricVcsContributorCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricVcsContributorCount(cfg VcsContributorCountMetricConfig) metricVcsContributorCount {
-	m := metricVcsContributorCount{config: cfg}
+func newMetricVcsCveCount(cfg VcsCveCountMetricConfig) metricVcsCveCount {
+	m := metricVcsCveCount{config: cfg}
 
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
@@ -729,24 +2866,24 @@ func newMetricVcsContributorCount(cfg VcsContributorCountMetricConfig) metricVcs
 	return m
 }
 
-type metricVcsCveCount struct {
-	data          pmetric.Metric          // data buffer for generated metric.
-	config        VcsCveCountMetricConfig // metric config provided by user.
-	capacity      int                     // max observed number of data points added to the metric.
-	aggDataPoints []int64                 // slice containing number of aggregated datapoints at each index
+type metricVcsCveTimeToRemediate struct {
+	data          pmetric.Metric                    // data buffer for generated metric.
+	config        VcsCveTimeToRemediateMetricConfig // metric config provided by user.
+	capacity      int                               // max observed number of data points added to the metric.
+	aggDataPoints []int64                           // slice containing number of aggregated datapoints at each index
 }
 
-// init fills vcs.cve.count metric with initial data.
-func (m *metricVcsCveCount) init() {
-	m.data.SetName("vcs.cve.count")
-	m.data.SetDescription("The number of Common Vulnerabilities and Exposures (CVEs) in the repository.")
-	m.data.SetUnit("{cve}")
+// init fills vcs.cve.time_to_remediate metric with initial data.
+func (m *metricVcsCveTimeToRemediate) init() {
+	m.data.SetName("vcs.cve.time_to_remediate")
+	m.data.SetDescription("The average time from the creation to the resolution of the Common Vulnerabilities and Exposures (CVE) alerts of the repository resolved over the CVE lookback window.")
+	m.data.SetUnit("s")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
 	m.aggDataPoints = m.aggDataPoints[:0]
 }
 
-func (m *metricVcsCveCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, cveSeverityAttributeValue string) {
+func (m *metricVcsCveTimeToRemediate) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, cveSeverityAttributeValue string, cveSourceAttributeValue string, cvePackageEcosystemAttributeValue string, cveResolutionAttributeValue string) {
 	if !m.config.Enabled {
 		return
 	}
@@ -754,15 +2891,24 @@ func (m *metricVcsCveCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.
 	dp := pmetric.NewNumberDataPoint()
 	dp.SetStartTimestamp(start)
 	dp.SetTimestamp(ts)
-	if slices.Contains(m.config.EnabledAttributes, VcsCveCountMetricAttributeKeyVcsRepositoryURLFull) {
+	if slices.Contains(m.config.EnabledAttributes, VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryURLFull) {
 		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsCveCountMetricAttributeKeyVcsRepositoryName) {
+	if slices.Contains(m.config.EnabledAttributes, VcsCveTimeToRemediateMetricAttributeKeyVcsRepositoryName) {
 		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
 	}
-	if slices.Contains(m.config.EnabledAttributes, VcsCveCountMetricAttributeKeyCveSeverity) {
+	if slices.Contains(m.config.EnabledAttributes, VcsCveTimeToRemediateMetricAttributeKeyCveSeverity) {
 		dp.Attributes().PutStr("cve.severity", cveSeverityAttributeValue)
 	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveTimeToRemediateMetricAttributeKeyCveSource) {
+		dp.Attributes().PutStr("cve.source", cveSourceAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveTimeToRemediateMetricAttributeKeyCvePackageEcosystem) {
+		dp.Attributes().PutStr("cve.package.ecosystem", cvePackageEcosystemAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsCveTimeToRemediateMetricAttributeKeyCveResolution) {
+		dp.Attributes().PutStr("cve.resolution", cveResolutionAttributeValue)
+	}
 
 	var s string
 	dps := m.data.Gauge().DataPoints()
@@ -794,14 +2940,14 @@ func (m *metricVcsCveCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.
 }
 
 // updateCapacity saves max length of data point slices that will be used for the slice capacity.
-func (m *metricVcsCveCount) updateCapacity() {
+func (m *metricVcsCveTimeToRemediate) updateCapacity() {
 	if m.data.Gauge().DataPoints().Len() > m.capacity {
 		m.capacity = m.data.Gauge().DataPoints().Len()
 	}
 }
 
 // emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
-func (m *metricVcsCveCount) emit(metrics pmetric.MetricSlice) {
+func (m *metricVcsCveTimeToRemediate) emit(metrics pmetric.MetricSlice) {
 	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
 		if m.config.AggregationStrategy == AggregationStrategyAvg {
 			for i, aggCount := range m.aggDataPoints {
@@ -814,8 +2960,8 @@ func (m *metricVcsCveCount) emit(metrics pmetric.MetricSlice) {
 	}
 }
 
-func newMetricVcsCveCount(cfg VcsCveCountMetricConfig) metricVcsCveCount {
-	m := metricVcsCveCount{config: cfg}
+func newMetricVcsCveTimeToRemediate(cfg VcsCveTimeToRemediateMetricConfig) metricVcsCveTimeToRemediate {
+	m := metricVcsCveTimeToRemediate{config: cfg}
 
 	if cfg.Enabled {
 		m.data = pmetric.NewMetric()
@@ -1275,27 +3421,241 @@ func newMetricVcsRepositoryCount(cfg VcsRepositoryCountMetricConfig) metricVcsRe
 	return m
 }
 
+type metricVcsSecretCount struct {
+	data          pmetric.Metric             // data buffer for generated metric.
+	config        VcsSecretCountMetricConfig // metric config provided by user.
+	capacity      int                        // max observed number of data points added to the metric.
+	aggDataPoints []int64                    // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.secret.count metric with initial data.
+func (m *metricVcsSecretCount) init() {
+	m.data.SetName("vcs.secret.count")
+	m.data.SetDescription("The number of open secret scanning alerts in the repository.")
+	m.data.SetUnit("{alert}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsSecretCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, secretTypeAttributeValue string, secretValidityAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsSecretCountMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsSecretCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsSecretCountMetricAttributeKeySecretType) {
+		dp.Attributes().PutStr("secret.type", secretTypeAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsSecretCountMetricAttributeKeySecretValidity) {
+		dp.Attributes().PutStr("secret.validity", secretValidityAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsSecretCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsSecretCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsSecretCount(cfg VcsSecretCountMetricConfig) metricVcsSecretCount {
+	m := metricVcsSecretCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricVcsSecretPushProtectionBypassCount struct {
+	data          pmetric.Metric                                 // data buffer for generated metric.
+	config        VcsSecretPushProtectionBypassCountMetricConfig // metric config provided by user.
+	capacity      int                                            // max observed number of data points added to the metric.
+	aggDataPoints []int64                                        // slice containing number of aggregated datapoints at each index
+}
+
+// init fills vcs.secret.push_protection_bypass.count metric with initial data.
+func (m *metricVcsSecretPushProtectionBypassCount) init() {
+	m.data.SetName("vcs.secret.push_protection_bypass.count")
+	m.data.SetDescription("The number of secret scanning alerts in the repository whose secret was pushed by bypassing push protection.")
+	m.data.SetUnit("{alert}")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+	m.aggDataPoints = m.aggDataPoints[:0]
+}
+
+func (m *metricVcsSecretPushProtectionBypassCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, secretTypeAttributeValue string) {
+	if !m.config.Enabled {
+		return
+	}
+
+	dp := pmetric.NewNumberDataPoint()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	if slices.Contains(m.config.EnabledAttributes, VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryURLFull) {
+		dp.Attributes().PutStr("vcs.repository.url.full", vcsRepositoryURLFullAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsSecretPushProtectionBypassCountMetricAttributeKeyVcsRepositoryName) {
+		dp.Attributes().PutStr("vcs.repository.name", vcsRepositoryNameAttributeValue)
+	}
+	if slices.Contains(m.config.EnabledAttributes, VcsSecretPushProtectionBypassCountMetricAttributeKeySecretType) {
+		dp.Attributes().PutStr("secret.type", secretTypeAttributeValue)
+	}
+
+	var s string
+	dps := m.data.Gauge().DataPoints()
+	for i := 0; i < dps.Len(); i++ {
+		dpi := dps.At(i)
+		if dp.Attributes().Equal(dpi.Attributes()) && dp.StartTimestamp() == dpi.StartTimestamp() && dp.Timestamp() == dpi.Timestamp() {
+			switch s = m.config.AggregationStrategy; s {
+			case AggregationStrategySum, AggregationStrategyAvg:
+				dpi.SetIntValue(dpi.IntValue() + val)
+				m.aggDataPoints[i] += 1
+				return
+			case AggregationStrategyMin:
+				if dpi.IntValue() > val {
+					dpi.SetIntValue(val)
+				}
+				return
+			case AggregationStrategyMax:
+				if dpi.IntValue() < val {
+					dpi.SetIntValue(val)
+				}
+				return
+			}
+		}
+	}
+
+	dp.SetIntValue(val)
+	m.aggDataPoints = append(m.aggDataPoints, 1)
+	dp.MoveTo(dps.AppendEmpty())
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricVcsSecretPushProtectionBypassCount) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricVcsSecretPushProtectionBypassCount) emit(metrics pmetric.MetricSlice) {
+	if m.config.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		if m.config.AggregationStrategy == AggregationStrategyAvg {
+			for i, aggCount := range m.aggDataPoints {
+				m.data.Gauge().DataPoints().At(i).SetIntValue(m.data.Gauge().DataPoints().At(i).IntValue() / aggCount)
+			}
+		}
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricVcsSecretPushProtectionBypassCount(cfg VcsSecretPushProtectionBypassCountMetricConfig) metricVcsSecretPushProtectionBypassCount {
+	m := metricVcsSecretPushProtectionBypassCount{config: cfg}
+
+	if cfg.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user config.
 type MetricsBuilder struct {
-	config                         MetricsBuilderConfig // config of the metrics builder.
-	startTime                      pcommon.Timestamp    // start time that will be applied to all recorded data points.
-	metricsCapacity                int                  // maximum observed number of metrics per resource.
-	metricsBuffer                  pmetric.Metrics      // accumulates metrics data before emitting.
-	buildInfo                      component.BuildInfo  // contains version information.
-	resourceAttributeIncludeFilter map[string]filter.Filter
-	resourceAttributeExcludeFilter map[string]filter.Filter
-	metricVcsChangeCount           metricVcsChangeCount
-	metricVcsChangeDuration        metricVcsChangeDuration
-	metricVcsChangeTimeToApproval  metricVcsChangeTimeToApproval
-	metricVcsChangeTimeToMerge     metricVcsChangeTimeToMerge
-	metricVcsContributorCount      metricVcsContributorCount
-	metricVcsCveCount              metricVcsCveCount
-	metricVcsRefCount              metricVcsRefCount
-	metricVcsRefLinesDelta         metricVcsRefLinesDelta
-	metricVcsRefRevisionsDelta     metricVcsRefRevisionsDelta
-	metricVcsRefTime               metricVcsRefTime
-	metricVcsRepositoryCount       metricVcsRepositoryCount
+	config                                   MetricsBuilderConfig // config of the metrics builder.
+	startTime                                pcommon.Timestamp    // start time that will be applied to all recorded data points.
+	metricsCapacity                          int                  // maximum observed number of metrics per resource.
+	metricsBuffer                            pmetric.Metrics      // accumulates metrics data before emitting.
+	buildInfo                                component.BuildInfo  // contains version information.
+	resourceAttributeIncludeFilter           map[string]filter.Filter
+	resourceAttributeExcludeFilter           map[string]filter.Filter
+	metricCicdPipelineRunCount               metricCicdPipelineRunCount
+	metricCicdPipelineTaskRunActive          metricCicdPipelineTaskRunActive
+	metricCicdPipelineTaskRunCount           metricCicdPipelineTaskRunCount
+	metricCicdPipelineTestCaseCount          metricCicdPipelineTestCaseCount
+	metricCicdPipelineTestCaseFlakyCount     metricCicdPipelineTestCaseFlakyCount
+	metricCicdWorkerCount                    metricCicdWorkerCount
+	metricCicdWorkerLabelCount               metricCicdWorkerLabelCount
+	metricDeployDeploymentChangeFailureRate  metricDeployDeploymentChangeFailureRate
+	metricDeployDeploymentCount              metricDeployDeploymentCount
+	metricDeployDeploymentFrequency          metricDeployDeploymentFrequency
+	metricDeployDeploymentLeadTime           metricDeployDeploymentLeadTime
+	metricDeployDeploymentTimeToRestore      metricDeployDeploymentTimeToRestore
+	metricVcsChangeCount                     metricVcsChangeCount
+	metricVcsChangeDuration                  metricVcsChangeDuration
+	metricVcsChangeReviewCommentCount        metricVcsChangeReviewCommentCount
+	metricVcsChangeReviewRoundCount          metricVcsChangeReviewRoundCount
+	metricVcsChangeReviewerCount             metricVcsChangeReviewerCount
+	metricVcsChangeTimeFromApprovalToMerge   metricVcsChangeTimeFromApprovalToMerge
+	metricVcsChangeTimeToApproval            metricVcsChangeTimeToApproval
+	metricVcsChangeTimeToFirstReview         metricVcsChangeTimeToFirstReview
+	metricVcsChangeTimeToMerge               metricVcsChangeTimeToMerge
+	metricVcsContributorCount                metricVcsContributorCount
+	metricVcsCveAge                          metricVcsCveAge
+	metricVcsCveCount                        metricVcsCveCount
+	metricVcsCveTimeToRemediate              metricVcsCveTimeToRemediate
+	metricVcsRefCount                        metricVcsRefCount
+	metricVcsRefLinesDelta                   metricVcsRefLinesDelta
+	metricVcsRefRevisionsDelta               metricVcsRefRevisionsDelta
+	metricVcsRefTime                         metricVcsRefTime
+	metricVcsRepositoryCount                 metricVcsRepositoryCount
+	metricVcsSecretCount                     metricVcsSecretCount
+	metricVcsSecretPushProtectionBypassCount metricVcsSecretPushProtectionBypassCount
 }
 
 // MetricBuilderOption applies changes to default metrics builder.
@@ -1317,23 +3677,44 @@ func WithStartTime(startTime pcommon.Timestamp) MetricBuilderOption {
 }
 func NewMetricsBuilder(mbc MetricsBuilderConfig, settings receiver.Settings, options ...MetricBuilderOption) *MetricsBuilder {
 	mb := &MetricsBuilder{
-		config:                         mbc,
-		startTime:                      pcommon.NewTimestampFromTime(time.Now()),
-		metricsBuffer:                  pmetric.NewMetrics(),
-		buildInfo:                      settings.BuildInfo,
-		metricVcsChangeCount:           newMetricVcsChangeCount(mbc.Metrics.VcsChangeCount),
-		metricVcsChangeDuration:        newMetricVcsChangeDuration(mbc.Metrics.VcsChangeDuration),
-		metricVcsChangeTimeToApproval:  newMetricVcsChangeTimeToApproval(mbc.Metrics.VcsChangeTimeToApproval),
-		metricVcsChangeTimeToMerge:     newMetricVcsChangeTimeToMerge(mbc.Metrics.VcsChangeTimeToMerge),
-		metricVcsContributorCount:      newMetricVcsContributorCount(mbc.Metrics.VcsContributorCount),
-		metricVcsCveCount:              newMetricVcsCveCount(mbc.Metrics.VcsCveCount),
-		metricVcsRefCount:              newMetricVcsRefCount(mbc.Metrics.VcsRefCount),
-		metricVcsRefLinesDelta:         newMetricVcsRefLinesDelta(mbc.Metrics.VcsRefLinesDelta),
-		metricVcsRefRevisionsDelta:     newMetricVcsRefRevisionsDelta(mbc.Metrics.VcsRefRevisionsDelta),
-		metricVcsRefTime:               newMetricVcsRefTime(mbc.Metrics.VcsRefTime),
-		metricVcsRepositoryCount:       newMetricVcsRepositoryCount(mbc.Metrics.VcsRepositoryCount),
-		resourceAttributeIncludeFilter: make(map[string]filter.Filter),
-		resourceAttributeExcludeFilter: make(map[string]filter.Filter),
+		config:                                   mbc,
+		startTime:                                pcommon.NewTimestampFromTime(time.Now()),
+		metricsBuffer:                            pmetric.NewMetrics(),
+		buildInfo:                                settings.BuildInfo,
+		metricCicdPipelineRunCount:               newMetricCicdPipelineRunCount(mbc.Metrics.CicdPipelineRunCount),
+		metricCicdPipelineTaskRunActive:          newMetricCicdPipelineTaskRunActive(mbc.Metrics.CicdPipelineTaskRunActive),
+		metricCicdPipelineTaskRunCount:           newMetricCicdPipelineTaskRunCount(mbc.Metrics.CicdPipelineTaskRunCount),
+		metricCicdPipelineTestCaseCount:          newMetricCicdPipelineTestCaseCount(mbc.Metrics.CicdPipelineTestCaseCount),
+		metricCicdPipelineTestCaseFlakyCount:     newMetricCicdPipelineTestCaseFlakyCount(mbc.Metrics.CicdPipelineTestCaseFlakyCount),
+		metricCicdWorkerCount:                    newMetricCicdWorkerCount(mbc.Metrics.CicdWorkerCount),
+		metricCicdWorkerLabelCount:               newMetricCicdWorkerLabelCount(mbc.Metrics.CicdWorkerLabelCount),
+		metricDeployDeploymentChangeFailureRate:  newMetricDeployDeploymentChangeFailureRate(mbc.Metrics.DeployDeploymentChangeFailureRate),
+		metricDeployDeploymentCount:              newMetricDeployDeploymentCount(mbc.Metrics.DeployDeploymentCount),
+		metricDeployDeploymentFrequency:          newMetricDeployDeploymentFrequency(mbc.Metrics.DeployDeploymentFrequency),
+		metricDeployDeploymentLeadTime:           newMetricDeployDeploymentLeadTime(mbc.Metrics.DeployDeploymentLeadTime),
+		metricDeployDeploymentTimeToRestore:      newMetricDeployDeploymentTimeToRestore(mbc.Metrics.DeployDeploymentTimeToRestore),
+		metricVcsChangeCount:                     newMetricVcsChangeCount(mbc.Metrics.VcsChangeCount),
+		metricVcsChangeDuration:                  newMetricVcsChangeDuration(mbc.Metrics.VcsChangeDuration),
+		metricVcsChangeReviewCommentCount:        newMetricVcsChangeReviewCommentCount(mbc.Metrics.VcsChangeReviewCommentCount),
+		metricVcsChangeReviewRoundCount:          newMetricVcsChangeReviewRoundCount(mbc.Metrics.VcsChangeReviewRoundCount),
+		metricVcsChangeReviewerCount:             newMetricVcsChangeReviewerCount(mbc.Metrics.VcsChangeReviewerCount),
+		metricVcsChangeTimeFromApprovalToMerge:   newMetricVcsChangeTimeFromApprovalToMerge(mbc.Metrics.VcsChangeTimeFromApprovalToMerge),
+		metricVcsChangeTimeToApproval:            newMetricVcsChangeTimeToApproval(mbc.Metrics.VcsChangeTimeToApproval),
+		metricVcsChangeTimeToFirstReview:         newMetricVcsChangeTimeToFirstReview(mbc.Metrics.VcsChangeTimeToFirstReview),
+		metricVcsChangeTimeToMerge:               newMetricVcsChangeTimeToMerge(mbc.Metrics.VcsChangeTimeToMerge),
+		metricVcsContributorCount:                newMetricVcsContributorCount(mbc.Metrics.VcsContributorCount),
+		metricVcsCveAge:                          newMetricVcsCveAge(mbc.Metrics.VcsCveAge),
+		metricVcsCveCount:                        newMetricVcsCveCount(mbc.Metrics.VcsCveCount),
+		metricVcsCveTimeToRemediate:              newMetricVcsCveTimeToRemediate(mbc.Metrics.VcsCveTimeToRemediate),
+		metricVcsRefCount:                        newMetricVcsRefCount(mbc.Metrics.VcsRefCount),
+		metricVcsRefLinesDelta:                   newMetricVcsRefLinesDelta(mbc.Metrics.VcsRefLinesDelta),
+		metricVcsRefRevisionsDelta:               newMetricVcsRefRevisionsDelta(mbc.Metrics.VcsRefRevisionsDelta),
+		metricVcsRefTime:                         newMetricVcsRefTime(mbc.Metrics.VcsRefTime),
+		metricVcsRepositoryCount:                 newMetricVcsRepositoryCount(mbc.Metrics.VcsRepositoryCount),
+		metricVcsSecretCount:                     newMetricVcsSecretCount(mbc.Metrics.VcsSecretCount),
+		metricVcsSecretPushProtectionBypassCount: newMetricVcsSecretPushProtectionBypassCount(mbc.Metrics.VcsSecretPushProtectionBypassCount),
+		resourceAttributeIncludeFilter:           make(map[string]filter.Filter),
+		resourceAttributeExcludeFilter:           make(map[string]filter.Filter),
 	}
 	if mbc.ResourceAttributes.OrganizationName.MetricsInclude != nil {
 		mb.resourceAttributeIncludeFilter["organization.name"] = filter.CreateFilter(mbc.ResourceAttributes.OrganizationName.MetricsInclude)
@@ -1423,17 +3804,38 @@ func (mb *MetricsBuilder) EmitForResource(options ...ResourceMetricsOption) {
 	ils.Scope().SetName(ScopeName)
 	ils.Scope().SetVersion(mb.buildInfo.Version)
 	ils.Metrics().EnsureCapacity(mb.metricsCapacity)
+	mb.metricCicdPipelineRunCount.emit(ils.Metrics())
+	mb.metricCicdPipelineTaskRunActive.emit(ils.Metrics())
+	mb.metricCicdPipelineTaskRunCount.emit(ils.Metrics())
+	mb.metricCicdPipelineTestCaseCount.emit(ils.Metrics())
+	mb.metricCicdPipelineTestCaseFlakyCount.emit(ils.Metrics())
+	mb.metricCicdWorkerCount.emit(ils.Metrics())
+	mb.metricCicdWorkerLabelCount.emit(ils.Metrics())
+	mb.metricDeployDeploymentChangeFailureRate.emit(ils.Metrics())
+	mb.metricDeployDeploymentCount.emit(ils.Metrics())
+	mb.metricDeployDeploymentFrequency.emit(ils.Metrics())
+	mb.metricDeployDeploymentLeadTime.emit(ils.Metrics())
+	mb.metricDeployDeploymentTimeToRestore.emit(ils.Metrics())
 	mb.metricVcsChangeCount.emit(ils.Metrics())
 	mb.metricVcsChangeDuration.emit(ils.Metrics())
+	mb.metricVcsChangeReviewCommentCount.emit(ils.Metrics())
+	mb.metricVcsChangeReviewRoundCount.emit(ils.Metrics())
+	mb.metricVcsChangeReviewerCount.emit(ils.Metrics())
+	mb.metricVcsChangeTimeFromApprovalToMerge.emit(ils.Metrics())
 	mb.metricVcsChangeTimeToApproval.emit(ils.Metrics())
+	mb.metricVcsChangeTimeToFirstReview.emit(ils.Metrics())
 	mb.metricVcsChangeTimeToMerge.emit(ils.Metrics())
 	mb.metricVcsContributorCount.emit(ils.Metrics())
+	mb.metricVcsCveAge.emit(ils.Metrics())
 	mb.metricVcsCveCount.emit(ils.Metrics())
+	mb.metricVcsCveTimeToRemediate.emit(ils.Metrics())
 	mb.metricVcsRefCount.emit(ils.Metrics())
 	mb.metricVcsRefLinesDelta.emit(ils.Metrics())
 	mb.metricVcsRefRevisionsDelta.emit(ils.Metrics())
 	mb.metricVcsRefTime.emit(ils.Metrics())
 	mb.metricVcsRepositoryCount.emit(ils.Metrics())
+	mb.metricVcsSecretCount.emit(ils.Metrics())
+	mb.metricVcsSecretPushProtectionBypassCount.emit(ils.Metrics())
 
 	for _, op := range options {
 		op.apply(rm)
@@ -1465,6 +3867,66 @@ func (mb *MetricsBuilder) Emit(options ...ResourceMetricsOption) pmetric.Metrics
 	return metrics
 }
 
+// RecordCicdPipelineRunCountDataPoint adds a data point to cicd.pipeline.run.count metric.
+func (mb *MetricsBuilder) RecordCicdPipelineRunCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, cicdPipelineResultAttributeValue string) {
+	mb.metricCicdPipelineRunCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryNameAttributeValue, cicdPipelineNameAttributeValue, vcsRefHeadNameAttributeValue, cicdPipelineResultAttributeValue)
+}
+
+// RecordCicdPipelineTaskRunActiveDataPoint adds a data point to cicd.pipeline.task.run.active metric.
+func (mb *MetricsBuilder) RecordCicdPipelineTaskRunActiveDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineWorkerLabelsAttributeValue []any, cicdPipelineRunStateAttributeValue AttributeCicdPipelineRunState) {
+	mb.metricCicdPipelineTaskRunActive.recordDataPoint(mb.startTime, ts, val, vcsRepositoryNameAttributeValue, cicdPipelineWorkerLabelsAttributeValue, cicdPipelineRunStateAttributeValue.String())
+}
+
+// RecordCicdPipelineTaskRunCountDataPoint adds a data point to cicd.pipeline.task.run.count metric.
+func (mb *MetricsBuilder) RecordCicdPipelineTaskRunCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, cicdPipelineWorkerLabelsAttributeValue []any, cicdPipelineResultAttributeValue string) {
+	mb.metricCicdPipelineTaskRunCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryNameAttributeValue, cicdPipelineNameAttributeValue, vcsRefHeadNameAttributeValue, cicdPipelineWorkerLabelsAttributeValue, cicdPipelineResultAttributeValue)
+}
+
+// RecordCicdPipelineTestCaseCountDataPoint adds a data point to cicd.pipeline.test.case.count metric.
+func (mb *MetricsBuilder) RecordCicdPipelineTestCaseCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, testCaseResultStatusAttributeValue AttributeTestCaseResultStatus) {
+	mb.metricCicdPipelineTestCaseCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryNameAttributeValue, cicdPipelineNameAttributeValue, vcsRefHeadNameAttributeValue, testCaseResultStatusAttributeValue.String())
+}
+
+// RecordCicdPipelineTestCaseFlakyCountDataPoint adds a data point to cicd.pipeline.test.case.flaky.count metric.
+func (mb *MetricsBuilder) RecordCicdPipelineTestCaseFlakyCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryNameAttributeValue string, cicdPipelineNameAttributeValue string, vcsRefHeadNameAttributeValue string, testSuiteNameAttributeValue string) {
+	mb.metricCicdPipelineTestCaseFlakyCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryNameAttributeValue, cicdPipelineNameAttributeValue, vcsRefHeadNameAttributeValue, testSuiteNameAttributeValue)
+}
+
+// RecordCicdWorkerCountDataPoint adds a data point to cicd.worker.count metric.
+func (mb *MetricsBuilder) RecordCicdWorkerCountDataPoint(ts pcommon.Timestamp, val int64, cicdWorkerStateAttributeValue AttributeCicdWorkerState, cicdWorkerGroupNameAttributeValue string, vcsRepositoryNameAttributeValue string) {
+	mb.metricCicdWorkerCount.recordDataPoint(mb.startTime, ts, val, cicdWorkerStateAttributeValue.String(), cicdWorkerGroupNameAttributeValue, vcsRepositoryNameAttributeValue)
+}
+
+// RecordCicdWorkerLabelCountDataPoint adds a data point to cicd.worker.label.count metric.
+func (mb *MetricsBuilder) RecordCicdWorkerLabelCountDataPoint(ts pcommon.Timestamp, val int64, cicdWorkerStateAttributeValue AttributeCicdWorkerState, cicdWorkerLabelAttributeValue string, vcsRepositoryNameAttributeValue string) {
+	mb.metricCicdWorkerLabelCount.recordDataPoint(mb.startTime, ts, val, cicdWorkerStateAttributeValue.String(), cicdWorkerLabelAttributeValue, vcsRepositoryNameAttributeValue)
+}
+
+// RecordDeployDeploymentChangeFailureRateDataPoint adds a data point to deploy.deployment.change_failure_rate metric.
+func (mb *MetricsBuilder) RecordDeployDeploymentChangeFailureRateDataPoint(ts pcommon.Timestamp, val float64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	mb.metricDeployDeploymentChangeFailureRate.recordDataPoint(mb.startTime, ts, val, serviceNameAttributeValue, deploymentEnvironmentNameAttributeValue)
+}
+
+// RecordDeployDeploymentCountDataPoint adds a data point to deploy.deployment.count metric.
+func (mb *MetricsBuilder) RecordDeployDeploymentCountDataPoint(ts pcommon.Timestamp, val int64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string, deploymentStatusAttributeValue AttributeDeploymentStatus) {
+	mb.metricDeployDeploymentCount.recordDataPoint(mb.startTime, ts, val, serviceNameAttributeValue, deploymentEnvironmentNameAttributeValue, deploymentStatusAttributeValue.String())
+}
+
+// RecordDeployDeploymentFrequencyDataPoint adds a data point to deploy.deployment.frequency metric.
+func (mb *MetricsBuilder) RecordDeployDeploymentFrequencyDataPoint(ts pcommon.Timestamp, val float64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	mb.metricDeployDeploymentFrequency.recordDataPoint(mb.startTime, ts, val, serviceNameAttributeValue, deploymentEnvironmentNameAttributeValue)
+}
+
+// RecordDeployDeploymentLeadTimeDataPoint adds a data point to deploy.deployment.lead_time metric.
+func (mb *MetricsBuilder) RecordDeployDeploymentLeadTimeDataPoint(ts pcommon.Timestamp, val int64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	mb.metricDeployDeploymentLeadTime.recordDataPoint(mb.startTime, ts, val, serviceNameAttributeValue, deploymentEnvironmentNameAttributeValue)
+}
+
+// RecordDeployDeploymentTimeToRestoreDataPoint adds a data point to deploy.deployment.time_to_restore metric.
+func (mb *MetricsBuilder) RecordDeployDeploymentTimeToRestoreDataPoint(ts pcommon.Timestamp, val int64, serviceNameAttributeValue string, deploymentEnvironmentNameAttributeValue string) {
+	mb.metricDeployDeploymentTimeToRestore.recordDataPoint(mb.startTime, ts, val, serviceNameAttributeValue, deploymentEnvironmentNameAttributeValue)
+}
+
 // RecordVcsChangeCountDataPoint adds a data point to vcs.change.count metric.
 func (mb *MetricsBuilder) RecordVcsChangeCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsChangeStateAttributeValue AttributeVcsChangeState, vcsRepositoryNameAttributeValue string) {
 	mb.metricVcsChangeCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsChangeStateAttributeValue.String(), vcsRepositoryNameAttributeValue)
@@ -1475,11 +3937,36 @@ func (mb *MetricsBuilder) RecordVcsChangeDurationDataPoint(ts pcommon.Timestamp,
 	mb.metricVcsChangeDuration.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue, vcsChangeStateAttributeValue.String())
 }
 
+// RecordVcsChangeReviewCommentCountDataPoint adds a data point to vcs.change.review.comment.count metric.
+func (mb *MetricsBuilder) RecordVcsChangeReviewCommentCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue AttributeVcsChangeState) {
+	mb.metricVcsChangeReviewCommentCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue, vcsChangeStateAttributeValue.String())
+}
+
+// RecordVcsChangeReviewRoundCountDataPoint adds a data point to vcs.change.review.round.count metric.
+func (mb *MetricsBuilder) RecordVcsChangeReviewRoundCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue AttributeVcsChangeState) {
+	mb.metricVcsChangeReviewRoundCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue, vcsChangeStateAttributeValue.String())
+}
+
+// RecordVcsChangeReviewerCountDataPoint adds a data point to vcs.change.reviewer.count metric.
+func (mb *MetricsBuilder) RecordVcsChangeReviewerCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue AttributeVcsChangeState) {
+	mb.metricVcsChangeReviewerCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue, vcsChangeStateAttributeValue.String())
+}
+
+// RecordVcsChangeTimeFromApprovalToMergeDataPoint adds a data point to vcs.change.time_from_approval_to_merge metric.
+func (mb *MetricsBuilder) RecordVcsChangeTimeFromApprovalToMergeDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
+	mb.metricVcsChangeTimeFromApprovalToMerge.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue)
+}
+
 // RecordVcsChangeTimeToApprovalDataPoint adds a data point to vcs.change.time_to_approval metric.
 func (mb *MetricsBuilder) RecordVcsChangeTimeToApprovalDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
 	mb.metricVcsChangeTimeToApproval.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue)
 }
 
+// RecordVcsChangeTimeToFirstReviewDataPoint adds a data point to vcs.change.time_to_first_review metric.
+func (mb *MetricsBuilder) RecordVcsChangeTimeToFirstReviewDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string, vcsChangeStateAttributeValue AttributeVcsChangeState) {
+	mb.metricVcsChangeTimeToFirstReview.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue, vcsChangeStateAttributeValue.String())
+}
+
 // RecordVcsChangeTimeToMergeDataPoint adds a data point to vcs.change.time_to_merge metric.
 func (mb *MetricsBuilder) RecordVcsChangeTimeToMergeDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadNameAttributeValue string) {
 	mb.metricVcsChangeTimeToMerge.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadNameAttributeValue)
@@ -1490,11 +3977,21 @@ func (mb *MetricsBuilder) RecordVcsContributorCountDataPoint(ts pcommon.Timestam
 	mb.metricVcsContributorCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue)
 }
 
+// RecordVcsCveAgeDataPoint adds a data point to vcs.cve.age metric.
+func (mb *MetricsBuilder) RecordVcsCveAgeDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, cveSeverityAttributeValue AttributeCveSeverity, cveSourceAttributeValue AttributeCveSource, cvePackageEcosystemAttributeValue string) {
+	mb.metricVcsCveAge.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, cveSeverityAttributeValue.String(), cveSourceAttributeValue.String(), cvePackageEcosystemAttributeValue)
+}
+
 // RecordVcsCveCountDataPoint adds a data point to vcs.cve.count metric.
 func (mb *MetricsBuilder) RecordVcsCveCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, cveSeverityAttributeValue AttributeCveSeverity) {
 	mb.metricVcsCveCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, cveSeverityAttributeValue.String())
 }
 
+// RecordVcsCveTimeToRemediateDataPoint adds a data point to vcs.cve.time_to_remediate metric.
+func (mb *MetricsBuilder) RecordVcsCveTimeToRemediateDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, cveSeverityAttributeValue AttributeCveSeverity, cveSourceAttributeValue AttributeCveSource, cvePackageEcosystemAttributeValue string, cveResolutionAttributeValue AttributeCveResolution) {
+	mb.metricVcsCveTimeToRemediate.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, cveSeverityAttributeValue.String(), cveSourceAttributeValue.String(), cvePackageEcosystemAttributeValue, cveResolutionAttributeValue.String())
+}
+
 // RecordVcsRefCountDataPoint adds a data point to vcs.ref.count metric.
 func (mb *MetricsBuilder) RecordVcsRefCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, vcsRefHeadTypeAttributeValue AttributeVcsRefHeadType) {
 	mb.metricVcsRefCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, vcsRefHeadTypeAttributeValue.String())
@@ -1520,6 +4017,16 @@ func (mb *MetricsBuilder) RecordVcsRepositoryCountDataPoint(ts pcommon.Timestamp
 	mb.metricVcsRepositoryCount.recordDataPoint(mb.startTime, ts, val)
 }
 
+// RecordVcsSecretCountDataPoint adds a data point to vcs.secret.count metric.
+func (mb *MetricsBuilder) RecordVcsSecretCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, secretTypeAttributeValue string, secretValidityAttributeValue AttributeSecretValidity) {
+	mb.metricVcsSecretCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, secretTypeAttributeValue, secretValidityAttributeValue.String())
+}
+
+// RecordVcsSecretPushProtectionBypassCountDataPoint adds a data point to vcs.secret.push_protection_bypass.count metric.
+func (mb *MetricsBuilder) RecordVcsSecretPushProtectionBypassCountDataPoint(ts pcommon.Timestamp, val int64, vcsRepositoryURLFullAttributeValue string, vcsRepositoryNameAttributeValue string, secretTypeAttributeValue string) {
+	mb.metricVcsSecretPushProtectionBypassCount.recordDataPoint(mb.startTime, ts, val, vcsRepositoryURLFullAttributeValue, vcsRepositoryNameAttributeValue, secretTypeAttributeValue)
+}
+
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
 // and metrics builder should update its startTime and reset it's internal state accordingly.
 func (mb *MetricsBuilder) Reset(options ...MetricBuilderOption) {
@@ -67,16 +67,37 @@ func TestMetricsBuilder(t *testing.T) {
 			settings.Logger = zap.New(observedZapCore)
 			mb := NewMetricsBuilder(loadMetricsBuilderConfig(t, tt.name), settings, WithStartTime(start))
 			aggMap := make(map[string]string) // contains the aggregation strategies for each metric name
+			aggMap["cicd.pipeline.run.count"] = mb.metricCicdPipelineRunCount.config.AggregationStrategy
+			aggMap["cicd.pipeline.task.run.active"] = mb.metricCicdPipelineTaskRunActive.config.AggregationStrategy
+			aggMap["cicd.pipeline.task.run.count"] = mb.metricCicdPipelineTaskRunCount.config.AggregationStrategy
+			aggMap["cicd.pipeline.test.case.count"] = mb.metricCicdPipelineTestCaseCount.config.AggregationStrategy
+			aggMap["cicd.pipeline.test.case.flaky.count"] = mb.metricCicdPipelineTestCaseFlakyCount.config.AggregationStrategy
+			aggMap["cicd.worker.count"] = mb.metricCicdWorkerCount.config.AggregationStrategy
+			aggMap["cicd.worker.label.count"] = mb.metricCicdWorkerLabelCount.config.AggregationStrategy
+			aggMap["deploy.deployment.change_failure_rate"] = mb.metricDeployDeploymentChangeFailureRate.config.AggregationStrategy
+			aggMap["deploy.deployment.count"] = mb.metricDeployDeploymentCount.config.AggregationStrategy
+			aggMap["deploy.deployment.frequency"] = mb.metricDeployDeploymentFrequency.config.AggregationStrategy
+			aggMap["deploy.deployment.lead_time"] = mb.metricDeployDeploymentLeadTime.config.AggregationStrategy
+			aggMap["deploy.deployment.time_to_restore"] = mb.metricDeployDeploymentTimeToRestore.config.AggregationStrategy
 			aggMap["vcs.change.count"] = mb.metricVcsChangeCount.config.AggregationStrategy
 			aggMap["vcs.change.duration"] = mb.metricVcsChangeDuration.config.AggregationStrategy
+			aggMap["vcs.change.review.comment.count"] = mb.metricVcsChangeReviewCommentCount.config.AggregationStrategy
+			aggMap["vcs.change.review.round.count"] = mb.metricVcsChangeReviewRoundCount.config.AggregationStrategy
+			aggMap["vcs.change.reviewer.count"] = mb.metricVcsChangeReviewerCount.config.AggregationStrategy
+			aggMap["vcs.change.time_from_approval_to_merge"] = mb.metricVcsChangeTimeFromApprovalToMerge.config.AggregationStrategy
 			aggMap["vcs.change.time_to_approval"] = mb.metricVcsChangeTimeToApproval.config.AggregationStrategy
+			aggMap["vcs.change.time_to_first_review"] = mb.metricVcsChangeTimeToFirstReview.config.AggregationStrategy
 			aggMap["vcs.change.time_to_merge"] = mb.metricVcsChangeTimeToMerge.config.AggregationStrategy
 			aggMap["vcs.contributor.count"] = mb.metricVcsContributorCount.config.AggregationStrategy
+			aggMap["vcs.cve.age"] = mb.metricVcsCveAge.config.AggregationStrategy
 			aggMap["vcs.cve.count"] = mb.metricVcsCveCount.config.AggregationStrategy
+			aggMap["vcs.cve.time_to_remediate"] = mb.metricVcsCveTimeToRemediate.config.AggregationStrategy
 			aggMap["vcs.ref.count"] = mb.metricVcsRefCount.config.AggregationStrategy
 			aggMap["vcs.ref.lines_delta"] = mb.metricVcsRefLinesDelta.config.AggregationStrategy
 			aggMap["vcs.ref.revisions_delta"] = mb.metricVcsRefRevisionsDelta.config.AggregationStrategy
 			aggMap["vcs.ref.time"] = mb.metricVcsRefTime.config.AggregationStrategy
+			aggMap["vcs.secret.count"] = mb.metricVcsSecretCount.config.AggregationStrategy
+			aggMap["vcs.secret.push_protection_bypass.count"] = mb.metricVcsSecretPushProtectionBypassCount.config.AggregationStrategy
 
 			expectedWarnings := 0
 			if tt.metricsSet != testDataSetReag {
@@ -87,6 +108,78 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount := 0
 			defaultMetricsCount++
 			allMetricsCount++
+			mb.RecordCicdPipelineRunCountDataPoint(ts, 1, "vcs.repository.name-val", "cicd.pipeline.name-val", "vcs.ref.head.name-val", "cicd.pipeline.result-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordCicdPipelineRunCountDataPoint(ts, 3, "vcs.repository.name-val-2", "cicd.pipeline.name-val-2", "vcs.ref.head.name-val-2", "cicd.pipeline.result-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordCicdPipelineTaskRunActiveDataPoint(ts, 1, "vcs.repository.name-val", []any{"cicd.pipeline.worker.labels-item1", "cicd.pipeline.worker.labels-item2"}, AttributeCicdPipelineRunStatePending)
+			if tt.name == "reaggregate_set" {
+				mb.RecordCicdPipelineTaskRunActiveDataPoint(ts, 3, "vcs.repository.name-val-2", []any{"cicd.pipeline.worker.labels-item3", "cicd.pipeline.worker.labels-item4"}, AttributeCicdPipelineRunStateExecuting)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordCicdPipelineTaskRunCountDataPoint(ts, 1, "vcs.repository.name-val", "cicd.pipeline.name-val", "vcs.ref.head.name-val", []any{"cicd.pipeline.worker.labels-item1", "cicd.pipeline.worker.labels-item2"}, "cicd.pipeline.result-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordCicdPipelineTaskRunCountDataPoint(ts, 3, "vcs.repository.name-val-2", "cicd.pipeline.name-val-2", "vcs.ref.head.name-val-2", []any{"cicd.pipeline.worker.labels-item3", "cicd.pipeline.worker.labels-item4"}, "cicd.pipeline.result-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordCicdPipelineTestCaseCountDataPoint(ts, 1, "vcs.repository.name-val", "cicd.pipeline.name-val", "vcs.ref.head.name-val", AttributeTestCaseResultStatusPass)
+			if tt.name == "reaggregate_set" {
+				mb.RecordCicdPipelineTestCaseCountDataPoint(ts, 3, "vcs.repository.name-val-2", "cicd.pipeline.name-val-2", "vcs.ref.head.name-val-2", AttributeTestCaseResultStatusFail)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordCicdPipelineTestCaseFlakyCountDataPoint(ts, 1, "vcs.repository.name-val", "cicd.pipeline.name-val", "vcs.ref.head.name-val", "test.suite.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordCicdPipelineTestCaseFlakyCountDataPoint(ts, 3, "vcs.repository.name-val-2", "cicd.pipeline.name-val-2", "vcs.ref.head.name-val-2", "test.suite.name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordCicdWorkerCountDataPoint(ts, 1, AttributeCicdWorkerStateAvailable, "cicd.worker.group.name-val", "vcs.repository.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordCicdWorkerCountDataPoint(ts, 3, AttributeCicdWorkerStateBusy, "cicd.worker.group.name-val-2", "vcs.repository.name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordCicdWorkerLabelCountDataPoint(ts, 1, AttributeCicdWorkerStateAvailable, "cicd.worker.label-val", "vcs.repository.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordCicdWorkerLabelCountDataPoint(ts, 3, AttributeCicdWorkerStateBusy, "cicd.worker.label-val-2", "vcs.repository.name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordDeployDeploymentChangeFailureRateDataPoint(ts, 1, "service.name-val", "deployment.environment.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordDeployDeploymentChangeFailureRateDataPoint(ts, 3, "service.name-val-2", "deployment.environment.name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordDeployDeploymentCountDataPoint(ts, 1, "service.name-val", "deployment.environment.name-val", AttributeDeploymentStatusSucceeded)
+			if tt.name == "reaggregate_set" {
+				mb.RecordDeployDeploymentCountDataPoint(ts, 3, "service.name-val-2", "deployment.environment.name-val-2", AttributeDeploymentStatusFailed)
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordDeployDeploymentFrequencyDataPoint(ts, 1, "service.name-val", "deployment.environment.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordDeployDeploymentFrequencyDataPoint(ts, 3, "service.name-val-2", "deployment.environment.name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordDeployDeploymentLeadTimeDataPoint(ts, 1, "service.name-val", "deployment.environment.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordDeployDeploymentLeadTimeDataPoint(ts, 3, "service.name-val-2", "deployment.environment.name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
+			mb.RecordDeployDeploymentTimeToRestoreDataPoint(ts, 1, "service.name-val", "deployment.environment.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordDeployDeploymentTimeToRestoreDataPoint(ts, 3, "service.name-val-2", "deployment.environment.name-val-2")
+			}
+			defaultMetricsCount++
+			allMetricsCount++
 			mb.RecordVcsChangeCountDataPoint(ts, 1, "vcs.repository.url.full-val", AttributeVcsChangeStateOpen, "vcs.repository.name-val")
 			if tt.name == "reaggregate_set" {
 				mb.RecordVcsChangeCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", AttributeVcsChangeStateMerged, "vcs.repository.name-val-2")
@@ -97,12 +190,42 @@ func TestMetricsBuilder(t *testing.T) {
 			if tt.name == "reaggregate_set" {
 				mb.RecordVcsChangeDurationDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "vcs.ref.head.name-val-2", AttributeVcsChangeStateMerged)
 			}
+
+			allMetricsCount++
+			mb.RecordVcsChangeReviewCommentCountDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "vcs.ref.head.name-val", AttributeVcsChangeStateOpen)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsChangeReviewCommentCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "vcs.ref.head.name-val-2", AttributeVcsChangeStateMerged)
+			}
+
+			allMetricsCount++
+			mb.RecordVcsChangeReviewRoundCountDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "vcs.ref.head.name-val", AttributeVcsChangeStateOpen)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsChangeReviewRoundCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "vcs.ref.head.name-val-2", AttributeVcsChangeStateMerged)
+			}
+
+			allMetricsCount++
+			mb.RecordVcsChangeReviewerCountDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "vcs.ref.head.name-val", AttributeVcsChangeStateOpen)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsChangeReviewerCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "vcs.ref.head.name-val-2", AttributeVcsChangeStateMerged)
+			}
+
+			allMetricsCount++
+			mb.RecordVcsChangeTimeFromApprovalToMergeDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "vcs.ref.head.name-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsChangeTimeFromApprovalToMergeDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "vcs.ref.head.name-val-2")
+			}
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordVcsChangeTimeToApprovalDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "vcs.ref.head.name-val")
 			if tt.name == "reaggregate_set" {
 				mb.RecordVcsChangeTimeToApprovalDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "vcs.ref.head.name-val-2")
 			}
+
+			allMetricsCount++
+			mb.RecordVcsChangeTimeToFirstReviewDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "vcs.ref.head.name-val", AttributeVcsChangeStateOpen)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsChangeTimeToFirstReviewDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "vcs.ref.head.name-val-2", AttributeVcsChangeStateMerged)
+			}
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordVcsChangeTimeToMergeDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "vcs.ref.head.name-val")
@@ -116,11 +239,23 @@ func TestMetricsBuilder(t *testing.T) {
 				mb.RecordVcsContributorCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2")
 			}
 
+			allMetricsCount++
+			mb.RecordVcsCveAgeDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", AttributeCveSeverityCritical, AttributeCveSourceDependabot, "cve.package.ecosystem-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsCveAgeDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", AttributeCveSeverityHigh, AttributeCveSourceCodeScanning, "cve.package.ecosystem-val-2")
+			}
+
 			allMetricsCount++
 			mb.RecordVcsCveCountDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", AttributeCveSeverityCritical)
 			if tt.name == "reaggregate_set" {
 				mb.RecordVcsCveCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", AttributeCveSeverityHigh)
 			}
+
+			allMetricsCount++
+			mb.RecordVcsCveTimeToRemediateDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", AttributeCveSeverityCritical, AttributeCveSourceDependabot, "cve.package.ecosystem-val", AttributeCveResolutionFixed)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsCveTimeToRemediateDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", AttributeCveSeverityHigh, AttributeCveSourceCodeScanning, "cve.package.ecosystem-val-2", AttributeCveResolutionDismissed)
+			}
 			defaultMetricsCount++
 			allMetricsCount++
 			mb.RecordVcsRefCountDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", AttributeVcsRefHeadTypeBranch)
@@ -149,6 +284,18 @@ func TestMetricsBuilder(t *testing.T) {
 			allMetricsCount++
 			mb.RecordVcsRepositoryCountDataPoint(ts, 1)
 
+			allMetricsCount++
+			mb.RecordVcsSecretCountDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "secret.type-val", AttributeSecretValidityActive)
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsSecretCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "secret.type-val-2", AttributeSecretValidityInactive)
+			}
+
+			allMetricsCount++
+			mb.RecordVcsSecretPushProtectionBypassCountDataPoint(ts, 1, "vcs.repository.url.full-val", "vcs.repository.name-val", "secret.type-val")
+			if tt.name == "reaggregate_set" {
+				mb.RecordVcsSecretPushProtectionBypassCountDataPoint(ts, 3, "vcs.repository.url.full-val-2", "vcs.repository.name-val-2", "secret.type-val-2")
+			}
+
 			rb := mb.NewResourceBuilder()
 			rb.SetOrganizationName("organization.name-val")
 			rb.SetTeamName("team.name-val")
@@ -156,16 +303,37 @@ func TestMetricsBuilder(t *testing.T) {
 			res := rb.Emit()
 			metrics := mb.Emit(WithResource(res))
 			if tt.name == "reaggregate_set" {
+				assert.Empty(t, mb.metricCicdPipelineRunCount.aggDataPoints)
+				assert.Empty(t, mb.metricCicdPipelineTaskRunActive.aggDataPoints)
+				assert.Empty(t, mb.metricCicdPipelineTaskRunCount.aggDataPoints)
+				assert.Empty(t, mb.metricCicdPipelineTestCaseCount.aggDataPoints)
+				assert.Empty(t, mb.metricCicdPipelineTestCaseFlakyCount.aggDataPoints)
+				assert.Empty(t, mb.metricCicdWorkerCount.aggDataPoints)
+				assert.Empty(t, mb.metricCicdWorkerLabelCount.aggDataPoints)
+				assert.Empty(t, mb.metricDeployDeploymentChangeFailureRate.aggDataPoints)
+				assert.Empty(t, mb.metricDeployDeploymentCount.aggDataPoints)
+				assert.Empty(t, mb.metricDeployDeploymentFrequency.aggDataPoints)
+				assert.Empty(t, mb.metricDeployDeploymentLeadTime.aggDataPoints)
+				assert.Empty(t, mb.metricDeployDeploymentTimeToRestore.aggDataPoints)
 				assert.Empty(t, mb.metricVcsChangeCount.aggDataPoints)
 				assert.Empty(t, mb.metricVcsChangeDuration.aggDataPoints)
+				assert.Empty(t, mb.metricVcsChangeReviewCommentCount.aggDataPoints)
+				assert.Empty(t, mb.metricVcsChangeReviewRoundCount.aggDataPoints)
+				assert.Empty(t, mb.metricVcsChangeReviewerCount.aggDataPoints)
+				assert.Empty(t, mb.metricVcsChangeTimeFromApprovalToMerge.aggDataPoints)
 				assert.Empty(t, mb.metricVcsChangeTimeToApproval.aggDataPoints)
+				assert.Empty(t, mb.metricVcsChangeTimeToFirstReview.aggDataPoints)
 				assert.Empty(t, mb.metricVcsChangeTimeToMerge.aggDataPoints)
 				assert.Empty(t, mb.metricVcsContributorCount.aggDataPoints)
+				assert.Empty(t, mb.metricVcsCveAge.aggDataPoints)
 				assert.Empty(t, mb.metricVcsCveCount.aggDataPoints)
+				assert.Empty(t, mb.metricVcsCveTimeToRemediate.aggDataPoints)
 				assert.Empty(t, mb.metricVcsRefCount.aggDataPoints)
 				assert.Empty(t, mb.metricVcsRefLinesDelta.aggDataPoints)
 				assert.Empty(t, mb.metricVcsRefRevisionsDelta.aggDataPoints)
 				assert.Empty(t, mb.metricVcsRefTime.aggDataPoints)
+				assert.Empty(t, mb.metricVcsSecretCount.aggDataPoints)
+				assert.Empty(t, mb.metricVcsSecretPushProtectionBypassCount.aggDataPoints)
 			}
 
 			if tt.expectEmpty {
@@ -193,14 +361,850 @@ func TestMetricsBuilder(t *testing.T) {
 			validatedMetrics := make(map[string]bool)
 			for _, mi := range allMetricsList {
 				switch mi.Name() {
-				case "vcs.change.count":
+				case "cicd.pipeline.run.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["cicd.pipeline.run.count"], "Found a duplicate in the metrics slice: cicd.pipeline.run.count")
+						validatedMetrics["cicd.pipeline.run.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of completed workflow runs, received by the webhook when `webhook.metrics.enabled` is set.", mi.Description())
+						assert.Equal(t, "{run}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						cicdPipelineNameAttrVal, ok := dp.Attributes().Get("cicd.pipeline.name")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.pipeline.name-val", cicdPipelineNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						cicdPipelineResultAttrVal, ok := dp.Attributes().Get("cicd.pipeline.result")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.pipeline.result-val", cicdPipelineResultAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["cicd.pipeline.run.count"], "Found a duplicate in the metrics slice: cicd.pipeline.run.count")
+						validatedMetrics["cicd.pipeline.run.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of completed workflow runs, received by the webhook when `webhook.metrics.enabled` is set.", mi.Description())
+						assert.Equal(t, "{run}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["cicd.pipeline.run.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.result")
+						assert.False(t, ok)
+					}
+				case "cicd.pipeline.task.run.active":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["cicd.pipeline.task.run.active"], "Found a duplicate in the metrics slice: cicd.pipeline.task.run.active")
+						validatedMetrics["cicd.pipeline.task.run.active"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of workflow jobs waiting for or running on a runner, tracked by the webhook when `webhook.metrics.enabled` is set.", mi.Description())
+						assert.Equal(t, "{job}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						cicdPipelineWorkerLabelsAttrVal, ok := dp.Attributes().Get("cicd.pipeline.worker.labels")
+						assert.True(t, ok)
+						assert.Equal(t, []any{"cicd.pipeline.worker.labels-item1", "cicd.pipeline.worker.labels-item2"}, cicdPipelineWorkerLabelsAttrVal.Slice().AsRaw())
+						cicdPipelineRunStateAttrVal, ok := dp.Attributes().Get("cicd.pipeline.run.state")
+						assert.True(t, ok)
+						assert.Equal(t, "pending", cicdPipelineRunStateAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["cicd.pipeline.task.run.active"], "Found a duplicate in the metrics slice: cicd.pipeline.task.run.active")
+						validatedMetrics["cicd.pipeline.task.run.active"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of workflow jobs waiting for or running on a runner, tracked by the webhook when `webhook.metrics.enabled` is set.", mi.Description())
+						assert.Equal(t, "{job}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["cicd.pipeline.task.run.active"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.worker.labels")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.run.state")
+						assert.False(t, ok)
+					}
+				case "cicd.pipeline.task.run.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["cicd.pipeline.task.run.count"], "Found a duplicate in the metrics slice: cicd.pipeline.task.run.count")
+						validatedMetrics["cicd.pipeline.task.run.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of completed workflow jobs, received by the webhook when `webhook.metrics.enabled` is set.", mi.Description())
+						assert.Equal(t, "{job}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						cicdPipelineNameAttrVal, ok := dp.Attributes().Get("cicd.pipeline.name")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.pipeline.name-val", cicdPipelineNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						cicdPipelineWorkerLabelsAttrVal, ok := dp.Attributes().Get("cicd.pipeline.worker.labels")
+						assert.True(t, ok)
+						assert.Equal(t, []any{"cicd.pipeline.worker.labels-item1", "cicd.pipeline.worker.labels-item2"}, cicdPipelineWorkerLabelsAttrVal.Slice().AsRaw())
+						cicdPipelineResultAttrVal, ok := dp.Attributes().Get("cicd.pipeline.result")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.pipeline.result-val", cicdPipelineResultAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["cicd.pipeline.task.run.count"], "Found a duplicate in the metrics slice: cicd.pipeline.task.run.count")
+						validatedMetrics["cicd.pipeline.task.run.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of completed workflow jobs, received by the webhook when `webhook.metrics.enabled` is set.", mi.Description())
+						assert.Equal(t, "{job}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["cicd.pipeline.task.run.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.worker.labels")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.result")
+						assert.False(t, ok)
+					}
+				case "cicd.pipeline.test.case.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["cicd.pipeline.test.case.count"], "Found a duplicate in the metrics slice: cicd.pipeline.test.case.count")
+						validatedMetrics["cicd.pipeline.test.case.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of test cases of a completed workflow run attempt by result, read from its test results when `webhook.test_results.enabled` and `webhook.metrics.enabled` are set.", mi.Description())
+						assert.Equal(t, "{test}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						cicdPipelineNameAttrVal, ok := dp.Attributes().Get("cicd.pipeline.name")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.pipeline.name-val", cicdPipelineNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						testCaseResultStatusAttrVal, ok := dp.Attributes().Get("test.case.result.status")
+						assert.True(t, ok)
+						assert.Equal(t, "pass", testCaseResultStatusAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["cicd.pipeline.test.case.count"], "Found a duplicate in the metrics slice: cicd.pipeline.test.case.count")
+						validatedMetrics["cicd.pipeline.test.case.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of test cases of a completed workflow run attempt by result, read from its test results when `webhook.test_results.enabled` and `webhook.metrics.enabled` are set.", mi.Description())
+						assert.Equal(t, "{test}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["cicd.pipeline.test.case.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("test.case.result.status")
+						assert.False(t, ok)
+					}
+				case "cicd.pipeline.test.case.flaky.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["cicd.pipeline.test.case.flaky.count"], "Found a duplicate in the metrics slice: cicd.pipeline.test.case.flaky.count")
+						validatedMetrics["cicd.pipeline.test.case.flaky.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of test cases of a test suite which passed on a retry of a workflow run after failing on a previous attempt, read from its test results when `webhook.test_results.enabled` and `webhook.metrics.enabled` are set.", mi.Description())
+						assert.Equal(t, "{test}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						cicdPipelineNameAttrVal, ok := dp.Attributes().Get("cicd.pipeline.name")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.pipeline.name-val", cicdPipelineNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						testSuiteNameAttrVal, ok := dp.Attributes().Get("test.suite.name")
+						assert.True(t, ok)
+						assert.Equal(t, "test.suite.name-val", testSuiteNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["cicd.pipeline.test.case.flaky.count"], "Found a duplicate in the metrics slice: cicd.pipeline.test.case.flaky.count")
+						validatedMetrics["cicd.pipeline.test.case.flaky.count"] = true
+						assert.Equal(t, pmetric.MetricTypeSum, mi.Type())
+						assert.Equal(t, 1, mi.Sum().DataPoints().Len())
+						assert.Equal(t, "The number of test cases of a test suite which passed on a retry of a workflow run after failing on a previous attempt, read from its test results when `webhook.test_results.enabled` and `webhook.metrics.enabled` are set.", mi.Description())
+						assert.Equal(t, "{test}", mi.Unit())
+						assert.True(t, mi.Sum().IsMonotonic())
+						assert.Equal(t, pmetric.AggregationTemporalityDelta, mi.Sum().AggregationTemporality())
+						dp := mi.Sum().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["cicd.pipeline.test.case.flaky.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.pipeline.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("test.suite.name")
+						assert.False(t, ok)
+					}
+				case "cicd.worker.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["cicd.worker.count"], "Found a duplicate in the metrics slice: cicd.worker.count")
+						validatedMetrics["cicd.worker.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of self-hosted runners by state and runner group.", mi.Description())
+						assert.Equal(t, "{count}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						cicdWorkerStateAttrVal, ok := dp.Attributes().Get("cicd.worker.state")
+						assert.True(t, ok)
+						assert.Equal(t, "available", cicdWorkerStateAttrVal.Str())
+						cicdWorkerGroupNameAttrVal, ok := dp.Attributes().Get("cicd.worker.group.name")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.worker.group.name-val", cicdWorkerGroupNameAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["cicd.worker.count"], "Found a duplicate in the metrics slice: cicd.worker.count")
+						validatedMetrics["cicd.worker.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of self-hosted runners by state and runner group.", mi.Description())
+						assert.Equal(t, "{count}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["cicd.worker.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("cicd.worker.state")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.worker.group.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+					}
+				case "cicd.worker.label.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["cicd.worker.label.count"], "Found a duplicate in the metrics slice: cicd.worker.label.count")
+						validatedMetrics["cicd.worker.label.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of self-hosted runners with a label by state.", mi.Description())
+						assert.Equal(t, "{count}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						cicdWorkerStateAttrVal, ok := dp.Attributes().Get("cicd.worker.state")
+						assert.True(t, ok)
+						assert.Equal(t, "available", cicdWorkerStateAttrVal.Str())
+						cicdWorkerLabelAttrVal, ok := dp.Attributes().Get("cicd.worker.label")
+						assert.True(t, ok)
+						assert.Equal(t, "cicd.worker.label-val", cicdWorkerLabelAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["cicd.worker.label.count"], "Found a duplicate in the metrics slice: cicd.worker.label.count")
+						validatedMetrics["cicd.worker.label.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of self-hosted runners with a label by state.", mi.Description())
+						assert.Equal(t, "{count}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["cicd.worker.label.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("cicd.worker.state")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cicd.worker.label")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+					}
+				case "deploy.deployment.change_failure_rate":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["deploy.deployment.change_failure_rate"], "Found a duplicate in the metrics slice: deploy.deployment.change_failure_rate")
+						validatedMetrics["deploy.deployment.change_failure_rate"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The ratio of failed deployments to all completed deployments for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						serviceNameAttrVal, ok := dp.Attributes().Get("service.name")
+						assert.True(t, ok)
+						assert.Equal(t, "service.name-val", serviceNameAttrVal.Str())
+						deploymentEnvironmentNameAttrVal, ok := dp.Attributes().Get("deployment.environment.name")
+						assert.True(t, ok)
+						assert.Equal(t, "deployment.environment.name-val", deploymentEnvironmentNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["deploy.deployment.change_failure_rate"], "Found a duplicate in the metrics slice: deploy.deployment.change_failure_rate")
+						validatedMetrics["deploy.deployment.change_failure_rate"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The ratio of failed deployments to all completed deployments for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "1", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						switch aggMap["deploy.deployment.change_failure_rate"] {
+						case "sum":
+							assert.InDelta(t, float64(4), dp.DoubleValue(), 0.01)
+						case "avg":
+							assert.InDelta(t, float64(2), dp.DoubleValue(), 0.01)
+						case "min":
+							assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						case "max":
+							assert.InDelta(t, float64(3), dp.DoubleValue(), 0.01)
+						}
+						_, ok := dp.Attributes().Get("service.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("deployment.environment.name")
+						assert.False(t, ok)
+					}
+				case "deploy.deployment.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["deploy.deployment.count"], "Found a duplicate in the metrics slice: deploy.deployment.count")
+						validatedMetrics["deploy.deployment.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of deployments by service, environment, and status over the lookback_days period.", mi.Description())
+						assert.Equal(t, "{deployment}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						serviceNameAttrVal, ok := dp.Attributes().Get("service.name")
+						assert.True(t, ok)
+						assert.Equal(t, "service.name-val", serviceNameAttrVal.Str())
+						deploymentEnvironmentNameAttrVal, ok := dp.Attributes().Get("deployment.environment.name")
+						assert.True(t, ok)
+						assert.Equal(t, "deployment.environment.name-val", deploymentEnvironmentNameAttrVal.Str())
+						deploymentStatusAttrVal, ok := dp.Attributes().Get("deployment.status")
+						assert.True(t, ok)
+						assert.Equal(t, "succeeded", deploymentStatusAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["deploy.deployment.count"], "Found a duplicate in the metrics slice: deploy.deployment.count")
+						validatedMetrics["deploy.deployment.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of deployments by service, environment, and status over the lookback_days period.", mi.Description())
+						assert.Equal(t, "{deployment}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["deploy.deployment.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("service.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("deployment.environment.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("deployment.status")
+						assert.False(t, ok)
+					}
+				case "deploy.deployment.frequency":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["deploy.deployment.frequency"], "Found a duplicate in the metrics slice: deploy.deployment.frequency")
+						validatedMetrics["deploy.deployment.frequency"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The average number of successful deployments per day for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "{deployment}/d", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						serviceNameAttrVal, ok := dp.Attributes().Get("service.name")
+						assert.True(t, ok)
+						assert.Equal(t, "service.name-val", serviceNameAttrVal.Str())
+						deploymentEnvironmentNameAttrVal, ok := dp.Attributes().Get("deployment.environment.name")
+						assert.True(t, ok)
+						assert.Equal(t, "deployment.environment.name-val", deploymentEnvironmentNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["deploy.deployment.frequency"], "Found a duplicate in the metrics slice: deploy.deployment.frequency")
+						validatedMetrics["deploy.deployment.frequency"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The average number of successful deployments per day for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "{deployment}/d", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeDouble, dp.ValueType())
+						switch aggMap["deploy.deployment.frequency"] {
+						case "sum":
+							assert.InDelta(t, float64(4), dp.DoubleValue(), 0.01)
+						case "avg":
+							assert.InDelta(t, float64(2), dp.DoubleValue(), 0.01)
+						case "min":
+							assert.InDelta(t, float64(1), dp.DoubleValue(), 0.01)
+						case "max":
+							assert.InDelta(t, float64(3), dp.DoubleValue(), 0.01)
+						}
+						_, ok := dp.Attributes().Get("service.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("deployment.environment.name")
+						assert.False(t, ok)
+					}
+				case "deploy.deployment.lead_time":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["deploy.deployment.lead_time"], "Found a duplicate in the metrics slice: deploy.deployment.lead_time")
+						validatedMetrics["deploy.deployment.lead_time"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Average time from the commit of a successful deployment to the success of the deployment for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						serviceNameAttrVal, ok := dp.Attributes().Get("service.name")
+						assert.True(t, ok)
+						assert.Equal(t, "service.name-val", serviceNameAttrVal.Str())
+						deploymentEnvironmentNameAttrVal, ok := dp.Attributes().Get("deployment.environment.name")
+						assert.True(t, ok)
+						assert.Equal(t, "deployment.environment.name-val", deploymentEnvironmentNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["deploy.deployment.lead_time"], "Found a duplicate in the metrics slice: deploy.deployment.lead_time")
+						validatedMetrics["deploy.deployment.lead_time"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Average time from the commit of a successful deployment to the success of the deployment for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["deploy.deployment.lead_time"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("service.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("deployment.environment.name")
+						assert.False(t, ok)
+					}
+				case "deploy.deployment.time_to_restore":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["deploy.deployment.time_to_restore"], "Found a duplicate in the metrics slice: deploy.deployment.time_to_restore")
+						validatedMetrics["deploy.deployment.time_to_restore"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Average time from a failed deployment to the next successful deployment for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						serviceNameAttrVal, ok := dp.Attributes().Get("service.name")
+						assert.True(t, ok)
+						assert.Equal(t, "service.name-val", serviceNameAttrVal.Str())
+						deploymentEnvironmentNameAttrVal, ok := dp.Attributes().Get("deployment.environment.name")
+						assert.True(t, ok)
+						assert.Equal(t, "deployment.environment.name-val", deploymentEnvironmentNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["deploy.deployment.time_to_restore"], "Found a duplicate in the metrics slice: deploy.deployment.time_to_restore")
+						validatedMetrics["deploy.deployment.time_to_restore"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "Average time from a failed deployment to the next successful deployment for a given service and environment over the lookback_days period.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["deploy.deployment.time_to_restore"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("service.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("deployment.environment.name")
+						assert.False(t, ok)
+					}
+				case "vcs.change.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.change.count"], "Found a duplicate in the metrics slice: vcs.change.count")
+						validatedMetrics["vcs.change.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (open, merged or closed without merging).", mi.Description())
+						assert.Equal(t, "{change}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
+						assert.True(t, ok)
+						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.change.count"], "Found a duplicate in the metrics slice: vcs.change.count")
+						validatedMetrics["vcs.change.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (open, merged or closed without merging).", mi.Description())
+						assert.Equal(t, "{change}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.change.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.change.state")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+					}
+				case "vcs.change.duration":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.change.duration"], "Found a duplicate in the metrics slice: vcs.change.duration")
+						validatedMetrics["vcs.change.duration"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in a given state (open, or until it was merged or closed).", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
+						assert.True(t, ok)
+						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.change.duration"], "Found a duplicate in the metrics slice: vcs.change.duration")
+						validatedMetrics["vcs.change.duration"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in a given state (open, or until it was merged or closed).", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.change.duration"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.change.state")
+						assert.False(t, ok)
+					}
+				case "vcs.change.review.comment.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.change.review.comment.count"], "Found a duplicate in the metrics slice: vcs.change.review.comment.count")
+						validatedMetrics["vcs.change.review.comment.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of review comments left on a change (pull request) by its reviews.", mi.Description())
+						assert.Equal(t, "{comment}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
+						assert.True(t, ok)
+						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.change.review.comment.count"], "Found a duplicate in the metrics slice: vcs.change.review.comment.count")
+						validatedMetrics["vcs.change.review.comment.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of review comments left on a change (pull request) by its reviews.", mi.Description())
+						assert.Equal(t, "{comment}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.change.review.comment.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.change.state")
+						assert.False(t, ok)
+					}
+				case "vcs.change.review.round.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.change.review.round.count"], "Found a duplicate in the metrics slice: vcs.change.review.round.count")
+						validatedMetrics["vcs.change.review.round.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of review rounds of a change (pull request), which is the number of distinct revisions (commits) of the change that were reviewed.", mi.Description())
+						assert.Equal(t, "{round}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
+						assert.True(t, ok)
+						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.change.review.round.count"], "Found a duplicate in the metrics slice: vcs.change.review.round.count")
+						validatedMetrics["vcs.change.review.round.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of review rounds of a change (pull request), which is the number of distinct revisions (commits) of the change that were reviewed.", mi.Description())
+						assert.Equal(t, "{round}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.change.review.round.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.change.state")
+						assert.False(t, ok)
+					}
+				case "vcs.change.reviewer.count":
 					if tt.name != "reaggregate_set" {
-						assert.False(t, validatedMetrics["vcs.change.count"], "Found a duplicate in the metrics slice: vcs.change.count")
-						validatedMetrics["vcs.change.count"] = true
+						assert.False(t, validatedMetrics["vcs.change.reviewer.count"], "Found a duplicate in the metrics slice: vcs.change.reviewer.count")
+						validatedMetrics["vcs.change.reviewer.count"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (either open or merged).", mi.Description())
-						assert.Equal(t, "{change}", mi.Unit())
+						assert.Equal(t, "The number of distinct reviewers, other than its author, who submitted a review of a change (pull request).", mi.Description())
+						assert.Equal(t, "{reviewer}", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
 						assert.Equal(t, ts, dp.Timestamp())
@@ -209,24 +1213,27 @@ func TestMetricsBuilder(t *testing.T) {
 						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
 						assert.True(t, ok)
 						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
-						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
-						assert.True(t, ok)
-						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
 						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
 						assert.True(t, ok)
 						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
+						assert.True(t, ok)
+						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
 					} else {
-						assert.False(t, validatedMetrics["vcs.change.count"], "Found a duplicate in the metrics slice: vcs.change.count")
-						validatedMetrics["vcs.change.count"] = true
+						assert.False(t, validatedMetrics["vcs.change.reviewer.count"], "Found a duplicate in the metrics slice: vcs.change.reviewer.count")
+						validatedMetrics["vcs.change.reviewer.count"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The number of changes (pull requests) in a repository, categorized by their state (either open or merged).", mi.Description())
-						assert.Equal(t, "{change}", mi.Unit())
+						assert.Equal(t, "The number of distinct reviewers, other than its author, who submitted a review of a change (pull request).", mi.Description())
+						assert.Equal(t, "{reviewer}", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
 						assert.Equal(t, ts, dp.Timestamp())
 						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
-						switch aggMap["vcs.change.count"] {
+						switch aggMap["vcs.change.reviewer.count"] {
 						case "sum":
 							assert.Equal(t, int64(4), dp.IntValue())
 						case "avg":
@@ -238,18 +1245,20 @@ func TestMetricsBuilder(t *testing.T) {
 						}
 						_, ok := dp.Attributes().Get("vcs.repository.url.full")
 						assert.False(t, ok)
-						_, ok = dp.Attributes().Get("vcs.change.state")
-						assert.False(t, ok)
 						_, ok = dp.Attributes().Get("vcs.repository.name")
 						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.change.state")
+						assert.False(t, ok)
 					}
-				case "vcs.change.duration":
+				case "vcs.change.time_from_approval_to_merge":
 					if tt.name != "reaggregate_set" {
-						assert.False(t, validatedMetrics["vcs.change.duration"], "Found a duplicate in the metrics slice: vcs.change.duration")
-						validatedMetrics["vcs.change.duration"] = true
+						assert.False(t, validatedMetrics["vcs.change.time_from_approval_to_merge"], "Found a duplicate in the metrics slice: vcs.change.time_from_approval_to_merge")
+						validatedMetrics["vcs.change.time_from_approval_to_merge"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in an open state.", mi.Description())
+						assert.Equal(t, "The amount of time it took a change (pull request) to go from its last approval to merged.", mi.Description())
 						assert.Equal(t, "s", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -265,21 +1274,18 @@ func TestMetricsBuilder(t *testing.T) {
 						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
 						assert.True(t, ok)
 						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
-						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
-						assert.True(t, ok)
-						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
 					} else {
-						assert.False(t, validatedMetrics["vcs.change.duration"], "Found a duplicate in the metrics slice: vcs.change.duration")
-						validatedMetrics["vcs.change.duration"] = true
+						assert.False(t, validatedMetrics["vcs.change.time_from_approval_to_merge"], "Found a duplicate in the metrics slice: vcs.change.time_from_approval_to_merge")
+						validatedMetrics["vcs.change.time_from_approval_to_merge"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The time duration a change (pull request/merge request/changelist) has been in an open state.", mi.Description())
+						assert.Equal(t, "The amount of time it took a change (pull request) to go from its last approval to merged.", mi.Description())
 						assert.Equal(t, "s", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
 						assert.Equal(t, ts, dp.Timestamp())
 						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
-						switch aggMap["vcs.change.duration"] {
+						switch aggMap["vcs.change.time_from_approval_to_merge"] {
 						case "sum":
 							assert.Equal(t, int64(4), dp.IntValue())
 						case "avg":
@@ -295,8 +1301,6 @@ func TestMetricsBuilder(t *testing.T) {
 						assert.False(t, ok)
 						_, ok = dp.Attributes().Get("vcs.ref.head.name")
 						assert.False(t, ok)
-						_, ok = dp.Attributes().Get("vcs.change.state")
-						assert.False(t, ok)
 					}
 				case "vcs.change.time_to_approval":
 					if tt.name != "reaggregate_set" {
@@ -304,7 +1308,7 @@ func TestMetricsBuilder(t *testing.T) {
 						validatedMetrics["vcs.change.time_to_approval"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The amount of time it took a change (pull request) to go from open to approved.", mi.Description())
+						assert.Equal(t, "The amount of time it took a change (pull request) to go from open to its first approval.", mi.Description())
 						assert.Equal(t, "s", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -325,7 +1329,7 @@ func TestMetricsBuilder(t *testing.T) {
 						validatedMetrics["vcs.change.time_to_approval"] = true
 						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
 						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
-						assert.Equal(t, "The amount of time it took a change (pull request) to go from open to approved.", mi.Description())
+						assert.Equal(t, "The amount of time it took a change (pull request) to go from open to its first approval.", mi.Description())
 						assert.Equal(t, "s", mi.Unit())
 						dp := mi.Gauge().DataPoints().At(0)
 						assert.Equal(t, start, dp.StartTimestamp())
@@ -348,6 +1352,61 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok = dp.Attributes().Get("vcs.ref.head.name")
 						assert.False(t, ok)
 					}
+				case "vcs.change.time_to_first_review":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.change.time_to_first_review"], "Found a duplicate in the metrics slice: vcs.change.time_to_first_review")
+						validatedMetrics["vcs.change.time_to_first_review"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The amount of time it took a change (pull request) to go from open to its first review by someone other than its author.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						vcsRefHeadNameAttrVal, ok := dp.Attributes().Get("vcs.ref.head.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.ref.head.name-val", vcsRefHeadNameAttrVal.Str())
+						vcsChangeStateAttrVal, ok := dp.Attributes().Get("vcs.change.state")
+						assert.True(t, ok)
+						assert.Equal(t, "open", vcsChangeStateAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.change.time_to_first_review"], "Found a duplicate in the metrics slice: vcs.change.time_to_first_review")
+						validatedMetrics["vcs.change.time_to_first_review"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The amount of time it took a change (pull request) to go from open to its first review by someone other than its author.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.change.time_to_first_review"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.ref.head.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.change.state")
+						assert.False(t, ok)
+					}
 				case "vcs.change.time_to_merge":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["vcs.change.time_to_merge"], "Found a duplicate in the metrics slice: vcs.change.time_to_merge")
@@ -443,6 +1502,66 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok = dp.Attributes().Get("vcs.repository.name")
 						assert.False(t, ok)
 					}
+				case "vcs.cve.age":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.cve.age"], "Found a duplicate in the metrics slice: vcs.cve.age")
+						validatedMetrics["vcs.cve.age"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The age of the oldest open Common Vulnerabilities and Exposures (CVE) alert in the repository.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						cveSeverityAttrVal, ok := dp.Attributes().Get("cve.severity")
+						assert.True(t, ok)
+						assert.Equal(t, "critical", cveSeverityAttrVal.Str())
+						cveSourceAttrVal, ok := dp.Attributes().Get("cve.source")
+						assert.True(t, ok)
+						assert.Equal(t, "dependabot", cveSourceAttrVal.Str())
+						cvePackageEcosystemAttrVal, ok := dp.Attributes().Get("cve.package.ecosystem")
+						assert.True(t, ok)
+						assert.Equal(t, "cve.package.ecosystem-val", cvePackageEcosystemAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.cve.age"], "Found a duplicate in the metrics slice: vcs.cve.age")
+						validatedMetrics["vcs.cve.age"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The age of the oldest open Common Vulnerabilities and Exposures (CVE) alert in the repository.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.cve.age"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cve.severity")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cve.source")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cve.package.ecosystem")
+						assert.False(t, ok)
+					}
 				case "vcs.cve.count":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["vcs.cve.count"], "Found a duplicate in the metrics slice: vcs.cve.count")
@@ -493,6 +1612,71 @@ func TestMetricsBuilder(t *testing.T) {
 						_, ok = dp.Attributes().Get("cve.severity")
 						assert.False(t, ok)
 					}
+				case "vcs.cve.time_to_remediate":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.cve.time_to_remediate"], "Found a duplicate in the metrics slice: vcs.cve.time_to_remediate")
+						validatedMetrics["vcs.cve.time_to_remediate"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The average time from the creation to the resolution of the Common Vulnerabilities and Exposures (CVE) alerts of the repository resolved over the CVE lookback window.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						cveSeverityAttrVal, ok := dp.Attributes().Get("cve.severity")
+						assert.True(t, ok)
+						assert.Equal(t, "critical", cveSeverityAttrVal.Str())
+						cveSourceAttrVal, ok := dp.Attributes().Get("cve.source")
+						assert.True(t, ok)
+						assert.Equal(t, "dependabot", cveSourceAttrVal.Str())
+						cvePackageEcosystemAttrVal, ok := dp.Attributes().Get("cve.package.ecosystem")
+						assert.True(t, ok)
+						assert.Equal(t, "cve.package.ecosystem-val", cvePackageEcosystemAttrVal.Str())
+						cveResolutionAttrVal, ok := dp.Attributes().Get("cve.resolution")
+						assert.True(t, ok)
+						assert.Equal(t, "fixed", cveResolutionAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.cve.time_to_remediate"], "Found a duplicate in the metrics slice: vcs.cve.time_to_remediate")
+						validatedMetrics["vcs.cve.time_to_remediate"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The average time from the creation to the resolution of the Common Vulnerabilities and Exposures (CVE) alerts of the repository resolved over the CVE lookback window.", mi.Description())
+						assert.Equal(t, "s", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.cve.time_to_remediate"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cve.severity")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cve.source")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cve.package.ecosystem")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("cve.resolution")
+						assert.False(t, ok)
+					}
 				case "vcs.ref.count":
 					if tt.name != "reaggregate_set" {
 						assert.False(t, validatedMetrics["vcs.ref.count"], "Found a duplicate in the metrics slice: vcs.ref.count")
@@ -740,6 +1924,111 @@ func TestMetricsBuilder(t *testing.T) {
 					assert.Equal(t, ts, dp.Timestamp())
 					assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
 					assert.Equal(t, int64(1), dp.IntValue())
+				case "vcs.secret.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.secret.count"], "Found a duplicate in the metrics slice: vcs.secret.count")
+						validatedMetrics["vcs.secret.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of open secret scanning alerts in the repository.", mi.Description())
+						assert.Equal(t, "{alert}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						secretTypeAttrVal, ok := dp.Attributes().Get("secret.type")
+						assert.True(t, ok)
+						assert.Equal(t, "secret.type-val", secretTypeAttrVal.Str())
+						secretValidityAttrVal, ok := dp.Attributes().Get("secret.validity")
+						assert.True(t, ok)
+						assert.Equal(t, "active", secretValidityAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.secret.count"], "Found a duplicate in the metrics slice: vcs.secret.count")
+						validatedMetrics["vcs.secret.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of open secret scanning alerts in the repository.", mi.Description())
+						assert.Equal(t, "{alert}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.secret.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("secret.type")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("secret.validity")
+						assert.False(t, ok)
+					}
+				case "vcs.secret.push_protection_bypass.count":
+					if tt.name != "reaggregate_set" {
+						assert.False(t, validatedMetrics["vcs.secret.push_protection_bypass.count"], "Found a duplicate in the metrics slice: vcs.secret.push_protection_bypass.count")
+						validatedMetrics["vcs.secret.push_protection_bypass.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of secret scanning alerts in the repository whose secret was pushed by bypassing push protection.", mi.Description())
+						assert.Equal(t, "{alert}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						assert.Equal(t, int64(1), dp.IntValue())
+						vcsRepositoryURLFullAttrVal, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.url.full-val", vcsRepositoryURLFullAttrVal.Str())
+						vcsRepositoryNameAttrVal, ok := dp.Attributes().Get("vcs.repository.name")
+						assert.True(t, ok)
+						assert.Equal(t, "vcs.repository.name-val", vcsRepositoryNameAttrVal.Str())
+						secretTypeAttrVal, ok := dp.Attributes().Get("secret.type")
+						assert.True(t, ok)
+						assert.Equal(t, "secret.type-val", secretTypeAttrVal.Str())
+					} else {
+						assert.False(t, validatedMetrics["vcs.secret.push_protection_bypass.count"], "Found a duplicate in the metrics slice: vcs.secret.push_protection_bypass.count")
+						validatedMetrics["vcs.secret.push_protection_bypass.count"] = true
+						assert.Equal(t, pmetric.MetricTypeGauge, mi.Type())
+						assert.Equal(t, 1, mi.Gauge().DataPoints().Len())
+						assert.Equal(t, "The number of secret scanning alerts in the repository whose secret was pushed by bypassing push protection.", mi.Description())
+						assert.Equal(t, "{alert}", mi.Unit())
+						dp := mi.Gauge().DataPoints().At(0)
+						assert.Equal(t, start, dp.StartTimestamp())
+						assert.Equal(t, ts, dp.Timestamp())
+						assert.Equal(t, pmetric.NumberDataPointValueTypeInt, dp.ValueType())
+						switch aggMap["vcs.secret.push_protection_bypass.count"] {
+						case "sum":
+							assert.Equal(t, int64(4), dp.IntValue())
+						case "avg":
+							assert.Equal(t, int64(2), dp.IntValue())
+						case "min":
+							assert.Equal(t, int64(1), dp.IntValue())
+						case "max":
+							assert.Equal(t, int64(3), dp.IntValue())
+						}
+						_, ok := dp.Attributes().Get("vcs.repository.url.full")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("vcs.repository.name")
+						assert.False(t, ok)
+						_, ok = dp.Attributes().Get("secret.type")
+						assert.False(t, ok)
+					}
 				}
 			}
 		})
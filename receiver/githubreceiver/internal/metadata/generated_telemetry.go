@@ -0,0 +1,133 @@
+// Code generated by mdatagen. DO NOT EDIT.
+
+package metadata
+
+import (
+	"errors"
+	"sync"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+func Meter(settings component.TelemetrySettings) metric.Meter {
+	return settings.MeterProvider.Meter("github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver")
+}
+
+func Tracer(settings component.TelemetrySettings) trace.Tracer {
+	return settings.TracerProvider.Tracer("github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver")
+}
+
+// TelemetryBuilder provides an interface for components to report telemetry
+// as defined in metadata and user config.
+type TelemetryBuilder struct {
+	meter                                   metric.Meter
+	mu                                      sync.Mutex
+	registrations                           []metric.Registration
+	ReceiverGithubScraperRateLimitRemaining metric.Int64Gauge
+	ReceiverGithubScraperThrottledTime      metric.Float64Counter
+	ReceiverScraperDuration                 metric.Float64Histogram
+	ReceiverScraperRepositories             metric.Int64Gauge
+	ReceiverScraperRequestDuration          metric.Float64Histogram
+	ReceiverScraperRequestErrors            metric.Int64Counter
+	ReceiverScraperRequests                 metric.Int64Counter
+	ReceiverWebhookQueueCapacity            metric.Int64Gauge
+	ReceiverWebhookQueueDropped             metric.Int64Counter
+	ReceiverWebhookQueueSize                metric.Int64UpDownCounter
+}
+
+// TelemetryBuilderOption applies changes to default builder.
+type TelemetryBuilderOption interface {
+	apply(*TelemetryBuilder)
+}
+
+type telemetryBuilderOptionFunc func(mb *TelemetryBuilder)
+
+func (tbof telemetryBuilderOptionFunc) apply(mb *TelemetryBuilder) {
+	tbof(mb)
+}
+
+// Shutdown unregister all registered callbacks for async instruments.
+func (builder *TelemetryBuilder) Shutdown() {
+	builder.mu.Lock()
+	defer builder.mu.Unlock()
+	for _, reg := range builder.registrations {
+		reg.Unregister()
+	}
+}
+
+// NewTelemetryBuilder provides a struct with methods to update all internal telemetry
+// for a component
+func NewTelemetryBuilder(settings component.TelemetrySettings, options ...TelemetryBuilderOption) (*TelemetryBuilder, error) {
+	builder := TelemetryBuilder{}
+	for _, op := range options {
+		op.apply(&builder)
+	}
+	builder.meter = Meter(settings)
+	var err, errs error
+	builder.ReceiverGithubScraperRateLimitRemaining, err = builder.meter.Int64Gauge(
+		"otelcol_receiver_github_scraper_rate_limit_remaining",
+		metric.WithDescription("Remaining GitHub API rate limit budget of the credentials of the GitHub scraper, in requests for the REST API and in points for the GraphQL API. [Development]"),
+		metric.WithUnit("{request}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverGithubScraperThrottledTime, err = builder.meter.Float64Counter(
+		"otelcol_receiver_github_scraper_throttled_time",
+		metric.WithDescription("Time the requests of the GitHub scraper waited because of GitHub API rate limits. [Development]"),
+		metric.WithUnit("s"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverScraperDuration, err = builder.meter.Float64Histogram(
+		"otelcol_receiver_scraper_duration",
+		metric.WithDescription("Duration of the scrapes of the scraper, labeled by `scraper`. [Development]"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries([]float64{1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600}...),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverScraperRepositories, err = builder.meter.Int64Gauge(
+		"otelcol_receiver_scraper_repositories",
+		metric.WithDescription("Number of repositories processed by the last scrape of the scraper, labeled by `scraper`. [Development]"),
+		metric.WithUnit("{repository}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverScraperRequestDuration, err = builder.meter.Float64Histogram(
+		"otelcol_receiver_scraper_request_duration",
+		metric.WithDescription("Duration of the API requests sent by the scraper, labeled by `scraper`, `endpoint` and `http.response.status_code`. [Development]"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries([]float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}...),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverScraperRequestErrors, err = builder.meter.Int64Counter(
+		"otelcol_receiver_scraper_request_errors",
+		metric.WithDescription("Number of API requests sent by the scraper which failed or were answered with an error status code, labeled by `scraper`, `endpoint` and `error.type`. [Development]"),
+		metric.WithUnit("{request}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverScraperRequests, err = builder.meter.Int64Counter(
+		"otelcol_receiver_scraper_requests",
+		metric.WithDescription("Number of API requests sent by the scraper, labeled by `scraper`, `endpoint` and `http.response.status_code`. [Development]"),
+		metric.WithUnit("{request}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverWebhookQueueCapacity, err = builder.meter.Int64Gauge(
+		"otelcol_receiver_webhook_queue_capacity",
+		metric.WithDescription("Maximum number of webhook deliveries the asynchronous processing queue can hold. [Development]"),
+		metric.WithUnit("{delivery}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverWebhookQueueDropped, err = builder.meter.Int64Counter(
+		"otelcol_receiver_webhook_queue_dropped",
+		metric.WithDescription("Number of webhook deliveries dropped or rejected because the asynchronous processing queue was full. [Development]"),
+		metric.WithUnit("{delivery}"),
+	)
+	errs = errors.Join(errs, err)
+	builder.ReceiverWebhookQueueSize, err = builder.meter.Int64UpDownCounter(
+		"otelcol_receiver_webhook_queue_size",
+		metric.WithDescription("Number of webhook deliveries waiting in the asynchronous processing queue. [Development]"),
+		metric.WithUnit("{delivery}"),
+	)
+	errs = errors.Join(errs, err)
+	return &builder, errs
+}
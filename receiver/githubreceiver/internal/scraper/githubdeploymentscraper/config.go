@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubdeploymentscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubdeploymentscraper"
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+var (
+	errGitHubOrg    = errors.New("github_org must be set")
+	errLookbackDays = errors.New("lookback_days must be greater than 0")
+)
+
+// Config relating to GitHub Deployments Scraper.
+type Config struct {
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	confighttp.ClientConfig       `mapstructure:",squash"`
+	internal.ScraperConfig
+	// GitHubOrg is the name of the GitHub organization whose deployments are
+	// scraped. Only organizations are supported, not user accounts.
+	GitHubOrg string `mapstructure:"github_org"`
+	// Repositories are the names of the repositories of the organization
+	// whose deployments are scraped. All the repositories of the
+	// organization which are not archived are scraped when empty.
+	Repositories []string `mapstructure:"repositories"`
+	// Environments are the names of the deployment environments scraped. All
+	// the environments are scraped when empty.
+	Environments []string `mapstructure:"environments"`
+	// LookbackDays is how many days of deployment history the metrics are
+	// computed over. Default is 30.
+	LookbackDays int `mapstructure:"lookback_days"`
+	// Server holds the URLs of the GitHub server, set from the receiver
+	// configuration. An endpoint set in the ClientConfig takes precedence.
+	Server internal.ServerConfig `mapstructure:"-"`
+}
+
+// Validate the configuration of the deployment history scraped.
+func (cfg *Config) Validate() error {
+	if cfg.GitHubOrg == "" {
+		return errGitHubOrg
+	}
+
+	if cfg.LookbackDays <= 0 {
+		return errLookbackDays
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubdeploymentscraper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+// TestConfig ensures a config created with the factory is the same as one created manually with
+// the exported Config struct.
+func TestConfig(t *testing.T) {
+	factory := Factory{}
+	defaultConfig := factory.CreateDefaultConfig()
+
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Timeout = 15 * time.Second
+
+	expectedConfig := &Config{
+		MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+		ClientConfig:         clientConfig,
+		LookbackDays:         30,
+	}
+
+	assert.Equal(t, expectedConfig, defaultConfig)
+}
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		githubOrg    string
+		lookbackDays int
+		expectedErr  error
+	}{
+		{
+			desc:         "valid",
+			githubOrg:    "liatrio",
+			lookbackDays: 7,
+		},
+		{
+			desc:         "github_org is empty",
+			lookbackDays: 7,
+			expectedErr:  errGitHubOrg,
+		},
+		{
+			desc:         "lookback_days is zero",
+			githubOrg:    "liatrio",
+			lookbackDays: 0,
+			expectedErr:  errLookbackDays,
+		},
+		{
+			desc:         "lookback_days is negative",
+			githubOrg:    "liatrio",
+			lookbackDays: -1,
+			expectedErr:  errLookbackDays,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := &Config{GitHubOrg: tc.githubOrg, LookbackDays: tc.lookbackDays}
+			assert.ErrorIs(t, cfg.Validate(), tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubdeploymentscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubdeploymentscraper"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+var errClientNotInitErr = errors.New("http client not initialized")
+
+type githubDeploymentScraper struct {
+	client    *http.Client
+	cfg       *Config
+	settings  component.TelemetrySettings
+	logger    *zap.Logger
+	mb        *metadata.MetricsBuilder
+	rb        *metadata.ResourceBuilder
+	telemetry *metadata.TelemetryBuilder
+	// scraperTelemetry records the requests and the scrapes of the scraper
+	scraperTelemetry *internal.ScraperTelemetry
+}
+
+// deploymentOutcome is a completed deployment.
+type deploymentOutcome struct {
+	status metadata.AttributeDeploymentStatus
+	// finishedAt is when the deployment succeeded or failed.
+	finishedAt time.Time
+	// leadTime is the time from the commit deployed to the success of the
+	// deployment. It is zero for failed deployments, and when the commit
+	// could not be retrieved.
+	leadTime time.Duration
+}
+
+// deploymentSummary holds the DORA metrics of the deployments of a service to
+// an environment.
+type deploymentSummary struct {
+	succeeded int64
+	failed    int64
+	// leadTime is the average lead time of the leadTimes deployments whose
+	// lead time is known.
+	leadTime  time.Duration
+	leadTimes int
+	// timeToRestore is the average time from a failed deployment to the next
+	// successful one, over the restores times a failure was restored.
+	timeToRestore time.Duration
+	restores      int
+}
+
+func (gds *githubDeploymentScraper) start(ctx context.Context, host component.Host) (err error) {
+	gds.logger.Sugar().Info("starting the GitHub deployment scraper")
+
+	// Initialize extensions as nil, which is safe to pass to ToClient when host is nil
+	// The OpenTelemetry client will handle the nil extensions case appropriately
+	var extensions map[component.ID]component.Component
+	if host != nil {
+		extensions = host.GetExtensions()
+	}
+
+	gds.client, err = gds.cfg.ToClient(ctx, extensions, gds.settings)
+	if err != nil {
+		return
+	}
+
+	gds.telemetry, err = metadata.NewTelemetryBuilder(gds.settings)
+	if err != nil {
+		return
+	}
+
+	gds.scraperTelemetry = internal.NewScraperTelemetry(gds.telemetry, TypeStr)
+	gds.client.Transport = gds.scraperTelemetry.Transport(gds.client.Transport)
+	return
+}
+
+func (gds *githubDeploymentScraper) shutdown(context.Context) error {
+	if gds.telemetry != nil {
+		gds.telemetry.Shutdown()
+	}
+	return nil
+}
+
+func newGitHubDeploymentScraper(
+	settings receiver.Settings,
+	cfg *Config,
+) *githubDeploymentScraper {
+	return &githubDeploymentScraper{
+		cfg:      cfg,
+		settings: settings.TelemetrySettings,
+		logger:   settings.Logger,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		rb:       metadata.NewResourceBuilder(cfg.ResourceAttributes),
+	}
+}
+
+// scrape and return the DORA metrics of the deployments of the repositories
+// of the organization over the lookback window
+func (gds *githubDeploymentScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if gds.client == nil {
+		return pmetric.NewMetrics(), errClientNotInitErr
+	}
+
+	start := time.Now()
+	now := pcommon.NewTimestampFromTime(start)
+	defer gds.scraperTelemetry.RecordScrape(ctx, start)
+
+	client, err := gds.createClient()
+	if err != nil {
+		gds.logger.Sugar().Errorf("unable to create client", zap.Error(err))
+		return gds.mb.Emit(), err
+	}
+
+	repos, err := gds.getRepositories(ctx, client)
+	if err != nil {
+		gds.logger.Sugar().Errorf("error getting repositories", zap.Error(err))
+		return gds.mb.Emit(), err
+	}
+
+	gds.scraperTelemetry.RecordRepositories(ctx, len(repos))
+
+	since := start.AddDate(0, 0, -gds.cfg.LookbackDays)
+	for _, repo := range repos {
+		outcomes, err := gds.getOutcomes(ctx, client, repo.GetName(), since)
+		if err != nil {
+			gds.logger.Sugar().Errorf("error getting deployments", zap.String("repo", repo.GetName()), zap.Error(err))
+			continue
+		}
+
+		svc := serviceName(repo)
+		for env, envOutcomes := range outcomes {
+			gds.recordDeployments(now, svc, env, summarizeDeployments(envOutcomes))
+		}
+	}
+
+	gds.rb.SetVcsVendorName("github")
+	gds.rb.SetOrganizationName(gds.cfg.GitHubOrg)
+
+	res := gds.rb.Emit()
+	return gds.mb.Emit(metadata.WithResource(res)), nil
+}
+
+// getOutcomes returns the completed deployments of a repository created
+// since the given time, by environment. The commits deployed are retrieved
+// once per SHA to compute the lead time of the successful deployments.
+func (gds *githubDeploymentScraper) getOutcomes(
+	ctx context.Context,
+	client *github.Client,
+	repo string,
+	since time.Time,
+) (map[string][]deploymentOutcome, error) {
+	environments := gds.cfg.Environments
+	if len(environments) == 0 {
+		environments = []string{""}
+	}
+
+	outcomes := map[string][]deploymentOutcome{}
+	commitTimes := map[string]time.Time{}
+	for _, env := range environments {
+		deployments, err := gds.getDeployments(ctx, client, repo, env, since)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deployment := range deployments {
+			statuses, err := gds.getDeploymentStatuses(ctx, client, repo, deployment.GetID())
+			if err != nil {
+				return nil, err
+			}
+
+			status, finishedAt, ok := deploymentResult(statuses)
+			if !ok {
+				continue
+			}
+
+			outcome := deploymentOutcome{status: status, finishedAt: finishedAt}
+			if status == metadata.AttributeDeploymentStatusSucceeded {
+				sha := deployment.GetSHA()
+				committedAt, seen := commitTimes[sha]
+				if !seen {
+					committedAt, err = gds.getCommitTime(ctx, client, repo, sha)
+					if err != nil {
+						gds.logger.Sugar().Debugf("error getting deployed commit", zap.String("repo", repo), zap.String("sha", sha), zap.Error(err))
+					}
+					commitTimes[sha] = committedAt
+				}
+
+				if !committedAt.IsZero() && committedAt.Before(finishedAt) {
+					outcome.leadTime = finishedAt.Sub(committedAt)
+				}
+			}
+
+			outcomes[deployment.GetEnvironment()] = append(outcomes[deployment.GetEnvironment()], outcome)
+		}
+	}
+
+	return outcomes, nil
+}
+
+// recordDeployments records the DORA metrics of the deployments of a service
+// to an environment.
+func (gds *githubDeploymentScraper) recordDeployments(now pcommon.Timestamp, svc, env string, summary deploymentSummary) {
+	gds.mb.RecordDeployDeploymentCountDataPoint(now, summary.succeeded, svc, env, metadata.AttributeDeploymentStatusSucceeded)
+	gds.mb.RecordDeployDeploymentCountDataPoint(now, summary.failed, svc, env, metadata.AttributeDeploymentStatusFailed)
+
+	frequency := float64(summary.succeeded) / float64(gds.cfg.LookbackDays)
+	gds.mb.RecordDeployDeploymentFrequencyDataPoint(now, frequency, svc, env)
+
+	if total := summary.succeeded + summary.failed; total > 0 {
+		gds.mb.RecordDeployDeploymentChangeFailureRateDataPoint(now, float64(summary.failed)/float64(total), svc, env)
+	}
+
+	if summary.leadTimes > 0 {
+		gds.mb.RecordDeployDeploymentLeadTimeDataPoint(now, int64(summary.leadTime.Seconds()), svc, env)
+	}
+
+	if summary.restores > 0 {
+		gds.mb.RecordDeployDeploymentTimeToRestoreDataPoint(now, int64(summary.timeToRestore.Seconds()), svc, env)
+	}
+}
+
+// deploymentResult returns whether a deployment succeeded or failed, and
+// when, from its statuses. A deployment succeeded once it has a success
+// status, even when it is inactive since a later deployment superseded it,
+// and failed once it has a failure or error status. Deployments which are
+// still pending or in progress are not completed.
+func deploymentResult(statuses []*github.DeploymentStatus) (metadata.AttributeDeploymentStatus, time.Time, bool) {
+	var succeededAt, failedAt time.Time
+	for _, status := range statuses {
+		createdAt := status.GetCreatedAt().Time
+		switch strings.ToLower(status.GetState()) {
+		case "success":
+			if succeededAt.IsZero() || createdAt.Before(succeededAt) {
+				succeededAt = createdAt
+			}
+		case "failure", "error":
+			if failedAt.IsZero() || createdAt.Before(failedAt) {
+				failedAt = createdAt
+			}
+		}
+	}
+
+	switch {
+	case !succeededAt.IsZero():
+		return metadata.AttributeDeploymentStatusSucceeded, succeededAt, true
+	case !failedAt.IsZero():
+		return metadata.AttributeDeploymentStatusFailed, failedAt, true
+	default:
+		return 0, time.Time{}, false
+	}
+}
+
+// summarizeDeployments returns the DORA metrics of completed deployments. A
+// failure is restored by the first successful deployment which follows it,
+// and the failed deployments in between do not restart the time to restore.
+func summarizeDeployments(outcomes []deploymentOutcome) deploymentSummary {
+	sorted := make([]deploymentOutcome, len(outcomes))
+	copy(sorted, outcomes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].finishedAt.Before(sorted[j].finishedAt)
+	})
+
+	var summary deploymentSummary
+	var leadTime, timeToRestore time.Duration
+	var failingSince time.Time
+	for _, outcome := range sorted {
+		switch outcome.status {
+		case metadata.AttributeDeploymentStatusFailed:
+			summary.failed++
+			if failingSince.IsZero() {
+				failingSince = outcome.finishedAt
+			}
+		case metadata.AttributeDeploymentStatusSucceeded:
+			summary.succeeded++
+			if outcome.leadTime > 0 {
+				leadTime += outcome.leadTime
+				summary.leadTimes++
+			}
+			if !failingSince.IsZero() {
+				timeToRestore += outcome.finishedAt.Sub(failingSince)
+				summary.restores++
+				failingSince = time.Time{}
+			}
+		}
+	}
+
+	if summary.leadTimes > 0 {
+		summary.leadTime = leadTime / time.Duration(summary.leadTimes)
+	}
+
+	if summary.restores > 0 {
+		summary.timeToRestore = timeToRestore / time.Duration(summary.restores)
+	}
+
+	return summary
+}
@@ -0,0 +1,343 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubdeploymentscraper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+func TestNewGitHubDeploymentScraper(t *testing.T) {
+	factory := Factory{}
+	defaultConfig := factory.CreateDefaultConfig()
+
+	s := newGitHubDeploymentScraper(receivertest.NewNopSettings(metadata.Type), defaultConfig.(*Config))
+
+	assert.NotNil(t, s)
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func newDeployment(id int64, env, sha string, createdAt time.Time) *github.Deployment {
+	return &github.Deployment{
+		ID:          github.Ptr(id),
+		Environment: github.Ptr(env),
+		SHA:         github.Ptr(sha),
+		CreatedAt:   &github.Timestamp{Time: createdAt},
+	}
+}
+
+func newStatus(state string, createdAt time.Time) *github.DeploymentStatus {
+	return &github.DeploymentStatus{
+		State:     github.Ptr(state),
+		CreatedAt: &github.Timestamp{Time: createdAt},
+	}
+}
+
+func deploymentTestServer(t *testing.T, base time.Time) *http.ServeMux {
+	var mux http.ServeMux
+
+	mux.HandleFunc("/api/v3/orgs/liatrio/repos", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, []*github.Repository{
+			{
+				Name:             github.Ptr("otel-testing"),
+				CustomProperties: map[string]any{"service_name": "Otel_Service"},
+			},
+			{Name: github.Ptr("broken")},
+			{Name: github.Ptr("archived"), Archived: github.Ptr(true)},
+		})
+	})
+
+	// the deployments are listed from the most recent, and the last one is
+	// older than the lookback window
+	deployments := []*github.Deployment{
+		newDeployment(6, "staging", "c3", base.Add(3*time.Hour+30*time.Minute)),
+		newDeployment(5, "production", "c4", base.Add(3*time.Hour+20*time.Minute)),
+		newDeployment(4, "production", "c3", base.Add(3*time.Hour)),
+		newDeployment(3, "production", "c2", base.Add(2*time.Hour)),
+		newDeployment(2, "production", "c1", base.Add(time.Hour)),
+		newDeployment(1, "production", "c0", base.AddDate(0, 0, -60)),
+	}
+	mux.HandleFunc("/api/v3/repos/liatrio/otel-testing/deployments", func(w http.ResponseWriter, r *http.Request) {
+		env := r.URL.Query().Get("environment")
+		var list []*github.Deployment
+		for _, d := range deployments {
+			if env == "" || d.GetEnvironment() == env {
+				list = append(list, d)
+			}
+		}
+
+		// the deployments are split across two pages
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2&environment=`+env+`>; rel="next"`)
+			writeJSON(t, w, list[:2])
+			return
+		}
+		writeJSON(t, w, list[2:])
+	})
+
+	statuses := map[string][]*github.DeploymentStatus{
+		"6": {newStatus("success", base.Add(3*time.Hour+40*time.Minute))},
+		// still in progress
+		"5": {newStatus("in_progress", base.Add(3*time.Hour+25*time.Minute))},
+		"4": {newStatus("success", base.Add(3*time.Hour+10*time.Minute))},
+		"3": {newStatus("failure", base.Add(2*time.Hour+5*time.Minute))},
+		// superseded by a later deployment
+		"2": {
+			newStatus("inactive", base.Add(3*time.Hour+10*time.Minute)),
+			newStatus("success", base.Add(time.Hour+10*time.Minute)),
+		},
+	}
+	mux.HandleFunc("/api/v3/repos/liatrio/otel-testing/deployments/{id}/statuses", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, statuses[r.PathValue("id")])
+	})
+
+	commits := map[string]time.Time{
+		"c1": base,
+		"c2": base.Add(time.Hour + 30*time.Minute),
+		"c3": base.Add(2*time.Hour + 30*time.Minute),
+	}
+	mux.HandleFunc("/api/v3/repos/liatrio/otel-testing/git/commits/{sha}", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, &github.Commit{
+			SHA:       github.Ptr(r.PathValue("sha")),
+			Committer: &github.CommitAuthor{Date: &github.Timestamp{Time: commits[r.PathValue("sha")]}},
+		})
+	})
+
+	mux.HandleFunc("/api/v3/repos/liatrio/broken/deployments", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	return &mux
+}
+
+func TestScrape(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		repositories []string
+		environments []string
+		testFile     string
+	}{
+		{
+			// repositories whose deployments fail to be retrieved are skipped
+			desc:     "all environments",
+			testFile: "expected_happy_path.yaml",
+		},
+		{
+			desc:         "environments",
+			repositories: []string{"otel-testing"},
+			environments: []string{"production"},
+			testFile:     "expected_environments.yaml",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			base := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+			server := httptest.NewServer(deploymentTestServer(t, base))
+			defer server.Close()
+
+			cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(), LookbackDays: 30}
+
+			gds := newGitHubDeploymentScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+			gds.cfg.GitHubOrg = "liatrio"
+			gds.cfg.Repositories = tc.repositories
+			gds.cfg.Environments = tc.environments
+			gds.cfg.Endpoint = server.URL
+
+			err := gds.start(ctx, componenttest.NewNopHost())
+			require.NoError(t, err)
+
+			actualMetrics, err := gds.scrape(ctx)
+			require.NoError(t, err)
+
+			expectedFile := filepath.Join("testdata", "scraper", tc.testFile)
+
+			// golden.WriteMetrics(t, expectedFile, actualMetrics)
+
+			expectedMetrics, err := golden.ReadMetrics(expectedFile)
+			require.NoError(t, err)
+			require.NoError(t, pmetrictest.CompareMetrics(
+				expectedMetrics,
+				actualMetrics,
+				pmetrictest.IgnoreMetricDataPointsOrder(),
+				pmetrictest.IgnoreTimestamp(),
+				pmetrictest.IgnoreStartTimestamp(),
+			))
+		})
+	}
+}
+
+func TestScrapeRepositoriesError(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(), LookbackDays: 30}
+
+	gds := newGitHubDeploymentScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	gds.cfg.GitHubOrg = "liatrio"
+	gds.cfg.Endpoint = server.URL
+
+	require.NoError(t, gds.start(ctx, componenttest.NewNopHost()))
+
+	_, err := gds.scrape(ctx)
+	require.Error(t, err)
+}
+
+func TestScrapeClientNotInitialized(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig()}
+	gds := newGitHubDeploymentScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+
+	_, err := gds.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInitErr)
+}
+
+func TestDeploymentResult(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+
+	testCases := []struct {
+		desc           string
+		statuses       []*github.DeploymentStatus
+		expectedStatus metadata.AttributeDeploymentStatus
+		expectedAt     time.Time
+		expectedOK     bool
+	}{
+		{
+			desc:           "success",
+			statuses:       []*github.DeploymentStatus{newStatus("success", base), newStatus("in_progress", base.Add(-time.Minute))},
+			expectedStatus: metadata.AttributeDeploymentStatusSucceeded,
+			expectedAt:     base,
+			expectedOK:     true,
+		},
+		{
+			desc:           "superseded",
+			statuses:       []*github.DeploymentStatus{newStatus("inactive", base.Add(time.Hour)), newStatus("success", base)},
+			expectedStatus: metadata.AttributeDeploymentStatusSucceeded,
+			expectedAt:     base,
+			expectedOK:     true,
+		},
+		{
+			desc:           "failure",
+			statuses:       []*github.DeploymentStatus{newStatus("failure", base)},
+			expectedStatus: metadata.AttributeDeploymentStatusFailed,
+			expectedAt:     base,
+			expectedOK:     true,
+		},
+		{
+			desc:           "error",
+			statuses:       []*github.DeploymentStatus{newStatus("ERROR", base)},
+			expectedStatus: metadata.AttributeDeploymentStatusFailed,
+			expectedAt:     base,
+			expectedOK:     true,
+		},
+		{
+			desc:           "succeeded after an error",
+			statuses:       []*github.DeploymentStatus{newStatus("success", base.Add(time.Hour)), newStatus("error", base)},
+			expectedStatus: metadata.AttributeDeploymentStatusSucceeded,
+			expectedAt:     base.Add(time.Hour),
+			expectedOK:     true,
+		},
+		{
+			desc:     "in progress",
+			statuses: []*github.DeploymentStatus{newStatus("in_progress", base), newStatus("queued", base.Add(-time.Minute))},
+		},
+		{
+			desc: "no statuses",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			status, at, ok := deploymentResult(tc.statuses)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.True(t, tc.expectedAt.Equal(at))
+		})
+	}
+}
+
+func TestSummarizeDeployments(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	succeeded := func(at time.Duration, leadTime time.Duration) deploymentOutcome {
+		return deploymentOutcome{status: metadata.AttributeDeploymentStatusSucceeded, finishedAt: base.Add(at), leadTime: leadTime}
+	}
+	failed := func(at time.Duration) deploymentOutcome {
+		return deploymentOutcome{status: metadata.AttributeDeploymentStatusFailed, finishedAt: base.Add(at)}
+	}
+
+	testCases := []struct {
+		desc     string
+		outcomes []deploymentOutcome
+		expected deploymentSummary
+	}{
+		{
+			desc: "no deployments",
+		},
+		{
+			desc:     "successful deployments",
+			outcomes: []deploymentOutcome{succeeded(time.Hour, time.Hour), succeeded(2*time.Hour, 0), succeeded(3*time.Hour, 3*time.Hour)},
+			expected: deploymentSummary{succeeded: 3, leadTime: 2 * time.Hour, leadTimes: 2},
+		},
+		{
+			// the outcomes are not ordered, and the second failure does not
+			// restart the time to restore
+			desc: "failures restored",
+			outcomes: []deploymentOutcome{
+				succeeded(5*time.Hour, time.Hour),
+				failed(time.Hour),
+				failed(2 * time.Hour),
+				succeeded(3*time.Hour, time.Hour),
+				failed(4 * time.Hour),
+			},
+			expected: deploymentSummary{
+				succeeded:     2,
+				failed:        3,
+				leadTime:      time.Hour,
+				leadTimes:     2,
+				timeToRestore: 90 * time.Minute,
+				restores:      2,
+			},
+		},
+		{
+			desc:     "failure not restored",
+			outcomes: []deploymentOutcome{succeeded(time.Hour, 0), failed(2 * time.Hour)},
+			expected: deploymentSummary{succeeded: 1, failed: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, summarizeDeployments(tc.outcomes))
+		})
+	}
+}
+
+func TestServiceName(t *testing.T) {
+	assert.Equal(t, "otel-testing", serviceName(&github.Repository{Name: github.Ptr("Otel_Testing")}))
+	assert.Equal(t, "my-service", serviceName(&github.Repository{
+		Name:             github.Ptr("otel-testing"),
+		CustomProperties: map[string]any{"service_name": "My_Service"},
+	}))
+}
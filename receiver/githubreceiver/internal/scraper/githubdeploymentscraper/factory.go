@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubdeploymentscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubdeploymentscraper"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+// This file implements factory for the GitHub Deployments Scraper as part of
+// the GitHub Receiver
+
+const (
+	TypeStr             = "deployments"
+	defaultHTTPTimeout  = 15 * time.Second
+	defaultLookbackDays = 30
+)
+
+type Factory struct{}
+
+func (f *Factory) CreateDefaultConfig() internal.Config {
+	clientConfig := confighttp.NewDefaultClientConfig()
+	clientConfig.Timeout = defaultHTTPTimeout
+	return &Config{
+		MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+		ClientConfig:         clientConfig,
+		LookbackDays:         defaultLookbackDays,
+	}
+}
+
+func (f *Factory) CreateMetricsScraper(
+	ctx context.Context,
+	params receiver.Settings,
+	cfg internal.Config,
+) (scraper.Metrics, error) {
+	conf := cfg.(*Config)
+	s := newGitHubDeploymentScraper(params, conf)
+
+	return scraper.NewMetrics(
+		s.scrape,
+		scraper.WithStart(s.start),
+		scraper.WithShutdown(s.shutdown),
+	)
+}
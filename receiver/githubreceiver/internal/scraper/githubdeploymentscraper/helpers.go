@@ -0,0 +1,160 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubdeploymentscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubdeploymentscraper"
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+)
+
+const defaultPerPage = 100
+
+func (gds *githubDeploymentScraper) createClient() (*github.Client, error) {
+	if gds.cfg.Endpoint != "" {
+		// The rest client needs the endpoint to be the root of the server
+		ru := gds.cfg.Endpoint
+		return github.NewClient(github.WithHTTPClient(gds.client), github.WithEnterpriseURLs(ru, ru))
+	}
+
+	opts := []github.ClientOptionsFunc{github.WithHTTPClient(gds.client)}
+	if gds.cfg.Server.IsEnterprise() {
+		ru := gds.cfg.Server.RESTURL()
+		opts = append(opts, github.WithURLs(&ru, nil))
+	}
+
+	return github.NewClient(opts...)
+}
+
+// getRepositories returns the repositories of the organization whose
+// deployments are scraped: the configured repositories, or all the
+// repositories which are not archived.
+func (gds *githubDeploymentScraper) getRepositories(ctx context.Context, client *github.Client) ([]*github.Repository, error) {
+	var all []*github.Repository
+	opt := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: defaultPerPage}}
+	for {
+		repos, resp, err := client.Repositories.ListByOrg(ctx, gds.cfg.GitHubOrg, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repo := range repos {
+			if len(gds.cfg.Repositories) > 0 {
+				if slices.Contains(gds.cfg.Repositories, repo.GetName()) {
+					all = append(all, repo)
+				}
+				continue
+			}
+
+			if !repo.GetArchived() {
+				all = append(all, repo)
+			}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// getDeployments returns the deployments of a repository created since the
+// given time, to the given environment when it is not empty.
+func (gds *githubDeploymentScraper) getDeployments(
+	ctx context.Context,
+	client *github.Client,
+	repo string,
+	environment string,
+	since time.Time,
+) ([]*github.Deployment, error) {
+	var all []*github.Deployment
+	opt := &github.DeploymentsListOptions{
+		Environment: environment,
+		ListOptions: github.ListOptions{PerPage: defaultPerPage},
+	}
+	for {
+		deployments, resp, err := client.Repositories.ListDeployments(ctx, gds.cfg.GitHubOrg, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		// The deployments are listed from the most recent, so the pages
+		// past the first deployment created before the lookback window
+		// are not requested.
+		for _, deployment := range deployments {
+			if deployment.GetCreatedAt().Before(since) {
+				return all, nil
+			}
+			all = append(all, deployment)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// getDeploymentStatuses returns the statuses of a deployment.
+func (gds *githubDeploymentScraper) getDeploymentStatuses(
+	ctx context.Context,
+	client *github.Client,
+	repo string,
+	id int64,
+) ([]*github.DeploymentStatus, error) {
+	var all []*github.DeploymentStatus
+	opt := &github.ListOptions{PerPage: defaultPerPage}
+	for {
+		statuses, resp, err := client.Repositories.ListDeploymentStatuses(ctx, gds.cfg.GitHubOrg, repo, id, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, statuses...)
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// getCommitTime returns the time the commit with the given SHA was committed.
+func (gds *githubDeploymentScraper) getCommitTime(
+	ctx context.Context,
+	client *github.Client,
+	repo string,
+	sha string,
+) (time.Time, error) {
+	commit, _, err := client.Git.GetCommit(ctx, gds.cfg.GitHubOrg, repo, sha)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return commit.GetCommitter().GetDate().Time, nil
+}
+
+// serviceName returns the service.name of a repository, from its
+// `service_name` custom property when set or from its name otherwise,
+// formatted the same way as the service.name of the deployment spans
+// created from webhook events.
+func serviceName(repo *github.Repository) string {
+	name := repo.GetName()
+	if svc, ok := repo.CustomProperties["service_name"].(string); ok && svc != "" {
+		name = svc
+	}
+
+	return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+}
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubrunnerscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubrunnerscraper"
+
+import (
+	"go.opentelemetry.io/collector/config/confighttp"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+// Config relating to GitHub Self-Hosted Runner Scraper.
+type Config struct {
+	metadata.MetricsBuilderConfig `mapstructure:",squash"`
+	confighttp.ClientConfig       `mapstructure:",squash"`
+	internal.ScraperConfig
+	// GitHubOrg is the name of the GitHub organization whose runners and
+	// runner groups are scraped.
+	GitHubOrg string `mapstructure:"github_org"`
+	// Repositories are the names of the repositories of the organization
+	// whose repository level runners are also scraped.
+	Repositories []string `mapstructure:"repositories"`
+	// Server holds the URLs of the GitHub server, set from the receiver
+	// configuration. An endpoint set in the ClientConfig takes precedence.
+	Server internal.ServerConfig `mapstructure:"-"`
+}
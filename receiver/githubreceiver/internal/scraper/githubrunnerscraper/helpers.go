@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubrunnerscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubrunnerscraper"
+
+import (
+	"context"
+
+	"github.com/google/go-github/v89/github"
+)
+
+const defaultPerPage = 100
+
+func (grs *githubRunnerScraper) createClient() (*github.Client, error) {
+	if grs.cfg.Endpoint != "" {
+		// The rest client needs the endpoint to be the root of the server
+		ru := grs.cfg.Endpoint
+		return github.NewClient(github.WithHTTPClient(grs.client), github.WithEnterpriseURLs(ru, ru))
+	}
+
+	opts := []github.ClientOptionsFunc{github.WithHTTPClient(grs.client)}
+	if grs.cfg.Server.IsEnterprise() {
+		ru := grs.cfg.Server.RESTURL()
+		opts = append(opts, github.WithURLs(&ru, nil))
+	}
+
+	return github.NewClient(opts...)
+}
+
+// getRunnerGroups returns the names of the runner groups of the organization
+// by ID.
+func (grs *githubRunnerScraper) getRunnerGroups(ctx context.Context, client *github.Client) (map[int64]string, error) {
+	groups := map[int64]string{}
+	opt := &github.ListOrgRunnerGroupOptions{ListOptions: github.ListOptions{PerPage: defaultPerPage}}
+	for {
+		list, resp, err := client.Actions.ListOrganizationRunnerGroups(ctx, grs.cfg.GitHubOrg, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range list.RunnerGroups {
+			groups[group.GetID()] = group.GetName()
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return groups, nil
+}
+
+// getOrgRunners returns the self-hosted runners of the organization.
+func (grs *githubRunnerScraper) getOrgRunners(ctx context.Context, client *github.Client) ([]*github.Runner, error) {
+	var all []*github.Runner
+	opt := &github.ListRunnersOptions{ListOptions: github.ListOptions{PerPage: defaultPerPage}}
+	for {
+		runners, resp, err := client.Actions.ListOrganizationRunners(ctx, grs.cfg.GitHubOrg, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, runners.Runners...)
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// getRepoRunners returns the self-hosted runners registered to a repository of
+// the organization.
+func (grs *githubRunnerScraper) getRepoRunners(ctx context.Context, client *github.Client, repo string) ([]*github.Runner, error) {
+	var all []*github.Runner
+	opt := &github.ListRunnersOptions{ListOptions: github.ListOptions{PerPage: defaultPerPage}}
+	for {
+		runners, resp, err := client.Actions.ListRunners(ctx, grs.cfg.GitHubOrg, repo, opt)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, runners.Runners...)
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
@@ -0,0 +1,197 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubrunnerscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubrunnerscraper"
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+var errClientNotInitErr = errors.New("http client not initialized")
+
+// workerStates are the states every runner group and label is reported for,
+// so that a state without runners is reported as zero rather than missing.
+var workerStates = []metadata.AttributeCicdWorkerState{
+	metadata.AttributeCicdWorkerStateAvailable,
+	metadata.AttributeCicdWorkerStateBusy,
+	metadata.AttributeCicdWorkerStateOffline,
+}
+
+type githubRunnerScraper struct {
+	client    *http.Client
+	cfg       *Config
+	settings  component.TelemetrySettings
+	logger    *zap.Logger
+	mb        *metadata.MetricsBuilder
+	rb        *metadata.ResourceBuilder
+	telemetry *metadata.TelemetryBuilder
+	// scraperTelemetry records the requests and the scrapes of the scraper
+	scraperTelemetry *internal.ScraperTelemetry
+}
+
+func (grs *githubRunnerScraper) start(ctx context.Context, host component.Host) (err error) {
+	grs.logger.Sugar().Info("starting the GitHub runner scraper")
+
+	// Initialize extensions as nil, which is safe to pass to ToClient when host is nil
+	// The OpenTelemetry client will handle the nil extensions case appropriately
+	var extensions map[component.ID]component.Component
+	if host != nil {
+		extensions = host.GetExtensions()
+	}
+
+	grs.client, err = grs.cfg.ToClient(ctx, extensions, grs.settings)
+	if err != nil {
+		return
+	}
+
+	grs.telemetry, err = metadata.NewTelemetryBuilder(grs.settings)
+	if err != nil {
+		return
+	}
+
+	grs.scraperTelemetry = internal.NewScraperTelemetry(grs.telemetry, TypeStr)
+	grs.client.Transport = grs.scraperTelemetry.Transport(grs.client.Transport)
+	return
+}
+
+func (grs *githubRunnerScraper) shutdown(context.Context) error {
+	if grs.telemetry != nil {
+		grs.telemetry.Shutdown()
+	}
+	return nil
+}
+
+func newGitHubRunnerScraper(
+	settings receiver.Settings,
+	cfg *Config,
+) *githubRunnerScraper {
+	return &githubRunnerScraper{
+		cfg:      cfg,
+		settings: settings.TelemetrySettings,
+		logger:   settings.Logger,
+		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
+		rb:       metadata.NewResourceBuilder(cfg.ResourceAttributes),
+	}
+}
+
+// scrape and return the state of the self-hosted runners of the organization
+// and of the configured repositories
+func (grs *githubRunnerScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if grs.client == nil {
+		return pmetric.NewMetrics(), errClientNotInitErr
+	}
+
+	start := time.Now()
+	now := pcommon.NewTimestampFromTime(start)
+	defer grs.scraperTelemetry.RecordScrape(ctx, start)
+
+	client, err := grs.createClient()
+	if err != nil {
+		grs.logger.Sugar().Errorf("unable to create client", zap.Error(err))
+		return grs.mb.Emit(), err
+	}
+
+	groups, err := grs.getRunnerGroups(ctx, client)
+	if err != nil {
+		grs.logger.Sugar().Errorf("error getting runner groups", zap.Error(err))
+		return grs.mb.Emit(), err
+	}
+
+	runners, err := grs.getOrgRunners(ctx, client)
+	if err != nil {
+		grs.logger.Sugar().Errorf("error getting organization runners", zap.Error(err))
+		return grs.mb.Emit(), err
+	}
+
+	grs.recordRunners(now, "", groups, runners)
+
+	grs.scraperTelemetry.RecordRepositories(ctx, len(grs.cfg.Repositories))
+	for _, repo := range grs.cfg.Repositories {
+		runners, err := grs.getRepoRunners(ctx, client, repo)
+		if err != nil {
+			grs.logger.Sugar().Errorf("error getting repository runners", zap.String("repo", repo), zap.Error(err))
+			continue
+		}
+
+		grs.recordRunners(now, repo, nil, runners)
+	}
+
+	grs.rb.SetVcsVendorName("github")
+	grs.rb.SetOrganizationName(grs.cfg.GitHubOrg)
+
+	res := grs.rb.Emit()
+	return grs.mb.Emit(metadata.WithResource(res)), nil
+}
+
+// recordRunners records the number of runners by state for each runner group
+// and each label. Every runner group is recorded, including the groups
+// without runners. Repository runners do not belong to a runner group.
+func (grs *githubRunnerScraper) recordRunners(
+	now pcommon.Timestamp,
+	repo string,
+	groups map[int64]string,
+	runners []*github.Runner,
+) {
+	byGroup := map[string]map[metadata.AttributeCicdWorkerState]int64{}
+	for _, name := range groups {
+		byGroup[name] = map[metadata.AttributeCicdWorkerState]int64{}
+	}
+
+	byLabel := map[string]map[metadata.AttributeCicdWorkerState]int64{}
+	for _, runner := range runners {
+		state := runnerState(runner)
+
+		group := groups[runner.GetRunnerGroupID()]
+		if byGroup[group] == nil {
+			byGroup[group] = map[metadata.AttributeCicdWorkerState]int64{}
+		}
+		byGroup[group][state]++
+
+		for _, label := range runner.Labels {
+			name := strings.ToLower(label.GetName())
+			if byLabel[name] == nil {
+				byLabel[name] = map[metadata.AttributeCicdWorkerState]int64{}
+			}
+			byLabel[name][state]++
+		}
+	}
+
+	for group, counts := range byGroup {
+		for _, state := range workerStates {
+			grs.mb.RecordCicdWorkerCountDataPoint(now, counts[state], state, group, repo)
+		}
+	}
+
+	for label, counts := range byLabel {
+		for _, state := range workerStates {
+			grs.mb.RecordCicdWorkerLabelCountDataPoint(now, counts[state], state, label, repo)
+		}
+	}
+}
+
+// runnerState returns the state of a runner. Online runners are either busy
+// running a job or available to run one.
+func runnerState(runner *github.Runner) metadata.AttributeCicdWorkerState {
+	switch {
+	case !strings.EqualFold(runner.GetStatus(), "online"):
+		return metadata.AttributeCicdWorkerStateOffline
+	case runner.GetBusy():
+		return metadata.AttributeCicdWorkerStateBusy
+	default:
+		return metadata.AttributeCicdWorkerStateAvailable
+	}
+}
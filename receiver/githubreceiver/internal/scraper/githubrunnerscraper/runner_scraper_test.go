@@ -0,0 +1,217 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubrunnerscraper
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+func TestNewGitHubRunnerScraper(t *testing.T) {
+	factory := Factory{}
+	defaultConfig := factory.CreateDefaultConfig()
+
+	s := newGitHubRunnerScraper(receivertest.NewNopSettings(metadata.Type), defaultConfig.(*Config))
+
+	assert.NotNil(t, s)
+}
+
+func newRunner(id int64, groupID int64, status string, busy bool, labels ...string) *github.Runner {
+	runner := &github.Runner{
+		ID:            github.Ptr(id),
+		RunnerGroupID: github.Ptr(groupID),
+		Status:        github.Ptr(status),
+		Busy:          github.Ptr(busy),
+	}
+	for _, label := range labels {
+		runner.Labels = append(runner.Labels, &github.RunnerLabels{Name: github.Ptr(label)})
+	}
+	return runner
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func runnerTestServer(t *testing.T) *http.ServeMux {
+	var mux http.ServeMux
+
+	mux.HandleFunc("/api/v3/orgs/liatrio/actions/runner-groups", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, &github.RunnerGroups{
+			TotalCount: 3,
+			RunnerGroups: []*github.RunnerGroup{
+				{ID: github.Ptr(int64(1)), Name: github.Ptr("Default")},
+				{ID: github.Ptr(int64(2)), Name: github.Ptr("gpu")},
+				{ID: github.Ptr(int64(3)), Name: github.Ptr("unused")},
+			},
+		})
+	})
+
+	mux.HandleFunc("/api/v3/orgs/liatrio/actions/runners", func(w http.ResponseWriter, r *http.Request) {
+		// the runners are split across two pages
+		if r.URL.Query().Get("page") == "" {
+			w.Header().Set("Link", `<`+r.URL.Path+`?page=2>; rel="next"`)
+			writeJSON(t, w, &github.Runners{
+				TotalCount: 4,
+				Runners: []*github.Runner{
+					newRunner(1, 1, "online", false, "self-hosted", "Linux", "X64"),
+					newRunner(2, 1, "online", true, "self-hosted", "Linux", "X64"),
+				},
+			})
+			return
+		}
+		writeJSON(t, w, &github.Runners{
+			TotalCount: 4,
+			Runners: []*github.Runner{
+				newRunner(3, 1, "offline", false, "self-hosted", "Linux", "X64"),
+				newRunner(4, 2, "online", true, "self-hosted", "Linux", "gpu"),
+			},
+		})
+	})
+
+	mux.HandleFunc("/api/v3/repos/liatrio/otel-testing/actions/runners", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(t, w, &github.Runners{
+			TotalCount: 1,
+			Runners:    []*github.Runner{newRunner(5, 0, "online", false, "self-hosted", "macOS")},
+		})
+	})
+
+	mux.HandleFunc("/api/v3/repos/liatrio/missing/actions/runners", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	return &mux
+}
+
+func TestScrape(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		repositories []string
+		testFile     string
+	}{
+		{
+			desc:     "organization runners",
+			testFile: "expected_org_runners.yaml",
+		},
+		{
+			// runners of repositories which fail to be retrieved are skipped
+			desc:         "organization and repository runners",
+			repositories: []string{"otel-testing", "missing"},
+			testFile:     "expected_happy_path.yaml",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			server := httptest.NewServer(runnerTestServer(t))
+			defer server.Close()
+
+			cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig()}
+
+			grs := newGitHubRunnerScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+			grs.cfg.GitHubOrg = "liatrio"
+			grs.cfg.Repositories = tc.repositories
+			grs.cfg.Endpoint = server.URL
+
+			err := grs.start(ctx, componenttest.NewNopHost())
+			require.NoError(t, err)
+
+			actualMetrics, err := grs.scrape(ctx)
+			require.NoError(t, err)
+
+			expectedFile := filepath.Join("testdata", "scraper", tc.testFile)
+
+			// golden.WriteMetrics(t, expectedFile, actualMetrics)
+
+			expectedMetrics, err := golden.ReadMetrics(expectedFile)
+			require.NoError(t, err)
+			require.NoError(t, pmetrictest.CompareMetrics(
+				expectedMetrics,
+				actualMetrics,
+				pmetrictest.IgnoreMetricDataPointsOrder(),
+				pmetrictest.IgnoreTimestamp(),
+				pmetrictest.IgnoreStartTimestamp(),
+			))
+		})
+	}
+}
+
+func TestScrapeOrgRunnersError(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig()}
+
+	grs := newGitHubRunnerScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	grs.cfg.GitHubOrg = "liatrio"
+	grs.cfg.Endpoint = server.URL
+
+	require.NoError(t, grs.start(ctx, componenttest.NewNopHost()))
+
+	_, err := grs.scrape(ctx)
+	require.Error(t, err)
+}
+
+func TestScrapeClientNotInitialized(t *testing.T) {
+	cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig()}
+	grs := newGitHubRunnerScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+
+	_, err := grs.scrape(context.Background())
+	require.ErrorIs(t, err, errClientNotInitErr)
+}
+
+func TestRunnerState(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		runner   *github.Runner
+		expected metadata.AttributeCicdWorkerState
+	}{
+		{
+			desc:     "online and idle",
+			runner:   newRunner(1, 1, "online", false),
+			expected: metadata.AttributeCicdWorkerStateAvailable,
+		},
+		{
+			desc:     "online and running a job",
+			runner:   newRunner(1, 1, "online", true),
+			expected: metadata.AttributeCicdWorkerStateBusy,
+		},
+		{
+			desc:     "offline",
+			runner:   newRunner(1, 1, "offline", false),
+			expected: metadata.AttributeCicdWorkerStateOffline,
+		},
+		{
+			// GitHub can report a runner which just went offline as busy
+			desc:     "offline and busy",
+			runner:   newRunner(1, 1, "offline", true),
+			expected: metadata.AttributeCicdWorkerStateOffline,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, runnerState(tc.runner))
+		})
+	}
+}
@@ -4,12 +4,27 @@
 package githubscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubscraper"
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"go.opentelemetry.io/collector/config/confighttp"
+	"go.uber.org/multierr"
 
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
 )
 
+var (
+	errOrganizationName      = errors.New("organizations must have a name")
+	errDuplicateOrganization = errors.New("organization is configured more than once")
+	errCacheMaxEntries       = errors.New("cache max_entries must be greater than 0")
+	errRateLimitMinRemaining = errors.New("rate_limit min_remaining must not be negative")
+	errRateLimitMaxWait      = errors.New("rate_limit max_wait must be greater than 0")
+	errRateLimitMaxRetries   = errors.New("rate_limit max_retries must not be negative")
+	errCVELookbackDays       = errors.New("cve_lookback_days must be greater than 0")
+)
+
 // Config relating to GitHub Metric Scraper.
 type Config struct {
 	metadata.MetricsBuilderConfig `mapstructure:",squash"`
@@ -18,7 +33,114 @@ type Config struct {
 	// GitHubOrg is the name of the GitHub organization to scrape (github scraper only)
 	GitHubOrg string `mapstructure:"github_org"`
 	// SearchQuery is the query to use when defining a custom search for repository data
-	SearchQuery      string `mapstructure:"search_query"`
-	GitHubTeam       string `mapstructure:"github_team"`
-	ConcurrencyLimit int    `mapstructure:"concurrency_limit"`
+	SearchQuery string `mapstructure:"search_query"`
+	// Organizations are additional GitHub organizations, or users, scraped
+	// with the same client and schedule. Each one is emitted under its own
+	// resource.
+	Organizations    []OrganizationConfig `mapstructure:"organizations"`
+	GitHubTeam       string               `mapstructure:"github_team"`
+	ConcurrencyLimit int                  `mapstructure:"concurrency_limit"`
+	// CVELookbackDays is the number of days over which the time to remediate
+	// the resolved CVE alerts is averaged. Default is 30.
+	CVELookbackDays int `mapstructure:"cve_lookback_days"`
+	// Cache configures caching the API responses across scrapes.
+	Cache CacheConfig `mapstructure:"cache"`
+	// RateLimit configures how requests are scheduled within the GitHub API
+	// rate limits.
+	RateLimit RateLimitConfig `mapstructure:"rate_limit"`
+	// Server holds the URLs of the GitHub server, set from the receiver
+	// configuration. An endpoint set in the ClientConfig takes precedence.
+	Server internal.ServerConfig `mapstructure:"-"`
+}
+
+// OrganizationConfig is a GitHub organization, or user, to scrape.
+type OrganizationConfig struct {
+	// Name is the login of the GitHub organization or user.
+	Name string `mapstructure:"name"`
+	// SearchQuery is the query to use when defining a custom search for the
+	// repository data of the organization. The search_query of the scraper
+	// only applies to the github_org.
+	SearchQuery string `mapstructure:"search_query"`
+}
+
+// CacheConfig configures caching the responses of the GitHub APIs across
+// scrapes, so that data which did not change since the previous scrape costs
+// little to none of the rate limit.
+type CacheConfig struct {
+	// Enabled revalidates the cached REST API responses with conditional
+	// requests, which GitHub does not count against the rate limit when
+	// answered with a 304 Not Modified, and reuses the commit evaluation of
+	// branches whose head did not change. Default is true.
+	Enabled bool `mapstructure:"enabled"`
+	// MaxEntries is the maximum number of REST API responses, and of branch
+	// commit evaluations, cached. Default is 5000.
+	MaxEntries int `mapstructure:"max_entries"`
+}
+
+// RateLimitConfig configures how the requests of the scraper are scheduled
+// within the primary and secondary rate limits of the GitHub APIs.
+type RateLimitConfig struct {
+	// MinRemaining is the rate limit budget kept in reserve. Once the
+	// remaining budget reaches it, requests wait for the rate limit to reset.
+	// Default is 100.
+	MinRemaining int `mapstructure:"min_remaining"`
+	// MaxWait is the longest a request waits for the rate limit to reset or
+	// before being retried. Requests which would wait longer are sent, or
+	// fail, right away. Default is 5m.
+	MaxWait time.Duration `mapstructure:"max_wait"`
+	// MaxRetries is the number of times a request rejected by a rate limit
+	// is retried. Default is 3.
+	MaxRetries int `mapstructure:"max_retries"`
+}
+
+// Validate the configuration of the organizations scraped, of the cache, of
+// the rate limits and of the CVE lookback window.
+func (cfg *Config) Validate() error {
+	var errs error
+
+	if cfg.Cache.Enabled && cfg.Cache.MaxEntries <= 0 {
+		errs = multierr.Append(errs, errCacheMaxEntries)
+	}
+
+	if cfg.RateLimit.MinRemaining < 0 {
+		errs = multierr.Append(errs, errRateLimitMinRemaining)
+	}
+
+	if cfg.RateLimit.MaxWait <= 0 {
+		errs = multierr.Append(errs, errRateLimitMaxWait)
+	}
+
+	if cfg.RateLimit.MaxRetries < 0 {
+		errs = multierr.Append(errs, errRateLimitMaxRetries)
+	}
+
+	if cfg.CVELookbackDays <= 0 {
+		errs = multierr.Append(errs, errCVELookbackDays)
+	}
+
+	seen := map[string]bool{}
+	for _, org := range cfg.organizations() {
+		if org.Name == "" {
+			errs = multierr.Append(errs, errOrganizationName)
+			continue
+		}
+
+		if seen[org.Name] {
+			errs = multierr.Append(errs, fmt.Errorf("%w: %s", errDuplicateOrganization, org.Name))
+		}
+		seen[org.Name] = true
+	}
+
+	return errs
+}
+
+// organizations returns the organizations to scrape, starting with the
+// github_org when set.
+func (cfg *Config) organizations() []OrganizationConfig {
+	var orgs []OrganizationConfig
+	if cfg.GitHubOrg != "" {
+		orgs = append(orgs, OrganizationConfig{Name: cfg.GitHubOrg, SearchQuery: cfg.SearchQuery})
+	}
+
+	return append(orgs, cfg.Organizations...)
 }
@@ -25,7 +25,100 @@ func TestConfig(t *testing.T) {
 	expectedConfig := &Config{
 		MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
 		ClientConfig:         clientConfig,
+		CVELookbackDays:      30,
+		Cache: CacheConfig{
+			Enabled:    true,
+			MaxEntries: 5000,
+		},
+		RateLimit: RateLimitConfig{
+			MinRemaining: 100,
+			MaxWait:      5 * time.Minute,
+			MaxRetries:   3,
+		},
 	}
 
 	assert.Equal(t, expectedConfig, defaultConfig)
 }
+
+func TestValidateConfig(t *testing.T) {
+	testCases := []struct {
+		desc            string
+		githubOrg       string
+		organizations   []OrganizationConfig
+		cache           CacheConfig
+		rateLimit       RateLimitConfig
+		cveLookbackDays int
+		expectedErr     error
+	}{
+		{
+			desc:          "organizations",
+			githubOrg:     "liatrio",
+			organizations: []OrganizationConfig{{Name: "open-telemetry", SearchQuery: "org:open-telemetry topic:go"}},
+		},
+		{
+			desc:          "missing organization name",
+			organizations: []OrganizationConfig{{SearchQuery: "org:liatrio"}},
+			expectedErr:   errOrganizationName,
+		},
+		{
+			desc:        "cache without entries",
+			githubOrg:   "liatrio",
+			cache:       CacheConfig{Enabled: true},
+			expectedErr: errCacheMaxEntries,
+		},
+		{
+			desc:        "negative rate limit reserve",
+			githubOrg:   "liatrio",
+			rateLimit:   RateLimitConfig{MinRemaining: -1, MaxWait: time.Minute},
+			expectedErr: errRateLimitMinRemaining,
+		},
+		{
+			desc:        "rate limit without wait",
+			githubOrg:   "liatrio",
+			rateLimit:   RateLimitConfig{MinRemaining: 100},
+			expectedErr: errRateLimitMaxWait,
+		},
+		{
+			desc:        "negative rate limit retries",
+			githubOrg:   "liatrio",
+			rateLimit:   RateLimitConfig{MaxWait: time.Minute, MaxRetries: -1},
+			expectedErr: errRateLimitMaxRetries,
+		},
+		{
+			desc:            "negative cve lookback",
+			githubOrg:       "liatrio",
+			cveLookbackDays: -1,
+			expectedErr:     errCVELookbackDays,
+		},
+		{
+			desc:          "duplicate organization",
+			githubOrg:     "liatrio",
+			organizations: []OrganizationConfig{{Name: "liatrio"}},
+			expectedErr:   errDuplicateOrganization,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+			cfg.GitHubOrg = tc.githubOrg
+			cfg.Organizations = tc.organizations
+			if tc.cache != (CacheConfig{}) {
+				cfg.Cache = tc.cache
+			}
+			if tc.rateLimit != (RateLimitConfig{}) {
+				cfg.RateLimit = tc.rateLimit
+			}
+			if tc.cveLookbackDays != 0 {
+				cfg.CVELookbackDays = tc.cveLookbackDays
+			}
+
+			err := cfg.Validate()
+			if tc.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -20,6 +20,26 @@ import (
 const (
 	TypeStr            = "scraper"
 	defaultHTTPTimeout = 15 * time.Second
+
+	// defaultCacheMaxEntries is the default number of REST API responses, and
+	// of branch commit evaluations, cached across scrapes.
+	defaultCacheMaxEntries = 5000
+
+	// defaultRateLimitMinRemaining is the default rate limit budget kept in
+	// reserve.
+	defaultRateLimitMinRemaining = 100
+
+	// defaultRateLimitMaxWait is the default longest a request waits for the
+	// rate limit.
+	defaultRateLimitMaxWait = 5 * time.Minute
+
+	// defaultRateLimitMaxRetries is the default number of times a request
+	// rejected by a rate limit is retried.
+	defaultRateLimitMaxRetries = 3
+
+	// defaultCVELookbackDays is the default number of days over which the
+	// time to remediate the resolved CVE alerts is averaged.
+	defaultCVELookbackDays = 30
 )
 
 type Factory struct{}
@@ -30,6 +50,16 @@ func (f *Factory) CreateDefaultConfig() internal.Config {
 	return &Config{
 		MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
 		ClientConfig:         clientConfig,
+		CVELookbackDays:      defaultCVELookbackDays,
+		Cache: CacheConfig{
+			Enabled:    true,
+			MaxEntries: defaultCacheMaxEntries,
+		},
+		RateLimit: RateLimitConfig{
+			MinRemaining: defaultRateLimitMinRemaining,
+			MaxWait:      defaultRateLimitMaxWait,
+			MaxRetries:   defaultRateLimitMaxRetries,
+		},
 	}
 }
 
@@ -44,5 +74,6 @@ func (f *Factory) CreateMetricsScraper(
 	return scraper.NewMetrics(
 		s.scrape,
 		scraper.WithStart(s.start),
+		scraper.WithShutdown(s.shutdown),
 	)
 }
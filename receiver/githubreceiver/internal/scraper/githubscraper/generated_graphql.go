@@ -291,6 +291,8 @@ func (v *BranchHistoryTargetTree) GetTypename() string { return v.Typename }
 type BranchNode struct {
 	// The ref name.
 	Name string `json:"name"`
+	// The object the ref points to. Returns null when object does not exist.
+	Target BranchNodeTargetGitObject `json:"-"`
 	// Compares the current ref as a base ref to another head ref, if the comparison can be made.
 	Compare BranchNodeCompareComparison `json:"compare"`
 	// The repository the ref belongs to.
@@ -300,12 +302,87 @@ type BranchNode struct {
 // GetName returns BranchNode.Name, and is useful for accessing the field via an interface.
 func (v *BranchNode) GetName() string { return v.Name }
 
+// GetTarget returns BranchNode.Target, and is useful for accessing the field via an interface.
+func (v *BranchNode) GetTarget() BranchNodeTargetGitObject { return v.Target }
+
 // GetCompare returns BranchNode.Compare, and is useful for accessing the field via an interface.
 func (v *BranchNode) GetCompare() BranchNodeCompareComparison { return v.Compare }
 
 // GetRepository returns BranchNode.Repository, and is useful for accessing the field via an interface.
 func (v *BranchNode) GetRepository() BranchNodeRepository { return v.Repository }
 
+func (v *BranchNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*BranchNode
+		Target json.RawMessage `json:"target"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.BranchNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Target
+		src := firstPass.Target
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalBranchNodeTargetGitObject(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal BranchNode.Target: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalBranchNode struct {
+	Name string `json:"name"`
+
+	Target json.RawMessage `json:"target"`
+
+	Compare BranchNodeCompareComparison `json:"compare"`
+
+	Repository BranchNodeRepository `json:"repository"`
+}
+
+func (v *BranchNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *BranchNode) __premarshalJSON() (*__premarshalBranchNode, error) {
+	var retval __premarshalBranchNode
+
+	retval.Name = v.Name
+	{
+
+		dst := &retval.Target
+		src := v.Target
+		var err error
+		*dst, err = __marshalBranchNodeTargetGitObject(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal BranchNode.Target: %w", err)
+		}
+	}
+	retval.Compare = v.Compare
+	retval.Repository = v.Repository
+	return &retval, nil
+}
+
 // BranchNodeCompareComparison includes the requested fields of the GraphQL type Comparison.
 // The GraphQL type's documentation follows.
 //
@@ -354,6 +431,175 @@ type BranchNodeRepositoryDefaultBranchRef struct {
 // GetName returns BranchNodeRepositoryDefaultBranchRef.Name, and is useful for accessing the field via an interface.
 func (v *BranchNodeRepositoryDefaultBranchRef) GetName() string { return v.Name }
 
+// BranchNodeTargetBlob includes the requested fields of the GraphQL type Blob.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git blob.
+type BranchNodeTargetBlob struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns BranchNodeTargetBlob.Typename, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetBlob) GetTypename() string { return v.Typename }
+
+// GetOid returns BranchNodeTargetBlob.Oid, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetBlob) GetOid() string { return v.Oid }
+
+// BranchNodeTargetCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type BranchNodeTargetCommit struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns BranchNodeTargetCommit.Typename, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetCommit) GetTypename() string { return v.Typename }
+
+// GetOid returns BranchNodeTargetCommit.Oid, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetCommit) GetOid() string { return v.Oid }
+
+// BranchNodeTargetGitObject includes the requested fields of the GraphQL interface GitObject.
+//
+// BranchNodeTargetGitObject is implemented by the following types:
+// BranchNodeTargetBlob
+// BranchNodeTargetCommit
+// BranchNodeTargetTag
+// BranchNodeTargetTree
+// The GraphQL type's documentation follows.
+//
+// Represents a Git object.
+type BranchNodeTargetGitObject interface {
+	implementsGraphQLInterfaceBranchNodeTargetGitObject()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetOid returns the interface-field "oid" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The Git object ID
+	GetOid() string
+}
+
+func (v *BranchNodeTargetBlob) implementsGraphQLInterfaceBranchNodeTargetGitObject()   {}
+func (v *BranchNodeTargetCommit) implementsGraphQLInterfaceBranchNodeTargetGitObject() {}
+func (v *BranchNodeTargetTag) implementsGraphQLInterfaceBranchNodeTargetGitObject()    {}
+func (v *BranchNodeTargetTree) implementsGraphQLInterfaceBranchNodeTargetGitObject()   {}
+
+func __unmarshalBranchNodeTargetGitObject(b []byte, v *BranchNodeTargetGitObject) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Blob":
+		*v = new(BranchNodeTargetBlob)
+		return json.Unmarshal(b, *v)
+	case "Commit":
+		*v = new(BranchNodeTargetCommit)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(BranchNodeTargetTag)
+		return json.Unmarshal(b, *v)
+	case "Tree":
+		*v = new(BranchNodeTargetTree)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GitObject.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for BranchNodeTargetGitObject: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalBranchNodeTargetGitObject(v *BranchNodeTargetGitObject) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *BranchNodeTargetBlob:
+		typename = "Blob"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*BranchNodeTargetBlob
+		}{typename, v}
+		return json.Marshal(result)
+	case *BranchNodeTargetCommit:
+		typename = "Commit"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*BranchNodeTargetCommit
+		}{typename, v}
+		return json.Marshal(result)
+	case *BranchNodeTargetTag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*BranchNodeTargetTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *BranchNodeTargetTree:
+		typename = "Tree"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*BranchNodeTargetTree
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for BranchNodeTargetGitObject: "%T"`, v)
+	}
+}
+
+// BranchNodeTargetTag includes the requested fields of the GraphQL type Tag.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tag.
+type BranchNodeTargetTag struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns BranchNodeTargetTag.Typename, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetTag) GetTypename() string { return v.Typename }
+
+// GetOid returns BranchNodeTargetTag.Oid, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetTag) GetOid() string { return v.Oid }
+
+// BranchNodeTargetTree includes the requested fields of the GraphQL type Tree.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git tree.
+type BranchNodeTargetTree struct {
+	Typename string `json:"__typename"`
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetTypename returns BranchNodeTargetTree.Typename, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetTree) GetTypename() string { return v.Typename }
+
+// GetOid returns BranchNodeTargetTree.Oid, and is useful for accessing the field via an interface.
+func (v *BranchNodeTargetTree) GetOid() string { return v.Oid }
+
 // CVENode includes the requested fields of the GraphQL type RepositoryVulnerabilityAlert.
 // The GraphQL type's documentation follows.
 //
@@ -361,6 +607,8 @@ func (v *BranchNodeRepositoryDefaultBranchRef) GetName() string { return v.Name
 type CVENode struct {
 	// The Node ID of the RepositoryVulnerabilityAlert object
 	Id string `json:"id"`
+	// When was the alert created?
+	CreatedAt time.Time `json:"createdAt"`
 	// The associated security vulnerability
 	SecurityVulnerability CVENodeSecurityVulnerability `json:"securityVulnerability"`
 }
@@ -368,6 +616,9 @@ type CVENode struct {
 // GetId returns CVENode.Id, and is useful for accessing the field via an interface.
 func (v *CVENode) GetId() string { return v.Id }
 
+// GetCreatedAt returns CVENode.CreatedAt, and is useful for accessing the field via an interface.
+func (v *CVENode) GetCreatedAt() time.Time { return v.CreatedAt }
+
 // GetSecurityVulnerability returns CVENode.SecurityVulnerability, and is useful for accessing the field via an interface.
 func (v *CVENode) GetSecurityVulnerability() CVENodeSecurityVulnerability {
 	return v.SecurityVulnerability
@@ -380,11 +631,32 @@ func (v *CVENode) GetSecurityVulnerability() CVENodeSecurityVulnerability {
 type CVENodeSecurityVulnerability struct {
 	// The severity of the vulnerability within this package
 	Severity SecurityAdvisorySeverity `json:"severity"`
+	// A description of the vulnerable package
+	Package CVENodeSecurityVulnerabilityPackageSecurityAdvisoryPackage `json:"package"`
 }
 
 // GetSeverity returns CVENodeSecurityVulnerability.Severity, and is useful for accessing the field via an interface.
 func (v *CVENodeSecurityVulnerability) GetSeverity() SecurityAdvisorySeverity { return v.Severity }
 
+// GetPackage returns CVENodeSecurityVulnerability.Package, and is useful for accessing the field via an interface.
+func (v *CVENodeSecurityVulnerability) GetPackage() CVENodeSecurityVulnerabilityPackageSecurityAdvisoryPackage {
+	return v.Package
+}
+
+// CVENodeSecurityVulnerabilityPackageSecurityAdvisoryPackage includes the requested fields of the GraphQL type SecurityAdvisoryPackage.
+// The GraphQL type's documentation follows.
+//
+// An individual package
+type CVENodeSecurityVulnerabilityPackageSecurityAdvisoryPackage struct {
+	// The ecosystem the package belongs to, e.g. RUBYGEMS, NPM
+	Ecosystem SecurityAdvisoryEcosystem `json:"ecosystem"`
+}
+
+// GetEcosystem returns CVENodeSecurityVulnerabilityPackageSecurityAdvisoryPackage.Ecosystem, and is useful for accessing the field via an interface.
+func (v *CVENodeSecurityVulnerabilityPackageSecurityAdvisoryPackage) GetEcosystem() SecurityAdvisoryEcosystem {
+	return v.Ecosystem
+}
+
 // CommitNode includes the requested fields of the GraphQL type Commit.
 // The GraphQL type's documentation follows.
 //
@@ -407,45 +679,346 @@ func (v *CommitNode) GetAdditions() int { return v.Additions }
 // GetDeletions returns CommitNode.Deletions, and is useful for accessing the field via an interface.
 func (v *CommitNode) GetDeletions() int { return v.Deletions }
 
-// PullRequestNode includes the requested fields of the GraphQL type PullRequest.
+// PullRequestNode includes the requested fields of the GraphQL type PullRequest.
+// The GraphQL type's documentation follows.
+//
+// A repository pull request.
+type PullRequestNode struct {
+	// Identifies the date and time when the object was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Whether or not the pull request was merged.
+	Merged bool `json:"merged"`
+	// The date and time that the pull request was merged.
+	MergedAt time.Time `json:"mergedAt"`
+	// `true` if the pull request is closed
+	Closed bool `json:"closed"`
+	// Identifies the date and time when the object was closed.
+	ClosedAt time.Time `json:"closedAt"`
+	// The commit that was created when this pull request was merged.
+	MergeCommit PullRequestNodeMergeCommit `json:"mergeCommit"`
+	// Identifies the name of the head Ref associated with the pull request, even if the ref has been deleted.
+	HeadRefName string `json:"headRefName"`
+	// The actor who authored the comment.
+	Author PullRequestNodeAuthorActor `json:"-"`
+	// A list of reviews associated with the pull request.
+	Reviews PullRequestNodeReviewsPullRequestReviewConnection `json:"reviews"`
+}
+
+// GetCreatedAt returns PullRequestNode.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetMerged returns PullRequestNode.Merged, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetMerged() bool { return v.Merged }
+
+// GetMergedAt returns PullRequestNode.MergedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetMergedAt() time.Time { return v.MergedAt }
+
+// GetClosed returns PullRequestNode.Closed, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetClosed() bool { return v.Closed }
+
+// GetClosedAt returns PullRequestNode.ClosedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetClosedAt() time.Time { return v.ClosedAt }
+
+// GetMergeCommit returns PullRequestNode.MergeCommit, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetMergeCommit() PullRequestNodeMergeCommit { return v.MergeCommit }
+
+// GetHeadRefName returns PullRequestNode.HeadRefName, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetHeadRefName() string { return v.HeadRefName }
+
+// GetAuthor returns PullRequestNode.Author, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetAuthor() PullRequestNodeAuthorActor { return v.Author }
+
+// GetReviews returns PullRequestNode.Reviews, and is useful for accessing the field via an interface.
+func (v *PullRequestNode) GetReviews() PullRequestNodeReviewsPullRequestReviewConnection {
+	return v.Reviews
+}
+
+func (v *PullRequestNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PullRequestNode
+		Author json.RawMessage `json:"author"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PullRequestNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Author
+		src := firstPass.Author
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalPullRequestNodeAuthorActor(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal PullRequestNode.Author: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalPullRequestNode struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	Merged bool `json:"merged"`
+
+	MergedAt time.Time `json:"mergedAt"`
+
+	Closed bool `json:"closed"`
+
+	ClosedAt time.Time `json:"closedAt"`
+
+	MergeCommit PullRequestNodeMergeCommit `json:"mergeCommit"`
+
+	HeadRefName string `json:"headRefName"`
+
+	Author json.RawMessage `json:"author"`
+
+	Reviews PullRequestNodeReviewsPullRequestReviewConnection `json:"reviews"`
+}
+
+func (v *PullRequestNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PullRequestNode) __premarshalJSON() (*__premarshalPullRequestNode, error) {
+	var retval __premarshalPullRequestNode
+
+	retval.CreatedAt = v.CreatedAt
+	retval.Merged = v.Merged
+	retval.MergedAt = v.MergedAt
+	retval.Closed = v.Closed
+	retval.ClosedAt = v.ClosedAt
+	retval.MergeCommit = v.MergeCommit
+	retval.HeadRefName = v.HeadRefName
+	{
+
+		dst := &retval.Author
+		src := v.Author
+		var err error
+		*dst, err = __marshalPullRequestNodeAuthorActor(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal PullRequestNode.Author: %w", err)
+		}
+	}
+	retval.Reviews = v.Reviews
+	return &retval, nil
+}
+
+// PullRequestNodeAuthorActor includes the requested fields of the GraphQL interface Actor.
+//
+// PullRequestNodeAuthorActor is implemented by the following types:
+// PullRequestNodeAuthorBot
+// PullRequestNodeAuthorEnterpriseUserAccount
+// PullRequestNodeAuthorMannequin
+// PullRequestNodeAuthorOrganization
+// PullRequestNodeAuthorUser
+// The GraphQL type's documentation follows.
+//
+// Represents an object which can take actions on GitHub. Typically a User or Bot.
+type PullRequestNodeAuthorActor interface {
+	implementsGraphQLInterfacePullRequestNodeAuthorActor()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetLogin returns the interface-field "login" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The username of the actor.
+	GetLogin() string
+}
+
+func (v *PullRequestNodeAuthorBot) implementsGraphQLInterfacePullRequestNodeAuthorActor() {}
+func (v *PullRequestNodeAuthorEnterpriseUserAccount) implementsGraphQLInterfacePullRequestNodeAuthorActor() {
+}
+func (v *PullRequestNodeAuthorMannequin) implementsGraphQLInterfacePullRequestNodeAuthorActor()    {}
+func (v *PullRequestNodeAuthorOrganization) implementsGraphQLInterfacePullRequestNodeAuthorActor() {}
+func (v *PullRequestNodeAuthorUser) implementsGraphQLInterfacePullRequestNodeAuthorActor()         {}
+
+func __unmarshalPullRequestNodeAuthorActor(b []byte, v *PullRequestNodeAuthorActor) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Bot":
+		*v = new(PullRequestNodeAuthorBot)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(PullRequestNodeAuthorEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(PullRequestNodeAuthorMannequin)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(PullRequestNodeAuthorOrganization)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(PullRequestNodeAuthorUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Actor.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for PullRequestNodeAuthorActor: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalPullRequestNodeAuthorActor(v *PullRequestNodeAuthorActor) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *PullRequestNodeAuthorBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestNodeAuthorBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestNodeAuthorEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestNodeAuthorEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestNodeAuthorMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestNodeAuthorMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestNodeAuthorOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestNodeAuthorOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestNodeAuthorUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestNodeAuthorUser
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for PullRequestNodeAuthorActor: "%T"`, v)
+	}
+}
+
+// PullRequestNodeAuthorBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type PullRequestNodeAuthorBot struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns PullRequestNodeAuthorBot.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorBot) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestNodeAuthorBot.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorBot) GetLogin() string { return v.Login }
+
+// PullRequestNodeAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type PullRequestNodeAuthorEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns PullRequestNodeAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorEnterpriseUserAccount) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestNodeAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorEnterpriseUserAccount) GetLogin() string { return v.Login }
+
+// PullRequestNodeAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
 // The GraphQL type's documentation follows.
 //
-// A repository pull request.
-type PullRequestNode struct {
-	// Identifies the date and time when the object was created.
-	CreatedAt time.Time `json:"createdAt"`
-	// Whether or not the pull request was merged.
-	Merged bool `json:"merged"`
-	// The date and time that the pull request was merged.
-	MergedAt time.Time `json:"mergedAt"`
-	// The commit that was created when this pull request was merged.
-	MergeCommit PullRequestNodeMergeCommit `json:"mergeCommit"`
-	// Identifies the name of the head Ref associated with the pull request, even if the ref has been deleted.
-	HeadRefName string `json:"headRefName"`
-	// A list of reviews associated with the pull request.
-	Reviews PullRequestNodeReviewsPullRequestReviewConnection `json:"reviews"`
+// A placeholder user for attribution of imported data on GitHub.
+type PullRequestNodeAuthorMannequin struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
 }
 
-// GetCreatedAt returns PullRequestNode.CreatedAt, and is useful for accessing the field via an interface.
-func (v *PullRequestNode) GetCreatedAt() time.Time { return v.CreatedAt }
+// GetTypename returns PullRequestNodeAuthorMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorMannequin) GetTypename() string { return v.Typename }
 
-// GetMerged returns PullRequestNode.Merged, and is useful for accessing the field via an interface.
-func (v *PullRequestNode) GetMerged() bool { return v.Merged }
+// GetLogin returns PullRequestNodeAuthorMannequin.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorMannequin) GetLogin() string { return v.Login }
 
-// GetMergedAt returns PullRequestNode.MergedAt, and is useful for accessing the field via an interface.
-func (v *PullRequestNode) GetMergedAt() time.Time { return v.MergedAt }
+// PullRequestNodeAuthorOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type PullRequestNodeAuthorOrganization struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
 
-// GetMergeCommit returns PullRequestNode.MergeCommit, and is useful for accessing the field via an interface.
-func (v *PullRequestNode) GetMergeCommit() PullRequestNodeMergeCommit { return v.MergeCommit }
+// GetTypename returns PullRequestNodeAuthorOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorOrganization) GetTypename() string { return v.Typename }
 
-// GetHeadRefName returns PullRequestNode.HeadRefName, and is useful for accessing the field via an interface.
-func (v *PullRequestNode) GetHeadRefName() string { return v.HeadRefName }
+// GetLogin returns PullRequestNodeAuthorOrganization.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorOrganization) GetLogin() string { return v.Login }
 
-// GetReviews returns PullRequestNode.Reviews, and is useful for accessing the field via an interface.
-func (v *PullRequestNode) GetReviews() PullRequestNodeReviewsPullRequestReviewConnection {
-	return v.Reviews
+// PullRequestNodeAuthorUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type PullRequestNodeAuthorUser struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
 }
 
+// GetTypename returns PullRequestNodeAuthorUser.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorUser) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestNodeAuthorUser.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestNodeAuthorUser) GetLogin() string { return v.Login }
+
 // PullRequestNodeMergeCommit includes the requested fields of the GraphQL type Commit.
 // The GraphQL type's documentation follows.
 //
@@ -503,29 +1076,385 @@ type PullRequestNodeReviewsPullRequestReviewConnection struct {
 	// Identifies the total count of items in the connection.
 	TotalCount int `json:"totalCount"`
 	// A list of nodes.
-	Nodes []PullRequestNodeReviewsPullRequestReviewConnectionNodesPullRequestReview `json:"nodes"`
+	Nodes []PullRequestReviewNode `json:"nodes"`
 }
 
 // GetTotalCount returns PullRequestNodeReviewsPullRequestReviewConnection.TotalCount, and is useful for accessing the field via an interface.
 func (v *PullRequestNodeReviewsPullRequestReviewConnection) GetTotalCount() int { return v.TotalCount }
 
 // GetNodes returns PullRequestNodeReviewsPullRequestReviewConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *PullRequestNodeReviewsPullRequestReviewConnection) GetNodes() []PullRequestNodeReviewsPullRequestReviewConnectionNodesPullRequestReview {
+func (v *PullRequestNodeReviewsPullRequestReviewConnection) GetNodes() []PullRequestReviewNode {
 	return v.Nodes
 }
 
-// PullRequestNodeReviewsPullRequestReviewConnectionNodesPullRequestReview includes the requested fields of the GraphQL type PullRequestReview.
+// PullRequestReviewNode includes the requested fields of the GraphQL type PullRequestReview.
 // The GraphQL type's documentation follows.
 //
 // A review object for a given pull request.
-type PullRequestNodeReviewsPullRequestReviewConnectionNodesPullRequestReview struct {
+type PullRequestReviewNode struct {
+	// Identifies the current state of the pull request review.
+	State PullRequestReviewState `json:"state"`
 	// Identifies the date and time when the object was created.
 	CreatedAt time.Time `json:"createdAt"`
+	// Identifies when the Pull Request Review was submitted
+	SubmittedAt time.Time `json:"submittedAt"`
+	// The actor who authored the comment.
+	Author PullRequestReviewNodeAuthorActor `json:"-"`
+	// Identifies the commit associated with this pull request review.
+	Commit PullRequestReviewNodeCommit `json:"commit"`
+	// A list of review comments for the current pull request review.
+	Comments PullRequestReviewNodeCommentsPullRequestReviewCommentConnection `json:"comments"`
 }
 
-// GetCreatedAt returns PullRequestNodeReviewsPullRequestReviewConnectionNodesPullRequestReview.CreatedAt, and is useful for accessing the field via an interface.
-func (v *PullRequestNodeReviewsPullRequestReviewConnectionNodesPullRequestReview) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// GetState returns PullRequestReviewNode.State, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNode) GetState() PullRequestReviewState { return v.State }
+
+// GetCreatedAt returns PullRequestReviewNode.CreatedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNode) GetCreatedAt() time.Time { return v.CreatedAt }
+
+// GetSubmittedAt returns PullRequestReviewNode.SubmittedAt, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNode) GetSubmittedAt() time.Time { return v.SubmittedAt }
+
+// GetAuthor returns PullRequestReviewNode.Author, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNode) GetAuthor() PullRequestReviewNodeAuthorActor { return v.Author }
+
+// GetCommit returns PullRequestReviewNode.Commit, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNode) GetCommit() PullRequestReviewNodeCommit { return v.Commit }
+
+// GetComments returns PullRequestReviewNode.Comments, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNode) GetComments() PullRequestReviewNodeCommentsPullRequestReviewCommentConnection {
+	return v.Comments
+}
+
+func (v *PullRequestReviewNode) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*PullRequestReviewNode
+		Author json.RawMessage `json:"author"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.PullRequestReviewNode = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Author
+		src := firstPass.Author
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalPullRequestReviewNodeAuthorActor(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal PullRequestReviewNode.Author: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalPullRequestReviewNode struct {
+	State PullRequestReviewState `json:"state"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	SubmittedAt time.Time `json:"submittedAt"`
+
+	Author json.RawMessage `json:"author"`
+
+	Commit PullRequestReviewNodeCommit `json:"commit"`
+
+	Comments PullRequestReviewNodeCommentsPullRequestReviewCommentConnection `json:"comments"`
+}
+
+func (v *PullRequestReviewNode) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *PullRequestReviewNode) __premarshalJSON() (*__premarshalPullRequestReviewNode, error) {
+	var retval __premarshalPullRequestReviewNode
+
+	retval.State = v.State
+	retval.CreatedAt = v.CreatedAt
+	retval.SubmittedAt = v.SubmittedAt
+	{
+
+		dst := &retval.Author
+		src := v.Author
+		var err error
+		*dst, err = __marshalPullRequestReviewNodeAuthorActor(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal PullRequestReviewNode.Author: %w", err)
+		}
+	}
+	retval.Commit = v.Commit
+	retval.Comments = v.Comments
+	return &retval, nil
+}
+
+// PullRequestReviewNodeAuthorActor includes the requested fields of the GraphQL interface Actor.
+//
+// PullRequestReviewNodeAuthorActor is implemented by the following types:
+// PullRequestReviewNodeAuthorBot
+// PullRequestReviewNodeAuthorEnterpriseUserAccount
+// PullRequestReviewNodeAuthorMannequin
+// PullRequestReviewNodeAuthorOrganization
+// PullRequestReviewNodeAuthorUser
+// The GraphQL type's documentation follows.
+//
+// Represents an object which can take actions on GitHub. Typically a User or Bot.
+type PullRequestReviewNodeAuthorActor interface {
+	implementsGraphQLInterfacePullRequestReviewNodeAuthorActor()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetLogin returns the interface-field "login" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The username of the actor.
+	GetLogin() string
+}
+
+func (v *PullRequestReviewNodeAuthorBot) implementsGraphQLInterfacePullRequestReviewNodeAuthorActor() {
+}
+func (v *PullRequestReviewNodeAuthorEnterpriseUserAccount) implementsGraphQLInterfacePullRequestReviewNodeAuthorActor() {
+}
+func (v *PullRequestReviewNodeAuthorMannequin) implementsGraphQLInterfacePullRequestReviewNodeAuthorActor() {
+}
+func (v *PullRequestReviewNodeAuthorOrganization) implementsGraphQLInterfacePullRequestReviewNodeAuthorActor() {
+}
+func (v *PullRequestReviewNodeAuthorUser) implementsGraphQLInterfacePullRequestReviewNodeAuthorActor() {
+}
+
+func __unmarshalPullRequestReviewNodeAuthorActor(b []byte, v *PullRequestReviewNodeAuthorActor) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Bot":
+		*v = new(PullRequestReviewNodeAuthorBot)
+		return json.Unmarshal(b, *v)
+	case "EnterpriseUserAccount":
+		*v = new(PullRequestReviewNodeAuthorEnterpriseUserAccount)
+		return json.Unmarshal(b, *v)
+	case "Mannequin":
+		*v = new(PullRequestReviewNodeAuthorMannequin)
+		return json.Unmarshal(b, *v)
+	case "Organization":
+		*v = new(PullRequestReviewNodeAuthorOrganization)
+		return json.Unmarshal(b, *v)
+	case "User":
+		*v = new(PullRequestReviewNodeAuthorUser)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Actor.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for PullRequestReviewNodeAuthorActor: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalPullRequestReviewNodeAuthorActor(v *PullRequestReviewNodeAuthorActor) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *PullRequestReviewNodeAuthorBot:
+		typename = "Bot"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestReviewNodeAuthorBot
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestReviewNodeAuthorEnterpriseUserAccount:
+		typename = "EnterpriseUserAccount"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestReviewNodeAuthorEnterpriseUserAccount
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestReviewNodeAuthorMannequin:
+		typename = "Mannequin"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestReviewNodeAuthorMannequin
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestReviewNodeAuthorOrganization:
+		typename = "Organization"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestReviewNodeAuthorOrganization
+		}{typename, v}
+		return json.Marshal(result)
+	case *PullRequestReviewNodeAuthorUser:
+		typename = "User"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*PullRequestReviewNodeAuthorUser
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for PullRequestReviewNodeAuthorActor: "%T"`, v)
+	}
+}
+
+// PullRequestReviewNodeAuthorBot includes the requested fields of the GraphQL type Bot.
+// The GraphQL type's documentation follows.
+//
+// A special type of user which takes actions on behalf of GitHub Apps.
+type PullRequestReviewNodeAuthorBot struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns PullRequestReviewNodeAuthorBot.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorBot) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestReviewNodeAuthorBot.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorBot) GetLogin() string { return v.Login }
+
+// PullRequestReviewNodeAuthorEnterpriseUserAccount includes the requested fields of the GraphQL type EnterpriseUserAccount.
+// The GraphQL type's documentation follows.
+//
+// An account for a user who is an admin of an enterprise or a member of an enterprise through one or more organizations.
+type PullRequestReviewNodeAuthorEnterpriseUserAccount struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns PullRequestReviewNodeAuthorEnterpriseUserAccount.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorEnterpriseUserAccount) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestReviewNodeAuthorEnterpriseUserAccount.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorEnterpriseUserAccount) GetLogin() string { return v.Login }
+
+// PullRequestReviewNodeAuthorMannequin includes the requested fields of the GraphQL type Mannequin.
+// The GraphQL type's documentation follows.
+//
+// A placeholder user for attribution of imported data on GitHub.
+type PullRequestReviewNodeAuthorMannequin struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns PullRequestReviewNodeAuthorMannequin.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorMannequin) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestReviewNodeAuthorMannequin.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorMannequin) GetLogin() string { return v.Login }
+
+// PullRequestReviewNodeAuthorOrganization includes the requested fields of the GraphQL type Organization.
+// The GraphQL type's documentation follows.
+//
+// An account on GitHub, with one or more owners, that has repositories, members and teams.
+type PullRequestReviewNodeAuthorOrganization struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns PullRequestReviewNodeAuthorOrganization.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorOrganization) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestReviewNodeAuthorOrganization.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorOrganization) GetLogin() string { return v.Login }
+
+// PullRequestReviewNodeAuthorUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user is an individual's account on GitHub that owns repositories and can make new content.
+type PullRequestReviewNodeAuthorUser struct {
+	Typename string `json:"__typename"`
+	// The username of the actor.
+	Login string `json:"login"`
+}
+
+// GetTypename returns PullRequestReviewNodeAuthorUser.Typename, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorUser) GetTypename() string { return v.Typename }
+
+// GetLogin returns PullRequestReviewNodeAuthorUser.Login, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeAuthorUser) GetLogin() string { return v.Login }
+
+// PullRequestReviewNodeCommentsPullRequestReviewCommentConnection includes the requested fields of the GraphQL type PullRequestReviewCommentConnection.
+// The GraphQL type's documentation follows.
+//
+// The connection type for PullRequestReviewComment.
+type PullRequestReviewNodeCommentsPullRequestReviewCommentConnection struct {
+	// Identifies the total count of items in the connection.
+	TotalCount int `json:"totalCount"`
+}
+
+// GetTotalCount returns PullRequestReviewNodeCommentsPullRequestReviewCommentConnection.TotalCount, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeCommentsPullRequestReviewCommentConnection) GetTotalCount() int {
+	return v.TotalCount
+}
+
+// PullRequestReviewNodeCommit includes the requested fields of the GraphQL type Commit.
+// The GraphQL type's documentation follows.
+//
+// Represents a Git commit.
+type PullRequestReviewNodeCommit struct {
+	// The Git object ID
+	Oid string `json:"oid"`
+}
+
+// GetOid returns PullRequestReviewNodeCommit.Oid, and is useful for accessing the field via an interface.
+func (v *PullRequestReviewNodeCommit) GetOid() string { return v.Oid }
+
+// The possible states of a pull request review.
+type PullRequestReviewState string
+
+const (
+	// A review allowing the pull request to merge.
+	PullRequestReviewStateApproved PullRequestReviewState = "APPROVED"
+	// A review blocking the pull request from merging.
+	PullRequestReviewStateChangesRequested PullRequestReviewState = "CHANGES_REQUESTED"
+	// An informational review.
+	PullRequestReviewStateCommented PullRequestReviewState = "COMMENTED"
+	// A review that has been dismissed.
+	PullRequestReviewStateDismissed PullRequestReviewState = "DISMISSED"
+	// A review that has not yet been submitted.
+	PullRequestReviewStatePending PullRequestReviewState = "PENDING"
+)
+
+var AllPullRequestReviewState = []PullRequestReviewState{
+	PullRequestReviewStateApproved,
+	PullRequestReviewStateChangesRequested,
+	PullRequestReviewStateCommented,
+	PullRequestReviewStateDismissed,
+	PullRequestReviewStatePending,
 }
 
 // The possible states of a pull request.
@@ -900,6 +1829,51 @@ type SearchNodeUser struct {
 // GetTypename returns SearchNodeUser.Typename, and is useful for accessing the field via an interface.
 func (v *SearchNodeUser) GetTypename() string { return v.Typename }
 
+// The possible ecosystems of a security vulnerability's package.
+type SecurityAdvisoryEcosystem string
+
+const (
+	// GitHub Actions
+	SecurityAdvisoryEcosystemActions SecurityAdvisoryEcosystem = "ACTIONS"
+	// PHP packages hosted at packagist.org
+	SecurityAdvisoryEcosystemComposer SecurityAdvisoryEcosystem = "COMPOSER"
+	// Erlang/Elixir packages hosted at hex.pm
+	SecurityAdvisoryEcosystemErlang SecurityAdvisoryEcosystem = "ERLANG"
+	// Go modules
+	SecurityAdvisoryEcosystemGo SecurityAdvisoryEcosystem = "GO"
+	// Java artifacts hosted at the Maven central repository
+	SecurityAdvisoryEcosystemMaven SecurityAdvisoryEcosystem = "MAVEN"
+	// JavaScript packages hosted at npmjs.com
+	SecurityAdvisoryEcosystemNpm SecurityAdvisoryEcosystem = "NPM"
+	// .NET packages hosted at the NuGet Gallery
+	SecurityAdvisoryEcosystemNuget SecurityAdvisoryEcosystem = "NUGET"
+	// Python packages hosted at PyPI.org
+	SecurityAdvisoryEcosystemPip SecurityAdvisoryEcosystem = "PIP"
+	// Dart packages hosted at pub.dev
+	SecurityAdvisoryEcosystemPub SecurityAdvisoryEcosystem = "PUB"
+	// Ruby gems hosted at RubyGems.org
+	SecurityAdvisoryEcosystemRubygems SecurityAdvisoryEcosystem = "RUBYGEMS"
+	// Rust crates
+	SecurityAdvisoryEcosystemRust SecurityAdvisoryEcosystem = "RUST"
+	// Swift packages
+	SecurityAdvisoryEcosystemSwift SecurityAdvisoryEcosystem = "SWIFT"
+)
+
+var AllSecurityAdvisoryEcosystem = []SecurityAdvisoryEcosystem{
+	SecurityAdvisoryEcosystemActions,
+	SecurityAdvisoryEcosystemComposer,
+	SecurityAdvisoryEcosystemErlang,
+	SecurityAdvisoryEcosystemGo,
+	SecurityAdvisoryEcosystemMaven,
+	SecurityAdvisoryEcosystemNpm,
+	SecurityAdvisoryEcosystemNuget,
+	SecurityAdvisoryEcosystemPip,
+	SecurityAdvisoryEcosystemPub,
+	SecurityAdvisoryEcosystemRubygems,
+	SecurityAdvisoryEcosystemRust,
+	SecurityAdvisoryEcosystemSwift,
+}
+
 // Severity of the vulnerability.
 type SecurityAdvisorySeverity string
 
@@ -2240,6 +3214,10 @@ query getBranchData ($name: String!, $owner: String!, $branchFirst: Int!, $targe
 			totalCount
 			nodes {
 				name
+				target {
+					__typename
+					oid
+				}
 				compare(headRef: $targetBranch) {
 					aheadBy
 					behindBy
@@ -2385,6 +3363,8 @@ query getPullRequestData ($name: String!, $owner: String!, $prFirst: Int!, $prCu
 					createdAt
 					merged
 					mergedAt
+					closed
+					closedAt
 					mergeCommit {
 						deployments(last: 1, orderBy: {field:CREATED_AT,direction:ASC}) {
 							nodes {
@@ -2395,11 +3375,27 @@ query getPullRequestData ($name: String!, $owner: String!, $prFirst: Int!, $prCu
 					}
 				}
 				headRefName
-				reviews(states: APPROVED, last: 1) {
+				author {
+					__typename
+					login
+				}
+				reviews(first: 100) {
 					totalCount
 					nodes {
 						... on PullRequestReview {
+							state
 							createdAt
+							submittedAt
+							author {
+								__typename
+								login
+							}
+							commit {
+								oid
+							}
+							comments {
+								totalCount
+							}
 						}
 					}
 				}
@@ -2466,8 +3462,12 @@ query getRepoCVEs ($owner: String!, $repo: String!, $alertCursor: String) {
 			}
 			nodes {
 				id
+				createdAt
 				securityVulnerability {
 					severity
+					package {
+						ecosystem
+					}
 				}
 			}
 		}
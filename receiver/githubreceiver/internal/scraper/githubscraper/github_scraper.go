@@ -8,16 +8,23 @@ package githubscraper // import "github.com/liatrio/liatrio-otel-collector/recei
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/Khan/genqlient/graphql"
+	"github.com/google/go-github/v89/github"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
 	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
 )
 
@@ -26,10 +33,24 @@ var errClientNotInitErr = errors.New("http client not initialized")
 type githubScraper struct {
 	client   *http.Client
 	cfg      *Config
+	params   receiver.Settings
 	settings component.TelemetrySettings
 	logger   *zap.Logger
-	mb       *metadata.MetricsBuilder
-	rb       *metadata.ResourceBuilder
+	// mb is the metrics builder of the organization of a scraper returned by
+	// forOrg
+	mb *metadata.MetricsBuilder
+	rb *metadata.ResourceBuilder
+	// mbs are the metrics builders of each organization, kept across scrapes
+	mbs map[string]*metadata.MetricsBuilder
+	// commits are the commit evaluations of branches by head commit, shared
+	// by the scrapers of all the organizations
+	commits *lru.Cache[commitCacheKey, commitEvaluation]
+	// rateLimiter schedules the requests of all the organizations within
+	// the rate limits of the shared credentials
+	rateLimiter *rateLimiter
+	telemetry   *metadata.TelemetryBuilder
+	// scraperTelemetry records the requests and the scrapes of the scraper
+	scraperTelemetry *internal.ScraperTelemetry
 }
 
 func (ghs *githubScraper) start(ctx context.Context, host component.Host) (err error) {
@@ -43,29 +64,98 @@ func (ghs *githubScraper) start(ctx context.Context, host component.Host) (err e
 	}
 
 	ghs.client, err = ghs.cfg.ToClient(ctx, extensions, ghs.settings)
+	if err != nil {
+		return
+	}
+
+	ghs.telemetry, err = metadata.NewTelemetryBuilder(ghs.settings)
+	if err != nil {
+		return
+	}
+
+	// record the requests sent to GitHub, including the retries
+	ghs.scraperTelemetry = internal.NewScraperTelemetry(ghs.telemetry, TypeStr)
+	ghs.client.Transport = ghs.scraperTelemetry.Transport(ghs.client.Transport)
+
+	// schedule the requests within the rate limits, below the cache so that
+	// only the requests sent to GitHub are scheduled
+	ghs.rateLimiter = newRateLimiter(ghs.cfg.RateLimit, ghs.telemetry, ghs.logger)
+	ghs.client.Transport = newRateLimitTransport(ghs.client.Transport, ghs.rateLimiter)
+
+	if !ghs.cfg.Cache.Enabled {
+		return
+	}
+
+	// cache the responses under both the GraphQL and REST clients created on
+	// each scrape, so they are revalidated rather than fetched again
+	transport, err := newCachingTransport(ghs.client.Transport, ghs.cfg.Cache.MaxEntries)
+	if err != nil {
+		return
+	}
+	ghs.client.Transport = transport
+
+	ghs.commits, err = lru.New[commitCacheKey, commitEvaluation](ghs.cfg.Cache.MaxEntries)
 	return
 }
 
+func (ghs *githubScraper) shutdown(context.Context) error {
+	if ghs.telemetry != nil {
+		ghs.telemetry.Shutdown()
+	}
+	return nil
+}
+
 func newGitHubScraper(
 	settings receiver.Settings,
 	cfg *Config,
 ) *githubScraper {
 	return &githubScraper{
 		cfg:      cfg,
+		params:   settings,
 		settings: settings.TelemetrySettings,
 		logger:   settings.Logger,
-		mb:       metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, settings),
 		rb:       metadata.NewResourceBuilder(cfg.ResourceAttributes),
+		mbs:      map[string]*metadata.MetricsBuilder{},
+	}
+}
+
+// forOrg returns the scraper of a single organization. It shares the client
+// of the scraper and records into the metrics builder of the organization, so
+// that each organization is emitted under its own resource.
+func (ghs *githubScraper) forOrg(org OrganizationConfig) *githubScraper {
+	cfg := *ghs.cfg
+	cfg.GitHubOrg = org.Name
+	cfg.SearchQuery = org.SearchQuery
+
+	mb, ok := ghs.mbs[org.Name]
+	if !ok {
+		mb = metadata.NewMetricsBuilder(cfg.MetricsBuilderConfig, ghs.params)
+		ghs.mbs[org.Name] = mb
+	}
+
+	return &githubScraper{
+		client:      ghs.client,
+		cfg:         &cfg,
+		params:      ghs.params,
+		settings:    ghs.settings,
+		logger:      ghs.logger.With(zap.String("org", org.Name)),
+		mb:          mb,
+		rb:          ghs.rb,
+		commits:     ghs.commits,
+		rateLimiter: ghs.rateLimiter,
 	}
 }
 
-// scrape and return github metrics
+// scrape and return github metrics of each organization. The repositories of
+// all the organizations are scraped concurrently within the same concurrency
+// limit, sharing the client and thus the rate limit of its credentials.
 func (ghs *githubScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	if ghs.client == nil {
 		return pmetric.NewMetrics(), errClientNotInitErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	start := time.Now()
+	now := pcommon.NewTimestampFromTime(start)
 	ghs.logger.Sugar().Debug("current time", zap.Time("now", now.AsTime()))
 
 	genClient, restClient, err := ghs.createClients()
@@ -73,12 +163,97 @@ func (ghs *githubScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		ghs.logger.Sugar().Errorf("unable to create clients", zap.Error(err))
 	}
 
+	type orgRepos struct {
+		scraper *githubScraper
+		repos   []Repo
+	}
+
+	// Discover the repositories of each organization before scraping any of
+	// them, so they all share the same concurrency limit
+	var scraped []orgRepos
+	var total int
+	var errs error
+	orgs := ghs.cfg.organizations()
+	for _, org := range orgs {
+		ogs := ghs.forOrg(org)
+
+		repos, err := ogs.discover(ctx, genClient, now)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to scrape organization %s: %w", org.Name, err))
+			continue
+		}
+
+		scraped = append(scraped, orgRepos{scraper: ogs, repos: repos})
+		total += len(repos)
+	}
+
+	// Get the ref (branch) count (future branch data) for each repo and record
+	// the given metrics
+	var wg sync.WaitGroup
+	var mux sync.Mutex
+
+	var max int
+	switch {
+	case ghs.cfg.ConcurrencyLimit > 0:
+		max = ghs.cfg.ConcurrencyLimit
+	default:
+		max = total
+	}
+
+	limiter := make(chan struct{}, max)
+
+	for _, org := range scraped {
+		wg.Add(len(org.repos))
+		for _, repo := range org.repos {
+			limiter <- struct{}{}
+
+			go func() {
+				defer func() {
+					<-limiter
+					wg.Done()
+				}()
+
+				org.scraper.scrapeRepo(ctx, genClient, restClient, &mux, now, repo)
+			}()
+		}
+	}
+
+	wg.Wait()
+	ghs.scraperTelemetry.RecordScrape(ctx, start)
+	ghs.scraperTelemetry.RecordRepositories(ctx, total)
+
+	// Set the resource attributes and emit the metrics of each organization
+	// with its own resource
+	metrics := pmetric.NewMetrics()
+	for _, org := range scraped {
+		ghs.rb.SetVcsVendorName("github")
+		ghs.rb.SetOrganizationName(org.scraper.cfg.GitHubOrg)
+		ghs.rb.SetTeamName(ghs.cfg.GitHubTeam)
+
+		res := ghs.rb.Emit()
+		org.scraper.mb.Emit(metadata.WithResource(res)).ResourceMetrics().MoveAndAppendTo(metrics.ResourceMetrics())
+	}
+
+	switch {
+	case errs == nil:
+		return metrics, nil
+	case len(scraped) == 0:
+		return metrics, errs
+	default:
+		// the metrics of the organizations scraped are still emitted
+		return metrics, scrapererror.NewPartialScrapeError(errs, len(orgs)-len(scraped))
+	}
+}
+
+// discover validates the organization and returns its repositories, recording
+// the number of repositories.
+func (ghs *githubScraper) discover(ctx context.Context, client graphql.Client, now pcommon.Timestamp) ([]Repo, error) {
 	// Do some basic validation to ensure the values provided actually exist in github
 	// prior to making queries against that org or user value
-	loginType, err := ghs.login(ctx, genClient, ghs.cfg.GitHubOrg)
+	loginType, err := ghs.login(ctx, client, ghs.cfg.GitHubOrg)
 	if err != nil {
 		ghs.logger.Sugar().Errorf("error logging into GitHub via GraphQL", zap.Error(err))
-		return ghs.mb.Emit(), err
+		return nil, err
 	}
 
 	// Generate the search query based on the type, org/user name, and the search_query
@@ -92,172 +267,206 @@ func (ghs *githubScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 
 	// Get the repository data based on the search query retrieving a slice of branches
 	// and the recording the total count of repositories
-	repos, count, err := ghs.getRepos(ctx, genClient, sq)
+	repos, count, err := ghs.getRepos(ctx, client, sq)
 	if err != nil {
 		ghs.logger.Sugar().Errorf("error getting repo data", zap.Error(err))
-		return ghs.mb.Emit(), err
+		return nil, err
 	}
 
 	ghs.mb.RecordVcsRepositoryCountDataPoint(now, int64(count))
 
-	// Get the ref (branch) count (future branch data) for each repo and record
-	// the given metrics
-	var wg sync.WaitGroup
-	wg.Add(len(repos))
-	var mux sync.Mutex
+	return repos, nil
+}
 
-	var max int
-	switch {
-	case ghs.cfg.ConcurrencyLimit > 0:
-		max = ghs.cfg.ConcurrencyLimit
-	default:
-		max = len(repos)
+// scrapeRepo records the metrics of a repository of the organization.
+func (ghs *githubScraper) scrapeRepo(
+	ctx context.Context,
+	genClient graphql.Client,
+	restClient *github.Client,
+	mux *sync.Mutex,
+	now pcommon.Timestamp,
+	repo Repo,
+) {
+	name := repo.Name
+	url := repo.Url
+	trunk := repo.DefaultBranchRef.Name
+
+	defer func() {
+		if r := recover(); r != nil {
+			ghs.logger.Error(
+				"recovered from panic in per-repo scrape goroutine",
+				zap.String("repo", name),
+				zap.Any("panic", r),
+				zap.Stack("stacktrace"),
+			)
+		}
+	}()
+
+	branches, count, err := ghs.getBranches(ctx, genClient, name, trunk)
+	if err != nil {
+		ghs.logger.Sugar().Errorf("error getting branch count: %v", zap.Error(err))
 	}
 
-	limiter := make(chan struct{}, max)
+	// Create a mutual exclusion lock to prevent the recordDataPoint
+	// SetStartTimestamp call from having a nil pointer panic. The
+	// unlock is deferred so a panic in any of the helper calls below
+	// (caught by the recover() above) does not leak the lock and
+	// deadlock sibling per-repo goroutines.
+	mux.Lock()
+	defer mux.Unlock()
+
+	refType := metadata.AttributeVcsRefHeadTypeBranch
+	ghs.mb.RecordVcsRefCountDataPoint(now, int64(count), url, name, refType)
+
+	// Iterate through the refs (branches) populating the Branch focused
+	// metrics
+	for _, branch := range branches {
+		// See https://github.com/liatrio/liatrio-otel-collector/blob/main/receiver/githubreceiver/internal/scraper/githubscraper/README.md#github-limitations
+		// for more information as to why we do not emit metrics for
+		// the default branch (trunk) nor any branch with no changes to
+		// it.
+		if branch.Name == branch.Repository.DefaultBranchRef.Name || branch.Compare.BehindBy == 0 {
+			continue
+		}
+
+		// See https://github.com/liatrio/liatrio-otel-collector/blob/main/receiver/githubreceiver/internal/scraper/githubscraper/README.md#github-limitations
+		// for more information as to why `BehindBy` and `AheadBy` are
+		// swapped.
+		//nolint:lll
+		ghs.mb.RecordVcsRefRevisionsDeltaDataPoint(now, int64(branch.Compare.BehindBy), url, name, branch.Name, refType, metadata.AttributeVcsRevisionDeltaDirectionAhead)
+		//nolint:lll
+		ghs.mb.RecordVcsRefRevisionsDeltaDataPoint(now, int64(branch.Compare.AheadBy), url, name, branch.Name, refType, metadata.AttributeVcsRevisionDeltaDirectionBehind)
+
+		var additions int
+		var deletions int
+		var age int64
+
+		additions, deletions, age, err = ghs.evalCommits(ctx, genClient, branch.Repository.Name, branch)
+		if err != nil {
+			ghs.logger.Sugar().Errorf("error getting commit info: %v", zap.Error(err))
+			continue
+		}
+
+		ghs.mb.RecordVcsRefTimeDataPoint(now, age, url, name, branch.Name, refType)
+		//nolint:lll
+		ghs.mb.RecordVcsRefLinesDeltaDataPoint(now, int64(additions), url, name, branch.Name, refType, trunk, metadata.AttributeVcsRefBaseTypeBranch, metadata.AttributeVcsLineChangeTypeAdded)
+		//nolint:lll
+		ghs.mb.RecordVcsRefLinesDeltaDataPoint(now, int64(deletions), url, name, branch.Name, refType, trunk, metadata.AttributeVcsRefBaseTypeBranch, metadata.AttributeVcsLineChangeTypeRemoved)
+
+	}
 
-	for _, repo := range repos {
-		repo := repo
-		name := repo.Name
-		url := repo.Url
-		trunk := repo.DefaultBranchRef.Name
-		now := now
+	// Get the contributor count for each of the repositories
+	// if ghs.cfg.Metrics.VcsContributorCount.Enabled {
+	// }
+	contribs, err := ghs.getContributorCount(ctx, restClient, name)
+	if err != nil {
+		ghs.logger.Sugar().Errorf("error getting contributor count: %v", zap.Error(err))
+	}
+	ghs.mb.RecordVcsContributorCountDataPoint(now, int64(contribs), url, name)
 
-		limiter <- struct{}{}
+	// Get change (pull request) data
+	prs, err := ghs.getPullRequests(ctx, genClient, name)
+	if err != nil {
+		ghs.logger.Sugar().Errorf("error getting pull requests: %v", zap.Error(err))
+	}
 
-		go func() {
-			defer func() {
-				<-limiter
-				wg.Done()
-			}()
-			defer func() {
-				if r := recover(); r != nil {
-					ghs.logger.Error(
-						"recovered from panic in per-repo scrape goroutine",
-						zap.String("repo", name),
-						zap.Any("panic", r),
-						zap.Stack("stacktrace"),
-					)
-				}
-			}()
+	// When enabled, fetch the open CVEs of the repository once for both their
+	// count and their age
+	var cveNodes []CVENode
+	var cveAlerts []*github.Alert
+	if ghs.cfg.Metrics.VcsCveCount.Enabled || ghs.cfg.Metrics.VcsCveAge.Enabled {
+		cveNodes, cveAlerts = ghs.getCVEs(ctx, genClient, restClient, name)
+	}
 
-			branches, count, err := ghs.getBranches(ctx, genClient, name, trunk)
-			if err != nil {
-				ghs.logger.Sugar().Errorf("error getting branch count: %v", zap.Error(err))
-			}
+	// When enabled, process any CVEs for the repository
+	if ghs.cfg.Metrics.VcsCveCount.Enabled {
+		for s, c := range mapSeverities(cveNodes, cveAlerts) {
+			ghs.mb.RecordVcsCveCountDataPoint(now, c, url, name, s)
+		}
+	}
+
+	// When enabled, process the age of the open CVEs and the time to remediate
+	// the CVEs resolved over the lookback window for the repository
+	if ghs.cfg.Metrics.VcsCveAge.Enabled || ghs.cfg.Metrics.VcsCveTimeToRemediate.Enabled {
+		since := now.AsTime().AddDate(0, 0, -ghs.cfg.CVELookbackDays)
+		ages, remediations := ghs.getCVETimes(ctx, restClient, name, cveNodes, cveAlerts, now.AsTime(), since)
+		for k, age := range ages {
+			ghs.mb.RecordVcsCveAgeDataPoint(now, age, url, name, k.severity, k.source, k.ecosystem)
+		}
+		for k, ttr := range remediations {
+			ghs.mb.RecordVcsCveTimeToRemediateDataPoint(now, ttr, url, name, k.severity, k.source, k.ecosystem, k.resolution)
+		}
+	}
 
-			// Create a mutual exclusion lock to prevent the recordDataPoint
-			// SetStartTimestamp call from having a nil pointer panic. The
-			// unlock is deferred so a panic in any of the helper calls below
-			// (caught by the recover() above) does not leak the lock and
-			// deadlock sibling per-repo goroutines.
-			mux.Lock()
-			defer mux.Unlock()
-
-			refType := metadata.AttributeVcsRefHeadTypeBranch
-			ghs.mb.RecordVcsRefCountDataPoint(now, int64(count), url, name, refType)
-
-			// Iterate through the refs (branches) populating the Branch focused
-			// metrics
-			for _, branch := range branches {
-				// See https://github.com/liatrio/liatrio-otel-collector/blob/main/receiver/githubreceiver/internal/scraper/githubscraper/README.md#github-limitations
-				// for more information as to why we do not emit metrics for
-				// the default branch (trunk) nor any branch with no changes to
-				// it.
-				if branch.Name == branch.Repository.DefaultBranchRef.Name || branch.Compare.BehindBy == 0 {
-					continue
-				}
-
-				// See https://github.com/liatrio/liatrio-otel-collector/blob/main/receiver/githubreceiver/internal/scraper/githubscraper/README.md#github-limitations
-				// for more information as to why `BehindBy` and `AheadBy` are
-				// swapped.
-				//nolint:lll
-				ghs.mb.RecordVcsRefRevisionsDeltaDataPoint(now, int64(branch.Compare.BehindBy), url, name, branch.Name, refType, metadata.AttributeVcsRevisionDeltaDirectionAhead)
-				//nolint:lll
-				ghs.mb.RecordVcsRefRevisionsDeltaDataPoint(now, int64(branch.Compare.AheadBy), url, name, branch.Name, refType, metadata.AttributeVcsRevisionDeltaDirectionBehind)
-
-				var additions int
-				var deletions int
-				var age int64
-
-				additions, deletions, age, err = ghs.evalCommits(ctx, genClient, branch.Repository.Name, branch)
-				if err != nil {
-					ghs.logger.Sugar().Errorf("error getting commit info: %v", zap.Error(err))
-					continue
-				}
-
-				ghs.mb.RecordVcsRefTimeDataPoint(now, age, url, name, branch.Name, refType)
-				//nolint:lll
-				ghs.mb.RecordVcsRefLinesDeltaDataPoint(now, int64(additions), url, name, branch.Name, refType, trunk, metadata.AttributeVcsRefBaseTypeBranch, metadata.AttributeVcsLineChangeTypeAdded)
-				//nolint:lll
-				ghs.mb.RecordVcsRefLinesDeltaDataPoint(now, int64(deletions), url, name, branch.Name, refType, trunk, metadata.AttributeVcsRefBaseTypeBranch, metadata.AttributeVcsLineChangeTypeRemoved)
+	// When enabled, process the secret scanning alerts for the repository
+	if ghs.cfg.Metrics.VcsSecretCount.Enabled || ghs.cfg.Metrics.VcsSecretPushProtectionBypassCount.Enabled {
+		secrets, bypasses := mapSecrets(ghs.getSecretScanAlerts(ctx, restClient, name))
+		for k, c := range secrets {
+			ghs.mb.RecordVcsSecretCountDataPoint(now, c, url, name, k.secretType, k.validity)
+		}
+		for t, c := range bypasses {
+			ghs.mb.RecordVcsSecretPushProtectionBypassCountDataPoint(now, c, url, name, t)
+		}
+	}
 
-			}
+	var merged int
+	var open int
+	var closed int
 
-			// Get the contributor count for each of the repositories
-			// if ghs.cfg.Metrics.VcsContributorCount.Enabled {
-			// }
-			contribs, err := ghs.getContributorCount(ctx, restClient, name)
-			if err != nil {
-				ghs.logger.Sugar().Errorf("error getting contributor count: %v", zap.Error(err))
-			}
-			ghs.mb.RecordVcsContributorCountDataPoint(now, int64(contribs), url, name)
+	for _, pr := range prs {
+		reviews := summarizeReviews(pr)
+		state := metadata.AttributeVcsChangeStateOpen
 
-			// Get change (pull request) data
-			prs, err := ghs.getPullRequests(ctx, genClient, name)
-			if err != nil {
-				ghs.logger.Sugar().Errorf("error getting pull requests: %v", zap.Error(err))
-			}
+		switch {
+		case pr.Merged:
+			merged++
+			state = metadata.AttributeVcsChangeStateMerged
 
-			// When enabled, process any CVEs for the repository
-			if ghs.cfg.Metrics.VcsCveCount.Enabled {
-				cves, err := ghs.getCVEs(ctx, genClient, restClient, name)
-				if err != nil {
-					ghs.logger.Sugar().Errorf("error getting cves: %v", zap.Error(err))
-				}
-				for s, c := range cves {
-					ghs.mb.RecordVcsCveCountDataPoint(now, c, url, name, s)
-				}
-			}
+			age := getAge(pr.CreatedAt, pr.MergedAt)
 
-			var merged int
-			var open int
+			ghs.mb.RecordVcsChangeTimeToMergeDataPoint(now, age, url, name, pr.HeadRefName)
 
-			for _, pr := range prs {
-				if pr.Merged {
-					merged++
+			if !reviews.lastApproval.IsZero() {
+				age := getAge(reviews.lastApproval, pr.MergedAt)
 
-					age := getAge(pr.CreatedAt, pr.MergedAt)
+				ghs.mb.RecordVcsChangeTimeFromApprovalToMergeDataPoint(now, age, url, name, pr.HeadRefName)
+			}
+		case pr.Closed:
+			// A pull request closed without being merged was abandoned, so its
+			// lifetime is the time until it was closed.
+			closed++
+			state = metadata.AttributeVcsChangeStateClosed
 
-					ghs.mb.RecordVcsChangeTimeToMergeDataPoint(now, age, url, name, pr.HeadRefName)
+			age := getAge(pr.CreatedAt, pr.ClosedAt)
 
-				} else {
-					open++
+			ghs.mb.RecordVcsChangeDurationDataPoint(now, age, url, name, pr.HeadRefName, metadata.AttributeVcsChangeStateClosed)
+		default:
+			open++
 
-					age := getAge(pr.CreatedAt, now.AsTime())
+			age := getAge(pr.CreatedAt, now.AsTime())
 
-					ghs.mb.RecordVcsChangeDurationDataPoint(now, age, url, name, pr.HeadRefName, metadata.AttributeVcsChangeStateOpen)
+			ghs.mb.RecordVcsChangeDurationDataPoint(now, age, url, name, pr.HeadRefName, metadata.AttributeVcsChangeStateOpen)
 
-					if pr.Reviews.TotalCount > 0 {
-						age := getAge(pr.CreatedAt, pr.Reviews.Nodes[0].CreatedAt)
+			if !reviews.firstApproval.IsZero() {
+				age := getAge(pr.CreatedAt, reviews.firstApproval)
 
-						ghs.mb.RecordVcsChangeTimeToApprovalDataPoint(now, age, url, name, pr.HeadRefName)
-					}
-				}
+				ghs.mb.RecordVcsChangeTimeToApprovalDataPoint(now, age, url, name, pr.HeadRefName)
 			}
+		}
 
-			ghs.mb.RecordVcsChangeCountDataPoint(now, int64(open), url, metadata.AttributeVcsChangeStateOpen, name)
-			ghs.mb.RecordVcsChangeCountDataPoint(now, int64(merged), url, metadata.AttributeVcsChangeStateMerged, name)
-		}()
-	}
+		if !reviews.firstReview.IsZero() {
+			age := getAge(pr.CreatedAt, reviews.firstReview)
 
-	wg.Wait()
+			ghs.mb.RecordVcsChangeTimeToFirstReviewDataPoint(now, age, url, name, pr.HeadRefName, state)
+		}
+
+		ghs.mb.RecordVcsChangeReviewRoundCountDataPoint(now, int64(reviews.rounds), url, name, pr.HeadRefName, state)
+		ghs.mb.RecordVcsChangeReviewerCountDataPoint(now, int64(reviews.reviewers), url, name, pr.HeadRefName, state)
+		ghs.mb.RecordVcsChangeReviewCommentCountDataPoint(now, int64(reviews.comments), url, name, pr.HeadRefName, state)
+	}
 
-	// Set the resource attributes and emit metrics with those resources
-	ghs.rb.SetVcsVendorName("github")
-	ghs.rb.SetOrganizationName(ghs.cfg.GitHubOrg)
-	ghs.rb.SetTeamName(ghs.cfg.GitHubTeam)
+	ghs.mb.RecordVcsChangeCountDataPoint(now, int64(open), url, metadata.AttributeVcsChangeStateOpen, name)
+	ghs.mb.RecordVcsChangeCountDataPoint(now, int64(merged), url, metadata.AttributeVcsChangeStateMerged, name)
+	ghs.mb.RecordVcsChangeCountDataPoint(now, int64(closed), url, metadata.AttributeVcsChangeStateClosed, name)
 
-	res := ghs.rb.Emit()
-	return ghs.mb.Emit(metadata.WithResource(res)), nil
 }
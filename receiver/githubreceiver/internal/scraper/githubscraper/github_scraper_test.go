@@ -21,6 +21,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receivertest"
+	"go.opentelemetry.io/collector/scraper/scrapererror"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
 )
@@ -114,6 +115,9 @@ func TestScrape(t *testing.T) {
 								{
 									Merged: true,
 								},
+								{
+									Closed: true,
+								},
 							},
 						},
 					},
@@ -232,6 +236,9 @@ func TestScrape(t *testing.T) {
 								{
 									Merged: true,
 								},
+								{
+									Closed: true,
+								},
 							},
 						},
 					},
@@ -380,6 +387,128 @@ func TestScrape(t *testing.T) {
 	}
 }
 
+func TestScrapeOrganizations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// the login of both the liatrio organization and the octocat user exists,
+	// each of them having a single repository
+	r := singleRepoResponses("repo1")
+	r.checkLoginResponse.checkLogin.User = checkLoginUser{Login: "octocat"}
+	r.searchRepoResponse.repos = append(r.searchRepoResponse.repos, r.searchRepoResponse.repos[0])
+	r.branchResponse.branches = append(r.branchResponse.branches, r.branchResponse.branches[0])
+	r.prResponse.prs = append(r.prResponse.prs, r.prResponse.prs[0])
+	r.contribResponse.contribs = append(r.contribResponse.contribs, r.contribResponse.contribs[0])
+
+	server := httptest.NewServer(MockServer(r))
+	defer server.Close()
+
+	cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig()}
+
+	ghs := newGitHubScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	ghs.cfg.GitHubOrg = "liatrio"
+	ghs.cfg.Organizations = []OrganizationConfig{
+		{Name: "octocat", SearchQuery: "user:octocat topic:o11y"},
+		{Name: "missing"},
+	}
+	ghs.cfg.Endpoint = server.URL
+	ghs.cfg.ConcurrencyLimit = 1
+
+	require.NoError(t, ghs.start(ctx, componenttest.NewNopHost()))
+
+	// the organization which does not exist fails the scrape partially
+	metrics, err := ghs.scrape(ctx)
+	require.True(t, scrapererror.IsPartialScrapeError(err))
+	require.ErrorContains(t, err, "missing")
+
+	// each organization is emitted under its own resource
+	require.Equal(t, 2, metrics.ResourceMetrics().Len())
+	for i, org := range []string{"liatrio", "octocat"} {
+		rm := metrics.ResourceMetrics().At(i)
+		name, ok := rm.Resource().Attributes().Get("organization.name")
+		require.True(t, ok)
+		require.Equal(t, org, name.Str())
+
+		ms := rm.ScopeMetrics().At(0).Metrics()
+		var repoCount int64
+		for j := 0; j < ms.Len(); j++ {
+			if ms.At(j).Name() == "vcs.repository.count" {
+				repoCount = ms.At(j).Gauge().DataPoints().At(0).IntValue()
+			}
+		}
+		require.Equal(t, int64(1), repoCount)
+	}
+}
+
+func TestScrapeReviewMetrics(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	created := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	approval := PullRequestReviewNode{
+		State:       PullRequestReviewStateApproved,
+		SubmittedAt: created.Add(3 * time.Hour),
+		Author:      &PullRequestReviewNodeAuthorUser{Login: "alice"},
+		Commit:      PullRequestReviewNodeCommit{Oid: "a"},
+	}
+	approval.Comments.TotalCount = 2
+
+	r := singleRepoResponses("repo1")
+	r.prResponse.prs[0].Nodes = []PullRequestNode{
+		{
+			CreatedAt:   created,
+			Merged:      true,
+			MergedAt:    created.Add(5 * time.Hour),
+			HeadRefName: "feature",
+			Author:      &PullRequestNodeAuthorUser{Login: "author"},
+			Reviews: PullRequestNodeReviewsPullRequestReviewConnection{
+				TotalCount: 1,
+				Nodes:      []PullRequestReviewNode{approval},
+			},
+		},
+	}
+
+	server := httptest.NewServer(MockServer(r))
+	defer server.Close()
+
+	cfg := &Config{MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig()}
+	cfg.Metrics.VcsChangeTimeToFirstReview.Enabled = true
+	cfg.Metrics.VcsChangeTimeFromApprovalToMerge.Enabled = true
+	cfg.Metrics.VcsChangeReviewRoundCount.Enabled = true
+	cfg.Metrics.VcsChangeReviewerCount.Enabled = true
+	cfg.Metrics.VcsChangeReviewCommentCount.Enabled = true
+
+	ghs := newGitHubScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	ghs.cfg.GitHubOrg = "liatrio"
+	ghs.cfg.Endpoint = server.URL
+
+	require.NoError(t, ghs.start(ctx, componenttest.NewNopHost()))
+
+	metrics, err := ghs.scrape(ctx)
+	require.NoError(t, err)
+
+	values := map[string]int64{}
+	ms := metrics.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < ms.Len(); i++ {
+		if ms.At(i).Name() == "vcs.change.count" {
+			continue
+		}
+
+		// the review metrics of the change are recorded with its state
+		dp := ms.At(i).Gauge().DataPoints().At(0)
+		if state, ok := dp.Attributes().Get("vcs.change.state"); ok {
+			require.Equal(t, "merged", state.Str(), ms.At(i).Name())
+		}
+		values[ms.At(i).Name()] = dp.IntValue()
+	}
+
+	assert.Equal(t, int64(3*60*60), values["vcs.change.time_to_first_review"])
+	assert.Equal(t, int64(2*60*60), values["vcs.change.time_from_approval_to_merge"])
+	assert.Equal(t, int64(1), values["vcs.change.review.round.count"])
+	assert.Equal(t, int64(1), values["vcs.change.reviewer.count"])
+	assert.Equal(t, int64(2), values["vcs.change.review.comment.count"])
+}
+
 // singleRepoResponses builds a happy-path mock-server response set for a
 // single repository. The panic-recovery tests below pair it with
 // panicRoundTripper to inject a synthetic panic from inside the per-repo
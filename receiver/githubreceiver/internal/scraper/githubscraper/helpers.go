@@ -20,8 +20,6 @@ import (
 )
 
 const (
-	// The default public GitHub GraphQL Endpoint
-	defaultGraphURL = "https://api.github.com/graphql"
 	// The default maximum number of items to be returned in a GraphQL query.
 	defaultReturnItems = 100
 )
@@ -45,6 +43,7 @@ func (ghs *githubScraper) getRepos(
 				ghs.logger.Sugar().Errorf("error getting repo data by team", zap.Error(err))
 				return nil, 0, err
 			}
+			ghs.observeRateLimit(ctx, r.RateLimit.rateVals)
 			for _, repo := range r.Organization.Team.Repositories.Nodes {
 
 				repos = append(repos, repo.Repo)
@@ -61,6 +60,7 @@ func (ghs *githubScraper) getRepos(
 				ghs.logger.Sugar().Errorf("error getting repo data by search", zap.Error(err))
 				return nil, 0, err
 			}
+			ghs.observeRateLimit(ctx, r.RateLimit.rateVals)
 
 			for _, repo := range r.Search.Nodes {
 				if r, ok := repo.(*SearchNodeRepository); ok {
@@ -113,6 +113,7 @@ func (ghs *githubScraper) getBranches(
 				}
 			}
 
+			ghs.observeRateLimit(ctx, r.RateLimit.rateVals)
 			count = r.Repository.Refs.TotalCount
 			cursor = &r.Repository.Refs.PageInfo.EndCursor
 			next = r.Repository.Refs.PageInfo.HasNextPage
@@ -139,7 +140,7 @@ func (ghs *githubScraper) login(
 	// The checkLogin GraphQL query will always return an error. We only return
 	// the error if the login response for User and Organization are both nil.
 	// This is represented by checking to see if each resp.*.Login resolves to equal the owner.
-	resp, err := checkLogin(ctx, client, ghs.cfg.GitHubOrg)
+	resp, err := checkLogin(ctx, client, owner)
 
 	// These types are used later to generate the default string for the search query
 	// and thus must match the convention for user: and org: searches in GitHub
@@ -148,6 +149,8 @@ func (ghs *githubScraper) login(
 		loginType = "user"
 	case resp.Organization.Login == owner:
 		loginType = "org"
+	case err == nil:
+		return "", fmt.Errorf("no organization or user found for %q", owner)
 	default:
 		return "", err
 	}
@@ -155,6 +158,15 @@ func (ghs *githubScraper) login(
 	return loginType, nil
 }
 
+// observeRateLimit records the budget of the GraphQL API from the rateLimit
+// object of a query response.
+func (ghs *githubScraper) observeRateLimit(ctx context.Context, v rateVals) {
+	if ghs.rateLimiter == nil {
+		return
+	}
+	ghs.rateLimiter.observe(ctx, rateLimitResourceGraphQL, v.Limit, v.Remaining, v.ResetAt)
+}
+
 // Returns the default search query string based on input of owner type
 // and GitHubOrg name with a default of archived:false to ignore archived repos
 func genDefaultSearchQuery(ownertype string, ghorg string) string {
@@ -162,22 +174,16 @@ func genDefaultSearchQuery(ownertype string, ghorg string) string {
 }
 
 // Returns the graphql and rest clients for GitHub.
-// By default, the graphql client will use the public GitHub API URL as will
-// the rest client. If the user has specified an endpoint in the config via the
-// inherited ClientConfig, then the both clients will use that endpoint.
-// The endpoint defined needs to be the root server.
+// By default, the clients use the API URL of the GitHub server configured on
+// the receiver, which is the public GitHub API unless a GitHub Enterprise
+// Server is configured. If the user has specified an endpoint in the config
+// via the inherited ClientConfig, then the both clients will use that
+// endpoint instead. The endpoint defined needs to be the root server.
 // See the GitHub documentation for more information.
 // https://docs.github.com/en/graphql/guides/forming-calls-with-graphql#the-graphql-endpoint
 // https://docs.github.com/en/enterprise-server@3.8/graphql/guides/forming-calls-with-graphql#the-graphql-endpoint
 // https://docs.github.com/en/enterprise-server@3.8/rest/guides/getting-started-with-the-rest-api#making-a-request
 func (ghs *githubScraper) createClients() (gClient graphql.Client, rClient *github.Client, err error) {
-	rClient, err = github.NewClient(github.WithHTTPClient(ghs.client))
-	if err != nil {
-		ghs.logger.Sugar().Errorf("error creating rest client: %v", err)
-		return nil, nil, err
-	}
-	gClient = graphql.NewClient(defaultGraphURL, ghs.client)
-
 	if ghs.cfg.Endpoint != "" {
 
 		// Given endpoint set as `https://myGHEserver.com` we need to join the path
@@ -196,8 +202,23 @@ func (ghs *githubScraper) createClients() (gClient graphql.Client, rClient *gith
 			ghs.logger.Sugar().Errorf("error creating enterprise client: %v", err)
 			return nil, nil, err
 		}
+
+		return gClient, rClient, nil
+	}
+
+	opts := []github.ClientOptionsFunc{github.WithHTTPClient(ghs.client)}
+	if ghs.cfg.Server.IsEnterprise() {
+		ru := ghs.cfg.Server.RESTURL()
+		opts = append(opts, github.WithURLs(&ru, nil))
 	}
 
+	rClient, err = github.NewClient(opts...)
+	if err != nil {
+		ghs.logger.Sugar().Errorf("error creating rest client: %v", err)
+		return nil, nil, err
+	}
+	gClient = graphql.NewClient(ghs.cfg.Server.GraphQLURL(), ghs.client)
+
 	return gClient, rClient, nil
 }
 
@@ -251,7 +272,7 @@ func (ghs *githubScraper) getPullRequests(
 				ghs.cfg.GitHubOrg,
 				defaultReturnItems,
 				cursor,
-				[]PullRequestState{"OPEN", "MERGED"},
+				[]PullRequestState{"OPEN", "MERGED", "CLOSED"},
 			)
 			if err != nil {
 				if !strings.Contains(err.Error(), "403") || !strings.Contains(err.Error(), "secondary") {
@@ -273,6 +294,7 @@ func (ghs *githubScraper) getPullRequests(
 				return "", err
 			}
 
+			ghs.observeRateLimit(ctx, prs.RateLimit.rateVals)
 			pullRequests = append(pullRequests, prs.Repository.PullRequests.Nodes...)
 			cursor = &prs.Repository.PullRequests.PageInfo.EndCursor
 			hasNextPage = prs.Repository.PullRequests.PageInfo.HasNextPage
@@ -288,13 +310,50 @@ func (ghs *githubScraper) getPullRequests(
 	return pullRequests, nil
 }
 
+// commitCacheKey identifies the commits of a branch which are not on the
+// default branch. They only change when the head of the branch moves or when
+// some of them are merged into the default branch.
+type commitCacheKey struct {
+	owner    string
+	repo     string
+	branch   string
+	sha      string
+	behindBy int
+}
+
+// commitEvaluation is the result of evaluating the commits of a branch. The
+// date of the oldest commit is kept rather than the age of the branch, which
+// keeps increasing.
+type commitEvaluation struct {
+	additions int
+	deletions int
+	oldest    time.Time
+}
+
 func (ghs *githubScraper) evalCommits(
 	ctx context.Context,
 	client graphql.Client,
 	repoName string,
 	branch BranchNode,
 ) (additions int, deletions int, age int64, err error) {
+	// the commits of a branch whose head did not change since a previous
+	// scrape are not retrieved again
+	var key commitCacheKey
+	if ghs.commits != nil && branch.Target != nil {
+		key = commitCacheKey{
+			owner:    ghs.cfg.GitHubOrg,
+			repo:     repoName,
+			branch:   branch.Name,
+			sha:      branch.Target.GetOid(),
+			behindBy: branch.Compare.BehindBy,
+		}
+		if e, ok := ghs.commits.Get(key); ok {
+			return e.additions, e.deletions, getBranchAge(e.oldest), nil
+		}
+	}
+
 	var cursor *string
+	var oldest time.Time
 	items := defaultReturnItems
 
 	// See https://github.com/liatrio/liatrio-otel-collector/blob/main/receiver/githubreceiver/internal/scraper/githubscraper/README.md#github-limitations
@@ -330,15 +389,28 @@ func (ghs *githubScraper) evalCommits(
 		cursor = &c.PageInfo.EndCursor
 		if page == pages {
 			node := c.GetNodes()
-			oldest := node[len(node)-1].GetCommittedDate()
-			age = int64(time.Since(oldest).Seconds())
+			oldest = node[len(node)-1].GetCommittedDate()
 		}
 		for b := 0; b < len(c.Nodes); b++ {
 			additions += c.Nodes[b].Additions
 			deletions += c.Nodes[b].Deletions
 		}
 	}
-	return additions, deletions, age, nil
+
+	if key.sha != "" {
+		ghs.commits.Add(key, commitEvaluation{additions: additions, deletions: deletions, oldest: oldest})
+	}
+
+	return additions, deletions, getBranchAge(oldest), nil
+}
+
+// getBranchAge returns the age in seconds of a branch from the date of its
+// oldest commit, or 0 when its commits are unknown.
+func getBranchAge(oldest time.Time) int64 {
+	if oldest.IsZero() {
+		return 0
+	}
+	return int64(time.Since(oldest).Seconds())
 }
 
 func (ghs *githubScraper) getCommitData(
@@ -370,6 +442,8 @@ func (ghs *githubScraper) getCommitData(
 			}
 		}
 
+		ghs.observeRateLimit(ctx, data.RateLimit.rateVals)
+
 		// This checks to ensure that the query returned a BranchHistory Node. The
 		// way the GraphQL query functions allows for a successful query to take
 		// place, but have an empty set of branches. The only time this query would
@@ -411,18 +485,122 @@ func getAge(start time.Time, end time.Time) int64 {
 	return int64(end.Sub(start).Seconds())
 }
 
+// reviewSummary is the review flow of a change (pull request), from the
+// reviews submitted by accounts other than its author.
+type reviewSummary struct {
+	// firstReview is when the first review was submitted, if any
+	firstReview time.Time
+	// firstApproval is when the first approval was submitted, if any
+	firstApproval time.Time
+	// lastApproval is when the last approval before the merge of the change,
+	// or before now for an open change, was submitted, if any
+	lastApproval time.Time
+	// rounds is the number of distinct revisions (commits) reviewed
+	rounds int
+	// reviewers is the number of distinct reviewers
+	reviewers int
+	// comments is the number of review comments
+	comments int
+}
+
+// summarizeReviews returns the review flow of a change from its first 100
+// reviews. Pending reviews, which are only visible to their author, and the
+// reviews of the author of the change are ignored. Dismissed approvals count
+// as reviews but not as approvals.
+func summarizeReviews(pr PullRequestNode) reviewSummary {
+	var summary reviewSummary
+
+	author := actorLogin(pr.Author)
+	commits := map[string]struct{}{}
+	reviewers := map[string]struct{}{}
+
+	for _, review := range pr.Reviews.Nodes {
+		if review.State == PullRequestReviewStatePending {
+			continue
+		}
+
+		login := actorLogin(review.Author)
+		if login != "" && login == author {
+			continue
+		}
+
+		submitted := review.SubmittedAt
+		if submitted.IsZero() {
+			submitted = review.CreatedAt
+		}
+
+		if summary.firstReview.IsZero() || submitted.Before(summary.firstReview) {
+			summary.firstReview = submitted
+		}
+
+		if review.State == PullRequestReviewStateApproved {
+			if summary.firstApproval.IsZero() || submitted.Before(summary.firstApproval) {
+				summary.firstApproval = submitted
+			}
+			if (!pr.Merged || !submitted.After(pr.MergedAt)) && submitted.After(summary.lastApproval) {
+				summary.lastApproval = submitted
+			}
+		}
+
+		if review.Commit.Oid != "" {
+			commits[review.Commit.Oid] = struct{}{}
+		}
+		if login != "" {
+			reviewers[login] = struct{}{}
+		}
+		summary.comments += review.Comments.TotalCount
+	}
+
+	summary.rounds = len(commits)
+	summary.reviewers = len(reviewers)
+	return summary
+}
+
+// actorLogin returns the login of an actor, or an empty string for a deleted
+// account.
+func actorLogin(actor interface{ GetLogin() string }) string {
+	if actor == nil {
+		return ""
+	}
+	return actor.GetLogin()
+}
+
+// getCVEs returns the open Dependabot and Code Scanning alerts of a
+// repository, which are shared by the CVE count and age.
 func (ghs *githubScraper) getCVEs(
 	ctx context.Context,
 	gClient graphql.Client,
 	rClient *github.Client,
 	repo string,
-) (map[metadata.AttributeCveSeverity]int64, error) {
+) ([]CVENode, []*github.Alert) {
 	d := ghs.getDepBotAlerts(ctx, gClient, repo)
 	c := ghs.getCodeScanAlerts(ctx, rClient, repo)
 
-	return mapSeverities(d, c), nil
+	return d, c
+}
+
+// getCVETimes returns the age of the oldest of the given open CVE alerts, and
+// the average time to remediate the CVE alerts resolved since the given time,
+// of a repository.
+func (ghs *githubScraper) getCVETimes(
+	ctx context.Context,
+	rClient *github.Client,
+	repo string,
+	nodes []CVENode,
+	alerts []*github.Alert,
+	now time.Time,
+	since time.Time,
+) (map[cveKey]int64, map[cveRemediationKey]int64) {
+	d := ghs.getResolvedDepBotAlerts(ctx, rClient, repo, since)
+	c := alerts
+	for _, state := range []string{"fixed", "dismissed"} {
+		c = append(c, ghs.getResolvedCodeScanAlerts(ctx, rClient, repo, state, since)...)
+	}
+
+	return mapCVETimes(nodes, d, c, now, since)
 }
 
+// Get the open Dependabot alerts of a repository via the GraphQL API
 func (ghs *githubScraper) getDepBotAlerts(
 	ctx context.Context,
 	gClient graphql.Client,
@@ -449,6 +627,56 @@ func (ghs *githubScraper) getDepBotAlerts(
 	return alerts
 }
 
+// Get the Dependabot alerts of a repository resolved (fixed, dismissed or
+// auto-dismissed) and updated since the given time via the REST API. The
+// alerts are listed from the most recently updated, so the pages past the
+// first alert updated before the given time are not requested.
+func (ghs *githubScraper) getResolvedDepBotAlerts(
+	ctx context.Context,
+	rClient *github.Client,
+	repo string,
+	since time.Time,
+) []*github.DependabotAlert {
+	var alerts []*github.DependabotAlert
+
+	opt := &github.ListAlertsOptions{
+		ListOptions: github.ListOptions{PerPage: 50},
+		State:       github.Ptr("fixed,dismissed,auto_dismissed"),
+		Sort:        github.Ptr("updated"),
+		Direction:   github.Ptr("desc"),
+	}
+
+	for {
+		a, resp, err := rClient.Dependabot.ListRepoAlerts(ctx, ghs.cfg.GitHubOrg, repo, opt)
+		if err != nil {
+			if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 403) {
+				ghs.logger.Sugar().Debugf("%s repo does not have any dependabot alerts or does not have dependabot alerts enabled", repo)
+				break
+			}
+			ghs.logger.Sugar().Errorf("error getting dependabot alerts from repo", zap.Error(err))
+			return nil
+		}
+
+		for _, alert := range a {
+			if alert.GetUpdatedAt().Before(since) {
+				return alerts
+			}
+			alerts = append(alerts, alert)
+		}
+
+		// Dependabot alerts are paginated with a cursor, and by page number
+		// on older GitHub Enterprise Server versions.
+		if resp.NextPage == 0 && resp.After == "" {
+			break
+		}
+
+		opt.ListOptions.Page = resp.NextPage
+		opt.After = resp.After
+	}
+
+	return alerts
+}
+
 // Get the Code Scanning Alerts count for a repository via the REST API
 func (ghs *githubScraper) getCodeScanAlerts(
 	ctx context.Context,
@@ -486,24 +714,147 @@ func (ghs *githubScraper) getCodeScanAlerts(
 	return alerts
 }
 
+// Get the Code Scanning Alerts of a repository in the given resolved state
+// (fixed or dismissed) updated since the given time via the REST API. The
+// alerts are listed from the most recently updated, so the pages past the
+// first alert updated before the given time are not requested.
+func (ghs *githubScraper) getResolvedCodeScanAlerts(
+	ctx context.Context,
+	rClient *github.Client,
+	repo string,
+	state string,
+	since time.Time,
+) []*github.Alert {
+	var alerts []*github.Alert
+
+	opt := &github.AlertListOptions{
+		ListOptions: github.ListOptions{PerPage: 50},
+		State:       state,
+		Sort:        "updated",
+		Direction:   "desc",
+	}
+
+	for {
+		a, resp, err := rClient.CodeScanning.ListAlertsForRepo(ctx, ghs.cfg.GitHubOrg, repo, opt)
+		if err != nil {
+			if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 403) {
+				ghs.logger.Sugar().Debugf("%s repo does not have any alerts or does not have alerts enabled", repo)
+				break
+			}
+			ghs.logger.Sugar().Errorf("error getting code scanning alerts from repo", zap.Error(err))
+			return nil
+		}
+
+		for _, alert := range a {
+			if alert.GetUpdatedAt().Before(since) {
+				return alerts
+			}
+			alerts = append(alerts, alert)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.ListOptions.Page = resp.NextPage
+	}
+
+	return alerts
+}
+
+// Get the secret scanning alerts of a repository via the REST API, in any
+// state so that the secrets which bypassed push protection are counted
+// after their alert is resolved.
+func (ghs *githubScraper) getSecretScanAlerts(
+	ctx context.Context,
+	rClient *github.Client,
+	repo string,
+) []*github.SecretScanningAlert {
+	var alerts []*github.SecretScanningAlert
+
+	opt := &github.SecretScanningAlertListOptions{
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		a, resp, err := rClient.SecretScanning.ListAlertsForRepo(ctx, ghs.cfg.GitHubOrg, repo, opt)
+		if err != nil {
+			if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 403) {
+				ghs.logger.Sugar().Debugf("%s repo does not have any secret scanning alerts or does not have secret scanning enabled", repo)
+				break
+			}
+			ghs.logger.Sugar().Errorf("error getting secret scanning alerts from repo", zap.Error(err))
+			return nil
+		}
+
+		alerts = append(alerts, a...)
+
+		// GitHub Enterprise Cloud paginates with a cursor, GitHub
+		// Enterprise Server by page number.
+		if resp.NextPage == 0 && resp.After == "" {
+			break
+		}
+
+		opt.ListOptions.Page = resp.NextPage
+		opt.After = resp.After
+	}
+
+	return alerts
+}
+
+// secretKey identifies the open secret scanning alerts counted together.
+type secretKey struct {
+	secretType string
+	validity   metadata.AttributeSecretValidity
+}
+
+// mapSecrets counts the open secret scanning alerts by secret type and
+// validity, and the alerts in any state whose secret bypassed push
+// protection by secret type. Secrets whose validity is not checked are of
+// unknown validity.
+func mapSecrets(
+	alerts []*github.SecretScanningAlert,
+) (map[secretKey]int64, map[string]int64) {
+	secrets := make(map[secretKey]int64)
+	bypasses := make(map[string]int64)
+
+	for _, alert := range alerts {
+		if alert.GetPushProtectionBypassed() {
+			bypasses[alert.GetSecretType()]++
+		}
+
+		if !strings.EqualFold(alert.GetState(), "open") {
+			continue
+		}
+
+		validity, found := metadata.MapAttributeSecretValidity[strings.ToLower(alert.GetValidity())]
+		if !found {
+			validity = metadata.AttributeSecretValidityUnknown
+		}
+		secrets[secretKey{secretType: alert.GetSecretType(), validity: validity}]++
+	}
+
+	return secrets, bypasses
+}
+
+// cveSeverities allows us to map the "MODERATE" to the conventional "medium"
+// and support the capital cased values that are returned from GitHub's API.
+var cveSeverities = map[string]metadata.AttributeCveSeverity{
+	"CRITICAL": metadata.AttributeCveSeverityCritical,
+	"HIGH":     metadata.AttributeCveSeverityHigh,
+	"MODERATE": metadata.AttributeCveSeverityMedium,
+	"MEDIUM":   metadata.AttributeCveSeverityMedium,
+	"LOW":      metadata.AttributeCveSeverityLow,
+}
+
 func mapSeverities(
 	nodes []CVENode,
 	alerts []*github.Alert,
 ) map[metadata.AttributeCveSeverity]int64 {
-
-	// Allows us to map the "MODERATE" to the conventional "medium" and support
-	// the capital cased values that are returned from GitHub's API.
-	mapping := map[string]metadata.AttributeCveSeverity{
-		"CRITICAL": metadata.AttributeCveSeverityCritical,
-		"HIGH":     metadata.AttributeCveSeverityHigh,
-		"MODERATE": metadata.AttributeCveSeverityMedium,
-		"MEDIUM":   metadata.AttributeCveSeverityMedium,
-		"LOW":      metadata.AttributeCveSeverityLow,
-	}
 	m := make(map[metadata.AttributeCveSeverity]int64)
 
 	for _, node := range nodes {
-		if val, found := mapping[strings.ToUpper(string(node.SecurityVulnerability.Severity))]; found {
+		if val, found := cveSeverities[strings.ToUpper(string(node.SecurityVulnerability.Severity))]; found {
 			m[val]++
 		}
 	}
@@ -512,10 +863,156 @@ func mapSeverities(
 		if alert.Rule == nil || alert.Rule.SecuritySeverityLevel == nil {
 			continue
 		}
-		if val, found := mapping[strings.ToUpper(*alert.Rule.SecuritySeverityLevel)]; found {
+		if val, found := cveSeverities[strings.ToUpper(*alert.Rule.SecuritySeverityLevel)]; found {
 			m[val]++
 		}
 	}
 
 	return m
 }
+
+// cveKey groups the CVE alerts of a repository by severity, by the tool which
+// raised them and by the ecosystem of the affected package.
+type cveKey struct {
+	severity  metadata.AttributeCveSeverity
+	source    metadata.AttributeCveSource
+	ecosystem string
+}
+
+// cveRemediationKey groups the resolved CVE alerts of a repository by how
+// they were resolved.
+type cveRemediationKey struct {
+	cveKey
+	resolution metadata.AttributeCveResolution
+}
+
+// cveAlert is when a CVE alert was created and, once resolved, when and how.
+type cveAlert struct {
+	key        cveKey
+	createdAt  time.Time
+	resolved   bool
+	resolvedAt time.Time
+	resolution metadata.AttributeCveResolution
+}
+
+// mapCVETimes returns the age in seconds of the oldest open alert, and the
+// average time in seconds from creation to resolution of the alerts resolved
+// since the given time, of the Dependabot and Code Scanning alerts of a
+// repository. The nodes are the open Dependabot alerts, and depBotAlerts the
+// resolved ones. Auto-dismissed Dependabot alerts count as dismissed.
+func mapCVETimes(
+	nodes []CVENode,
+	depBotAlerts []*github.DependabotAlert,
+	alerts []*github.Alert,
+	now time.Time,
+	since time.Time,
+) (map[cveKey]int64, map[cveRemediationKey]int64) {
+	var all []cveAlert
+
+	for _, node := range nodes {
+		severity, found := cveSeverities[strings.ToUpper(string(node.SecurityVulnerability.Severity))]
+		if !found {
+			continue
+		}
+
+		a := cveAlert{
+			key: cveKey{
+				severity:  severity,
+				source:    metadata.AttributeCveSourceDependabot,
+				ecosystem: strings.ToLower(string(node.SecurityVulnerability.Package.Ecosystem)),
+			},
+			createdAt: node.CreatedAt,
+		}
+
+		all = append(all, a)
+	}
+
+	for _, alert := range depBotAlerts {
+		vulnerability := alert.GetSecurityVulnerability()
+		severity, found := cveSeverities[strings.ToUpper(vulnerability.GetSeverity())]
+		if !found {
+			continue
+		}
+
+		a := cveAlert{
+			key: cveKey{
+				severity:  severity,
+				source:    metadata.AttributeCveSourceDependabot,
+				ecosystem: strings.ToLower(vulnerability.GetPackage().GetEcosystem()),
+			},
+			createdAt: alert.GetCreatedAt().Time,
+			resolved:  true,
+		}
+
+		switch strings.ToLower(alert.GetState()) {
+		case "fixed":
+			a.resolvedAt, a.resolution = alert.GetFixedAt().Time, metadata.AttributeCveResolutionFixed
+		case "dismissed":
+			a.resolvedAt, a.resolution = alert.GetDismissedAt().Time, metadata.AttributeCveResolutionDismissed
+		case "auto_dismissed":
+			a.resolvedAt, a.resolution = alert.GetAutoDismissedAt().Time, metadata.AttributeCveResolutionDismissed
+		default:
+			continue
+		}
+
+		all = append(all, a)
+	}
+
+	for _, alert := range alerts {
+		if alert.Rule == nil || alert.Rule.SecuritySeverityLevel == nil {
+			continue
+		}
+		severity, found := cveSeverities[strings.ToUpper(*alert.Rule.SecuritySeverityLevel)]
+		if !found {
+			continue
+		}
+
+		a := cveAlert{
+			key: cveKey{
+				severity: severity,
+				source:   metadata.AttributeCveSourceCodeScanning,
+			},
+			createdAt: alert.GetCreatedAt().Time,
+		}
+
+		switch strings.ToLower(alert.GetState()) {
+		case "open":
+		case "fixed":
+			a.resolved, a.resolvedAt, a.resolution = true, alert.GetFixedAt().Time, metadata.AttributeCveResolutionFixed
+		case "dismissed":
+			a.resolved, a.resolvedAt, a.resolution = true, alert.GetDismissedAt().Time, metadata.AttributeCveResolutionDismissed
+		default:
+			continue
+		}
+
+		all = append(all, a)
+	}
+
+	ages := make(map[cveKey]int64)
+	totals := make(map[cveRemediationKey]int64)
+	counts := make(map[cveRemediationKey]int64)
+	for _, a := range all {
+		if !a.resolved {
+			age := getAge(a.createdAt, now)
+			if oldest, ok := ages[a.key]; !ok || age > oldest {
+				ages[a.key] = age
+			}
+			continue
+		}
+
+		if a.resolvedAt.IsZero() || a.resolvedAt.Before(since) {
+			continue
+		}
+
+		k := cveRemediationKey{cveKey: a.key, resolution: a.resolution}
+		totals[k] += getAge(a.createdAt, a.resolvedAt)
+		counts[k]++
+	}
+
+	remediations := make(map[cveRemediationKey]int64)
+	for k, total := range totals {
+		remediations[k] = total / counts[k]
+	}
+
+	return ages, remediations
+}
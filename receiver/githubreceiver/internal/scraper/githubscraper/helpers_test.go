@@ -10,11 +10,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/google/go-github/v89/github"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/receiver/receivertest"
 )
 
@@ -401,6 +403,108 @@ func TestGetAge(t *testing.T) {
 	}
 }
 
+func TestSummarizeReviews(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time { return created.Add(time.Duration(hours) * time.Hour) }
+	review := func(login string, state PullRequestReviewState, hours int, oid string, comments int) PullRequestReviewNode {
+		r := PullRequestReviewNode{
+			State:       state,
+			CreatedAt:   at(hours),
+			SubmittedAt: at(hours),
+			Commit:      PullRequestReviewNodeCommit{Oid: oid},
+		}
+		r.Comments.TotalCount = comments
+		if login != "" {
+			r.Author = &PullRequestReviewNodeAuthorUser{Login: login}
+		}
+		return r
+	}
+
+	testCases := []struct {
+		desc     string
+		pr       PullRequestNode
+		expected reviewSummary
+	}{
+		{
+			desc:     "no reviews",
+			pr:       PullRequestNode{CreatedAt: created},
+			expected: reviewSummary{},
+		},
+		{
+			desc: "comment before approval",
+			pr: PullRequestNode{
+				CreatedAt: created,
+				Author:    &PullRequestNodeAuthorUser{Login: "author"},
+				Reviews: PullRequestNodeReviewsPullRequestReviewConnection{
+					Nodes: []PullRequestReviewNode{
+						review("alice", PullRequestReviewStateCommented, 2, "a", 3),
+						review("bob", PullRequestReviewStateApproved, 5, "a", 0),
+					},
+				},
+			},
+			expected: reviewSummary{
+				firstReview:   at(2),
+				firstApproval: at(5),
+				lastApproval:  at(5),
+				rounds:        1,
+				reviewers:     2,
+				comments:      3,
+			},
+		},
+		{
+			desc: "several rounds before merge",
+			pr: PullRequestNode{
+				CreatedAt: created,
+				Merged:    true,
+				MergedAt:  at(10),
+				Author:    &PullRequestNodeAuthorUser{Login: "author"},
+				Reviews: PullRequestNodeReviewsPullRequestReviewConnection{
+					Nodes: []PullRequestReviewNode{
+						review("alice", PullRequestReviewStateChangesRequested, 1, "a", 2),
+						review("author", PullRequestReviewStateCommented, 2, "b", 1),
+						review("alice", PullRequestReviewStateDismissed, 3, "b", 0),
+						review("alice", PullRequestReviewStateApproved, 4, "c", 1),
+						review("bob", PullRequestReviewStateApproved, 8, "c", 0),
+						review("carol", PullRequestReviewStateApproved, 12, "c", 0),
+						review("dave", PullRequestReviewStatePending, 0, "c", 4),
+					},
+				},
+			},
+			expected: reviewSummary{
+				firstReview:   at(1),
+				firstApproval: at(4),
+				lastApproval:  at(8),
+				rounds:        3,
+				reviewers:     3,
+				comments:      3,
+			},
+		},
+		{
+			desc: "deleted accounts",
+			pr: PullRequestNode{
+				CreatedAt: created,
+				Reviews: PullRequestNodeReviewsPullRequestReviewConnection{
+					Nodes: []PullRequestReviewNode{
+						review("", PullRequestReviewStateApproved, 6, "a", 0),
+					},
+				},
+			},
+			expected: reviewSummary{
+				firstReview:   at(6),
+				firstApproval: at(6),
+				lastApproval:  at(6),
+				rounds:        1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, summarizeReviews(tc.pr))
+		})
+	}
+}
+
 func TestGetSearchRepos(t *testing.T) {
 	testCases := []struct {
 		desc                    string
@@ -657,6 +761,22 @@ func TestCheckOwnerExists(t *testing.T) {
 			}),
 			expectedOwnerType: "user",
 		},
+		{
+			desc:  "TestOwnerNotFound",
+			login: "missing",
+			server: MockServer(&responses{
+				checkLoginResponse: loginResponse{
+					checkLogin: checkLoginResponse{
+						Organization: checkLoginOrganization{
+							Login: "liatrio",
+						},
+					},
+					responseCode: http.StatusOK,
+				},
+			}),
+			expectedOwnerType: "",
+			expectedError:     true,
+		},
 		{
 			desc:  "TestLoginError",
 			login: "liatrio",
@@ -687,7 +807,9 @@ func TestCheckOwnerExists(t *testing.T) {
 			loginType, err := ghs.login(context.Background(), client, tc.login)
 
 			assert.Equal(t, tc.expectedOwnerType, loginType)
-			if !tc.expectedError {
+			if tc.expectedError {
+				assert.Error(t, err)
+			} else {
 				assert.NoError(t, err)
 			}
 		})
@@ -1242,7 +1364,6 @@ func TestGetCVEs(t *testing.T) {
 		server           *http.ServeMux
 		repo             string
 		org              string
-		expectedErr      error
 		expectedCVECount int64
 		expectedMap      map[metadata.AttributeCveSeverity]int64
 	}{
@@ -1276,7 +1397,6 @@ func TestGetCVEs(t *testing.T) {
 					responseCode: http.StatusOK,
 				},
 			}),
-			expectedErr:      nil,
 			expectedCVECount: 2,
 			expectedMap: map[metadata.AttributeCveSeverity]int64{
 				metadata.AttributeCveSeverityHigh:   1,
@@ -1333,7 +1453,6 @@ func TestGetCVEs(t *testing.T) {
 					responseCode: http.StatusOK,
 				},
 			}),
-			expectedErr:      nil,
 			expectedCVECount: 4,
 			expectedMap: map[metadata.AttributeCveSeverity]int64{
 				metadata.AttributeCveSeverityHigh:   2,
@@ -1372,7 +1491,6 @@ func TestGetCVEs(t *testing.T) {
 			repo:             "r",
 			org:              "o",
 			expectedCVECount: 1,
-			expectedErr:      nil,
 		},
 		{
 			desc: "TestMultiPageRespCodeScanningAlert",
@@ -1419,7 +1537,6 @@ func TestGetCVEs(t *testing.T) {
 			repo:             "r",
 			org:              "o",
 			expectedCVECount: 3,
-			expectedErr:      nil,
 		},
 		{
 			desc: "TestSinglePageDepBotAndCodeScanningAlert",
@@ -1474,7 +1591,6 @@ func TestGetCVEs(t *testing.T) {
 			repo:             "r",
 			org:              "o",
 			expectedCVECount: 4,
-			expectedErr:      nil,
 		},
 		{
 			desc: "TestMultiPageDepBotAndCodeScanningAlert",
@@ -1563,7 +1679,6 @@ func TestGetCVEs(t *testing.T) {
 			repo:             "r",
 			org:              "o",
 			expectedCVECount: 8,
-			expectedErr:      nil,
 		},
 		{
 			desc: "TestEmptyInputDepBotAndCodeScanningAlert",
@@ -1587,7 +1702,6 @@ func TestGetCVEs(t *testing.T) {
 			repo:             "r",
 			org:              "o",
 			expectedCVECount: 0,
-			expectedErr:      nil,
 		},
 	}
 	for _, tc := range testCases {
@@ -1604,7 +1718,7 @@ func TestGetCVEs(t *testing.T) {
 			rClient, err := github.NewClient(github.WithEnterpriseURLs(server.URL, server.URL))
 			assert.NoError(t, err)
 
-			cves, err := ghs.getCVEs(context.Background(), gClient, rClient, tc.repo)
+			cves := mapSeverities(ghs.getCVEs(context.Background(), gClient, rClient, tc.repo))
 			totalCVEs := int64(0)
 
 			for _, sevCount := range cves {
@@ -1612,12 +1726,6 @@ func TestGetCVEs(t *testing.T) {
 			}
 
 			assert.Equal(t, tc.expectedCVECount, totalCVEs)
-
-			if tc.expectedErr == nil {
-				assert.NoError(t, err)
-			} else {
-				assert.EqualError(t, err, tc.expectedErr.Error())
-			}
 		})
 	}
 }
@@ -1727,6 +1835,69 @@ func TestMapSeveritiesCodeScanAlerts(t *testing.T) {
 	}
 }
 
+func TestEvalCommitsCache(t *testing.T) {
+	commit := func(additions int) BranchHistoryTargetCommit {
+		return BranchHistoryTargetCommit{
+			History: BranchHistoryTargetCommitHistoryCommitHistoryConnection{
+				Nodes: []CommitNode{
+					{
+						CommittedDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+						Additions:     additions,
+						Deletions:     1,
+					},
+				},
+			},
+		}
+	}
+
+	mock := MockServer(&responses{
+		commitResponse: commitResponse{
+			commits:      []BranchHistoryTargetCommit{commit(10), commit(20)},
+			responseCode: http.StatusOK,
+		},
+	})
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		mock.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	cfg := (&Factory{}).CreateDefaultConfig().(*Config)
+	cfg.Endpoint = server.URL
+
+	ghs := newGitHubScraper(receivertest.NewNopSettings(metadata.Type), cfg)
+	require.NoError(t, ghs.start(context.Background(), nil))
+
+	client := graphql.NewClient(server.URL, ghs.client)
+	branch := BranchNode{
+		Name:    "branch1",
+		Target:  &BranchNodeTargetCommit{Oid: "a1b2c3"},
+		Compare: BranchNodeCompareComparison{BehindBy: 1},
+	}
+
+	adds, _, age, err := ghs.evalCommits(context.Background(), client, "repo1", branch)
+	require.NoError(t, err)
+	assert.Equal(t, 10, adds)
+	assert.Equal(t, 1, requests)
+
+	// the commits of a branch whose head did not change are not retrieved
+	// again, while its age keeps increasing
+	adds, _, cachedAge, err := ghs.evalCommits(context.Background(), client, "repo1", branch)
+	require.NoError(t, err)
+	assert.Equal(t, 10, adds)
+	assert.GreaterOrEqual(t, cachedAge, age)
+	assert.Equal(t, 1, requests)
+
+	// a new commit on the branch is retrieved
+	branch.Target = &BranchNodeTargetCommit{Oid: "d4e5f6"}
+	adds, _, _, err = ghs.evalCommits(context.Background(), client, "repo1", branch)
+	require.NoError(t, err)
+	assert.Equal(t, 20, adds)
+	assert.Equal(t, 2, requests)
+}
+
 func TestCreateClients(t *testing.T) {
 	factory := Factory{}
 	settings := receivertest.NewNopSettings(metadata.Type)
@@ -1748,4 +1919,416 @@ func TestCreateClients(t *testing.T) {
 		_, _, err := ghs.createClients()
 		assert.Error(t, err)
 	})
+	t.Run("uses the configured server", func(t *testing.T) {
+		ghs := newGitHubScraper(settings, factory.CreateDefaultConfig().(*Config))
+		ghs.client = &http.Client{}
+
+		_, rClient, err := ghs.createClients()
+		require.NoError(t, err)
+		assert.Equal(t, "https://api.github.com/", rClient.BaseURL())
+
+		ghs.cfg.Server = internal.ServerConfig{APIURL: "https://ghes.example.com/api/v3", WebURL: "https://ghes.example.com"}
+		_, rClient, err = ghs.createClients()
+		require.NoError(t, err)
+		assert.Equal(t, "https://ghes.example.com/api/v3/", rClient.BaseURL())
+	})
+}
+
+func TestGetSecretScanAlerts(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		statusCode    int
+		pages         [][]*github.SecretScanningAlert
+		expectedCount int
+	}{
+		{
+			desc:       "single page",
+			statusCode: http.StatusOK,
+			pages: [][]*github.SecretScanningAlert{
+				{{Number: github.Ptr(1)}, {Number: github.Ptr(2)}},
+			},
+			expectedCount: 2,
+		},
+		{
+			desc:       "multiple pages",
+			statusCode: http.StatusOK,
+			pages: [][]*github.SecretScanningAlert{
+				{{Number: github.Ptr(1)}},
+				{{Number: github.Ptr(2)}},
+				{{Number: github.Ptr(3)}},
+			},
+			expectedCount: 3,
+		},
+		{
+			// secret scanning is disabled for the repository
+			desc:       "404 not found",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			// the credentials cannot read secret scanning alerts
+			desc:       "403 forbidden",
+			statusCode: http.StatusForbidden,
+		},
+		{
+			desc:       "server error",
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/repos/o/r/secret-scanning/alerts", r.URL.Path)
+				if tc.statusCode != http.StatusOK {
+					w.WriteHeader(tc.statusCode)
+					return
+				}
+
+				if page < len(tc.pages)-1 {
+					w.Header().Set("Link", fmt.Sprintf("<%s?page=%d>; rel=\"next\"", r.URL.Path, page+2))
+				}
+				assert.NoError(t, json.NewEncoder(w).Encode(tc.pages[page]))
+				page++
+			}))
+			defer server.Close()
+
+			factory := Factory{}
+			defaultConfig := factory.CreateDefaultConfig()
+			settings := receivertest.NewNopSettings(metadata.Type)
+			ghs := newGitHubScraper(settings, defaultConfig.(*Config))
+			ghs.cfg.GitHubOrg = "o"
+
+			rClient, err := github.NewClient(github.WithEnterpriseURLs(server.URL, server.URL))
+			assert.NoError(t, err)
+
+			alerts := ghs.getSecretScanAlerts(context.Background(), rClient, "r")
+			assert.Len(t, alerts, tc.expectedCount)
+		})
+	}
+}
+
+func TestMapSecrets(t *testing.T) {
+	alert := func(state, secretType, validity string, bypassed bool) *github.SecretScanningAlert {
+		a := &github.SecretScanningAlert{
+			State:                  github.Ptr(state),
+			SecretType:             github.Ptr(secretType),
+			PushProtectionBypassed: github.Ptr(bypassed),
+		}
+		if validity != "" {
+			a.Validity = github.Ptr(validity)
+		}
+		return a
+	}
+
+	secrets, bypasses := mapSecrets([]*github.SecretScanningAlert{
+		alert("open", "github_personal_access_token", "active", true),
+		alert("open", "github_personal_access_token", "active", false),
+		alert("open", "github_personal_access_token", "inactive", false),
+		// validity checks are not enabled
+		alert("open", "slack_api_token", "", false),
+		// resolved alerts are only counted when they bypassed push protection
+		alert("resolved", "aws_access_key_id", "inactive", true),
+		alert("resolved", "aws_access_key_id", "active", false),
+	})
+
+	assert.Equal(t, map[secretKey]int64{
+		{secretType: "github_personal_access_token", validity: metadata.AttributeSecretValidityActive}:   2,
+		{secretType: "github_personal_access_token", validity: metadata.AttributeSecretValidityInactive}: 1,
+		{secretType: "slack_api_token", validity: metadata.AttributeSecretValidityUnknown}:               1,
+	}, secrets)
+	assert.Equal(t, map[string]int64{
+		"github_personal_access_token": 1,
+		"aws_access_key_id":            1,
+	}, bypasses)
+
+	secrets, bypasses = mapSecrets(nil)
+	assert.Empty(t, secrets)
+	assert.Empty(t, bypasses)
+}
+
+func TestGetResolvedCodeScanAlerts(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := &github.Timestamp{Time: since.Add(time.Hour)}
+	stale := &github.Timestamp{Time: since.Add(-time.Hour)}
+
+	testCases := []struct {
+		desc          string
+		statusCode    int
+		pages         [][]*github.Alert
+		expectedCount int
+		expectedPages int
+	}{
+		{
+			desc:       "multiple pages",
+			statusCode: http.StatusOK,
+			pages: [][]*github.Alert{
+				{{Number: github.Ptr(1), UpdatedAt: recent}},
+				{{Number: github.Ptr(2), UpdatedAt: recent}},
+			},
+			expectedCount: 2,
+			expectedPages: 2,
+		},
+		{
+			desc:       "stops at the first alert updated before the lookback",
+			statusCode: http.StatusOK,
+			pages: [][]*github.Alert{
+				{{Number: github.Ptr(1), UpdatedAt: recent}, {Number: github.Ptr(2), UpdatedAt: stale}},
+				{{Number: github.Ptr(3), UpdatedAt: stale}},
+			},
+			expectedCount: 1,
+			expectedPages: 1,
+		},
+		{
+			desc:          "404 not found",
+			statusCode:    http.StatusNotFound,
+			expectedPages: 1,
+		},
+		{
+			desc:          "server error",
+			statusCode:    http.StatusInternalServerError,
+			expectedPages: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/repos/o/r/code-scanning/alerts", r.URL.Path)
+				assert.Equal(t, "fixed", r.URL.Query().Get("state"))
+				assert.Equal(t, "updated", r.URL.Query().Get("sort"))
+				page++
+				if tc.statusCode != http.StatusOK {
+					w.WriteHeader(tc.statusCode)
+					return
+				}
+
+				if page < len(tc.pages) {
+					w.Header().Set("Link", fmt.Sprintf("<%s?page=%d>; rel=\"next\"", r.URL.Path, page+1))
+				}
+				assert.NoError(t, json.NewEncoder(w).Encode(tc.pages[page-1]))
+			}))
+			defer server.Close()
+
+			factory := Factory{}
+			defaultConfig := factory.CreateDefaultConfig()
+			settings := receivertest.NewNopSettings(metadata.Type)
+			ghs := newGitHubScraper(settings, defaultConfig.(*Config))
+			ghs.cfg.GitHubOrg = "o"
+
+			rClient, err := github.NewClient(github.WithEnterpriseURLs(server.URL, server.URL))
+			assert.NoError(t, err)
+
+			alerts := ghs.getResolvedCodeScanAlerts(context.Background(), rClient, "r", "fixed", since)
+			assert.Len(t, alerts, tc.expectedCount)
+			assert.Equal(t, tc.expectedPages, page)
+		})
+	}
+}
+
+func TestGetResolvedDepBotAlerts(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := &github.Timestamp{Time: since.Add(time.Hour)}
+	stale := &github.Timestamp{Time: since.Add(-time.Hour)}
+
+	testCases := []struct {
+		desc          string
+		statusCode    int
+		pages         [][]*github.DependabotAlert
+		expectedCount int
+		expectedPages int
+	}{
+		{
+			desc:       "multiple pages",
+			statusCode: http.StatusOK,
+			pages: [][]*github.DependabotAlert{
+				{{Number: github.Ptr(1), UpdatedAt: recent}},
+				{{Number: github.Ptr(2), UpdatedAt: recent}},
+			},
+			expectedCount: 2,
+			expectedPages: 2,
+		},
+		{
+			desc:       "stops at the first alert updated before the lookback",
+			statusCode: http.StatusOK,
+			pages: [][]*github.DependabotAlert{
+				{{Number: github.Ptr(1), UpdatedAt: recent}, {Number: github.Ptr(2), UpdatedAt: stale}},
+				{{Number: github.Ptr(3), UpdatedAt: stale}},
+			},
+			expectedCount: 1,
+			expectedPages: 1,
+		},
+		{
+			desc:          "403 forbidden",
+			statusCode:    http.StatusForbidden,
+			expectedPages: 1,
+		},
+		{
+			desc:          "server error",
+			statusCode:    http.StatusInternalServerError,
+			expectedPages: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			page := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/repos/o/r/dependabot/alerts", r.URL.Path)
+				assert.Equal(t, "fixed,dismissed,auto_dismissed", r.URL.Query().Get("state"))
+				assert.Equal(t, "updated", r.URL.Query().Get("sort"))
+				assert.Equal(t, "desc", r.URL.Query().Get("direction"))
+				page++
+				if tc.statusCode != http.StatusOK {
+					w.WriteHeader(tc.statusCode)
+					return
+				}
+
+				if page < len(tc.pages) {
+					w.Header().Set("Link", fmt.Sprintf("<%s?after=cursor%d>; rel=\"next\"", r.URL.Path, page))
+				}
+				assert.NoError(t, json.NewEncoder(w).Encode(tc.pages[page-1]))
+			}))
+			defer server.Close()
+
+			factory := Factory{}
+			defaultConfig := factory.CreateDefaultConfig()
+			settings := receivertest.NewNopSettings(metadata.Type)
+			ghs := newGitHubScraper(settings, defaultConfig.(*Config))
+			ghs.cfg.GitHubOrg = "o"
+
+			rClient, err := github.NewClient(github.WithEnterpriseURLs(server.URL, server.URL))
+			assert.NoError(t, err)
+
+			alerts := ghs.getResolvedDepBotAlerts(context.Background(), rClient, "r", since)
+			assert.Len(t, alerts, tc.expectedCount)
+			assert.Equal(t, tc.expectedPages, page)
+		})
+	}
+}
+
+func TestMapCVETimes(t *testing.T) {
+	now := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	since := now.AddDate(0, 0, -30)
+	day := 24 * time.Hour
+
+	dependabot := func(severity SecurityAdvisorySeverity, ecosystem SecurityAdvisoryEcosystem, createdAt time.Time) CVENode {
+		node := CVENode{CreatedAt: createdAt}
+		node.SecurityVulnerability.Severity = severity
+		node.SecurityVulnerability.Package.Ecosystem = ecosystem
+		return node
+	}
+	resolvedDependabot := func(severity string, state string, createdAt time.Time, resolvedAt time.Time) *github.DependabotAlert {
+		alert := &github.DependabotAlert{
+			State:     github.Ptr(state),
+			CreatedAt: &github.Timestamp{Time: createdAt},
+			SecurityVulnerability: &github.AdvisoryVulnerability{
+				Severity: github.Ptr(severity),
+				Package:  &github.VulnerabilityPackage{Ecosystem: github.Ptr("npm")},
+			},
+		}
+		switch state {
+		case "fixed":
+			alert.FixedAt = &github.Timestamp{Time: resolvedAt}
+		case "dismissed":
+			alert.DismissedAt = &github.Timestamp{Time: resolvedAt}
+		case "auto_dismissed":
+			alert.AutoDismissedAt = &github.Timestamp{Time: resolvedAt}
+		}
+		return alert
+	}
+	npmCritical := cveKey{
+		severity:  metadata.AttributeCveSeverityCritical,
+		source:    metadata.AttributeCveSourceDependabot,
+		ecosystem: "npm",
+	}
+	codeScanHigh := cveKey{
+		severity: metadata.AttributeCveSeverityHigh,
+		source:   metadata.AttributeCveSourceCodeScanning,
+	}
+
+	testCases := []struct {
+		desc                 string
+		nodes                []CVENode
+		depBotAlerts         []*github.DependabotAlert
+		alerts               []*github.Alert
+		expectedAges         map[cveKey]int64
+		expectedRemediations map[cveRemediationKey]int64
+	}{
+		{
+			desc:                 "no alerts",
+			expectedAges:         map[cveKey]int64{},
+			expectedRemediations: map[cveRemediationKey]int64{},
+		},
+		{
+			desc: "oldest open alert by severity, source and ecosystem",
+			nodes: []CVENode{
+				dependabot(SecurityAdvisorySeverityCritical, SecurityAdvisoryEcosystemNpm, now.Add(-10*day)),
+				dependabot(SecurityAdvisorySeverityCritical, SecurityAdvisoryEcosystemNpm, now.Add(-3*day)),
+				dependabot(SecurityAdvisorySeverityModerate, SecurityAdvisoryEcosystemGo, now.Add(-day)),
+			},
+			alerts: []*github.Alert{
+				{
+					State:     github.Ptr("open"),
+					CreatedAt: &github.Timestamp{Time: now.Add(-5 * day)},
+					Rule:      &github.Rule{SecuritySeverityLevel: github.Ptr("high")},
+				},
+			},
+			expectedAges: map[cveKey]int64{
+				npmCritical:  int64((10 * day).Seconds()),
+				codeScanHigh: int64((5 * day).Seconds()),
+				{
+					severity:  metadata.AttributeCveSeverityMedium,
+					source:    metadata.AttributeCveSourceDependabot,
+					ecosystem: "go",
+				}: int64(day.Seconds()),
+			},
+			expectedRemediations: map[cveRemediationKey]int64{},
+		},
+		{
+			desc: "average time to remediate over the lookback window",
+			depBotAlerts: []*github.DependabotAlert{
+				resolvedDependabot("critical", "fixed", now.Add(-10*day), now.Add(-8*day)),
+				resolvedDependabot("critical", "fixed", now.Add(-10*day), now.Add(-6*day)),
+				resolvedDependabot("critical", "auto_dismissed", now.Add(-10*day), now.Add(-9*day)),
+				// fixed before the lookback window
+				resolvedDependabot("critical", "fixed", now.Add(-90*day), now.Add(-60*day)),
+			},
+			alerts: []*github.Alert{
+				{
+					State:       github.Ptr("dismissed"),
+					CreatedAt:   &github.Timestamp{Time: now.Add(-5 * day)},
+					DismissedAt: &github.Timestamp{Time: now.Add(-2 * day)},
+					Rule:        &github.Rule{SecuritySeverityLevel: github.Ptr("high")},
+				},
+			},
+			expectedAges: map[cveKey]int64{},
+			expectedRemediations: map[cveRemediationKey]int64{
+				{cveKey: npmCritical, resolution: metadata.AttributeCveResolutionFixed}:      int64((3 * day).Seconds()),
+				{cveKey: npmCritical, resolution: metadata.AttributeCveResolutionDismissed}:  int64(day.Seconds()),
+				{cveKey: codeScanHigh, resolution: metadata.AttributeCveResolutionDismissed}: int64((3 * day).Seconds()),
+			},
+		},
+		{
+			desc: "alerts without a known severity or resolution time are skipped",
+			nodes: []CVENode{
+				dependabot("", SecurityAdvisoryEcosystemNpm, now.Add(-day)),
+			},
+			depBotAlerts: []*github.DependabotAlert{
+				resolvedDependabot("", "fixed", now.Add(-day), now),
+				{State: github.Ptr("fixed"), SecurityVulnerability: &github.AdvisoryVulnerability{Severity: github.Ptr("low")}},
+			},
+			alerts: []*github.Alert{
+				{Rule: nil},
+				{State: github.Ptr("fixed"), Rule: &github.Rule{SecuritySeverityLevel: nil}},
+			},
+			expectedAges:         map[cveKey]int64{},
+			expectedRemediations: map[cveRemediationKey]int64{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ages, remediations := mapCVETimes(tc.nodes, tc.depBotAlerts, tc.alerts, now, since)
+			assert.Equal(t, tc.expectedAges, ages)
+			assert.Equal(t, tc.expectedRemediations, remediations)
+		})
+	}
 }
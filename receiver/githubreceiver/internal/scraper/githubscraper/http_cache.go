@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubscraper"
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// maxCachedResponseSize is the maximum number of bytes of a response body
+// cached. Larger responses are passed through without being cached.
+const maxCachedResponseSize = 1 << 20
+
+// cachingTransport is an http.RoundTripper caching the responses of GET
+// requests which carry an ETag or a Last-Modified header. Cached responses are
+// revalidated with conditional requests, which GitHub answers with a 304 Not
+// Modified that does not count against the REST API rate limit. GraphQL
+// requests are POST requests, which are never cached.
+type cachingTransport struct {
+	next    http.RoundTripper
+	cache   *lru.Cache[string, *cachedResponse]
+	maxSize int
+}
+
+type cachedResponse struct {
+	header http.Header
+	body   []byte
+}
+
+func newCachingTransport(next http.RoundTripper, maxEntries int) (*cachingTransport, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	cache, err := lru.New[string, *cachedResponse](maxEntries)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachingTransport{next: next, cache: cache, maxSize: maxCachedResponseSize}, nil
+}
+
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.next.RoundTrip(req)
+	}
+
+	// GitHub varies its responses on the Accept header, which differs between
+	// the endpoints and the previews of the REST API.
+	key := req.Header.Get("Accept") + " " + req.URL.String()
+
+	cached, ok := t.cache.Get(key)
+	if ok {
+		req = req.Clone(req.Context())
+		if etag := cached.header.Get("ETag"); etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.header.Get("Last-Modified"); modified != "" {
+			req.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+
+		// the headers of the 304, such as the rate limit, are more recent
+		// than the ones of the cached response
+		header := cached.header.Clone()
+		for k, v := range resp.Header {
+			header[k] = v
+		}
+
+		return &http.Response{
+			Status:        "200 OK",
+			StatusCode:    http.StatusOK,
+			Proto:         resp.Proto,
+			ProtoMajor:    resp.ProtoMajor,
+			ProtoMinor:    resp.ProtoMinor,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(cached.body)),
+			ContentLength: int64(len(cached.body)),
+			Request:       req,
+		}, nil
+	}
+
+	if resp.StatusCode != http.StatusOK || (resp.Header.Get("ETag") == "" && resp.Header.Get("Last-Modified") == "") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, int64(t.maxSize)+1))
+	if err != nil {
+		_ = resp.Body.Close()
+		return nil, err
+	}
+
+	// the part of a large body already read is put back in front of the rest
+	if len(body) > t.maxSize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return resp, nil
+	}
+
+	_ = resp.Body.Close()
+	t.cache.Add(key, &cachedResponse{header: resp.Header.Clone(), body: body})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubscraper
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingTransport(t *testing.T) {
+	var conditional []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conditional = append(conditional, r.Header.Get("If-None-Match"))
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+
+		switch r.URL.Path {
+		case "/etag":
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.Header().Set("X-RateLimit-Remaining", "4998")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte("contributors"))
+		case "/large":
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(strings.Repeat("a", 100)))
+		default:
+			_, _ = w.Write([]byte("uncached"))
+		}
+	}))
+	defer server.Close()
+
+	transport, err := newCachingTransport(nil, 10)
+	require.NoError(t, err)
+	transport.maxSize = 50
+	client := &http.Client{Transport: transport}
+
+	get := func(path string) (*http.Response, string) {
+		resp, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, string(body)
+	}
+
+	_, body := get("/etag")
+	assert.Equal(t, "contributors", body)
+
+	// the cached response is revalidated and served when not modified, with
+	// the headers of the 304
+	resp, body := get("/etag")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "contributors", body)
+	assert.Equal(t, "4998", resp.Header.Get("X-RateLimit-Remaining"))
+	assert.Equal(t, []string{"", `"v1"`}, conditional)
+
+	// responses without an ETag nor a Last-Modified are not cached
+	conditional = nil
+	get("/uncached")
+	get("/uncached")
+	assert.Equal(t, []string{"", ""}, conditional)
+
+	// responses larger than the maximum size are passed through whole
+	conditional = nil
+	_, body = get("/large")
+	assert.Len(t, body, 100)
+	get("/large")
+	assert.Equal(t, []string{"", ""}, conditional)
+
+	// non-GET requests are not cached
+	resp, err = client.Post(server.URL+"/etag", "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, 1, transport.cache.Len())
+}
@@ -0,0 +1,314 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubscraper // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/scraper/githubscraper"
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+const (
+	rateLimitResourceCore    = "core"
+	rateLimitResourceSearch  = "search"
+	rateLimitResourceGraphQL = "graphql"
+
+	// rateLimitSlowdownRatio is the ratio of the rate limit under which
+	// requests are spread evenly until the rate limit resets, rather than
+	// exhausting the remaining budget in a burst.
+	rateLimitSlowdownRatio = 0.1
+
+	// secondaryRateLimitBackoff is how long a request rejected by a secondary
+	// rate limit without a Retry-After header first waits before being
+	// retried, as recommended by GitHub. The wait doubles on each retry.
+	secondaryRateLimitBackoff = time.Minute
+
+	// rateLimitBodyPeek is the number of bytes of a 403 response read to
+	// tell a rate limit apart from a permission error.
+	rateLimitBodyPeek = 4096
+)
+
+// rateLimitBudget is the state of the rate limit of a GitHub API resource.
+type rateLimitBudget struct {
+	limit     int
+	remaining int
+	reset     time.Time
+	// next is the earliest time the next request is sent while requests
+	// are slowed down
+	next time.Time
+}
+
+// rateLimiter schedules the requests of the scraper within the rate limits of
+// the GitHub APIs. The budgets of the REST, search and GraphQL resources are
+// tracked from the X-RateLimit-* response headers and the GraphQL rateLimit
+// object.
+type rateLimiter struct {
+	cfg       RateLimitConfig
+	telemetry *metadata.TelemetryBuilder
+	logger    *zap.Logger
+
+	mu      sync.Mutex
+	budgets map[string]*rateLimitBudget
+
+	// now and sleep are replaced in tests
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newRateLimiter(cfg RateLimitConfig, telemetry *metadata.TelemetryBuilder, logger *zap.Logger) *rateLimiter {
+	return &rateLimiter{
+		cfg:       cfg,
+		telemetry: telemetry,
+		logger:    logger,
+		budgets:   map[string]*rateLimitBudget{},
+		now:       time.Now,
+		sleep:     sleepContext,
+	}
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// observe records the rate limit budget of a resource.
+func (rl *rateLimiter) observe(ctx context.Context, resource string, limit int, remaining int, reset time.Time) {
+	rl.mu.Lock()
+	b, ok := rl.budgets[resource]
+	if !ok {
+		b = &rateLimitBudget{}
+		rl.budgets[resource] = b
+	}
+	b.limit = limit
+	b.remaining = remaining
+	b.reset = reset
+	rl.mu.Unlock()
+
+	rl.telemetry.ReceiverGithubScraperRateLimitRemaining.Record(
+		ctx,
+		int64(remaining),
+		metric.WithAttributes(attribute.String("resource", resource)),
+	)
+}
+
+// observeHeaders records the rate limit budget from the X-RateLimit-* headers
+// of a response, if present.
+func (rl *rateLimiter) observeHeaders(ctx context.Context, resource string, header http.Header) {
+	remaining, err := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	reset, _ := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+
+	if r := header.Get("X-RateLimit-Resource"); r != "" {
+		resource = r
+	}
+
+	rl.observe(ctx, resource, limit, remaining, time.Unix(reset, 0))
+}
+
+// delay returns how long the next request of a resource waits. Requests
+// pause until the rate limit resets once the remaining budget reaches the
+// reserve, and are spread evenly until the reset once the remaining budget
+// is low.
+func (rl *rateLimiter) delay(resource string) time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	b, ok := rl.budgets[resource]
+	if !ok {
+		return 0
+	}
+
+	now := rl.now()
+	untilReset := b.reset.Sub(now)
+	if untilReset <= 0 {
+		return 0
+	}
+
+	if b.remaining <= rl.cfg.MinRemaining {
+		return untilReset
+	}
+
+	if float64(b.remaining) >= float64(b.limit)*rateLimitSlowdownRatio {
+		return 0
+	}
+
+	// the request takes the next slot of the remaining budget, so that the
+	// concurrent requests of the scrape are spread as well
+	interval := untilReset / time.Duration(b.remaining-rl.cfg.MinRemaining)
+	next := b.next
+	if next.Before(now) {
+		next = now
+	}
+	b.next = next.Add(interval)
+	return next.Sub(now)
+}
+
+// wait waits for the next request of a resource to be sent.
+func (rl *rateLimiter) wait(ctx context.Context, resource string) error {
+	d := rl.delay(resource)
+	if d <= 0 {
+		return nil
+	}
+
+	if d > rl.cfg.MaxWait {
+		rl.logger.Debug("rate limit resets after the maximum wait, sending the request anyway",
+			zap.String("resource", resource), zap.Duration("reset", d))
+		return nil
+	}
+
+	return rl.throttle(ctx, resource, d)
+}
+
+// throttle waits for d, recording the time waited.
+func (rl *rateLimiter) throttle(ctx context.Context, resource string, d time.Duration) error {
+	rl.logger.Debug("waiting for the GitHub API rate limit", zap.String("resource", resource), zap.Duration("wait", d))
+
+	start := rl.now()
+	err := rl.sleep(ctx, d)
+	rl.telemetry.ReceiverGithubScraperThrottledTime.Add(
+		ctx,
+		rl.now().Sub(start).Seconds(),
+		metric.WithAttributes(attribute.String("resource", resource)),
+	)
+	return err
+}
+
+// retryAfter returns how long to wait before retrying a request rejected by a
+// primary or secondary rate limit, and false if the response is not a rate
+// limit rejection. The body of the response is left readable.
+func (rl *rateLimiter) retryAfter(resp *http.Response, attempt int) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusForbidden {
+		return 0, false
+	}
+
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if seconds, err := strconv.Atoi(s); err == nil {
+			return time.Duration(seconds) * time.Second, true
+		}
+	}
+
+	// the primary rate limit is exhausted until it resets
+	if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+		reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+		if err == nil {
+			return max(time.Unix(reset, 0).Sub(rl.now()), 0), true
+		}
+	}
+
+	// a 403 is only a rate limit when GitHub says so, rather than a lack of
+	// permissions
+	if resp.StatusCode == http.StatusForbidden && !isRateLimitBody(resp) {
+		return 0, false
+	}
+
+	return secondaryRateLimitBackoff << attempt, true
+}
+
+// isRateLimitBody returns true when the body of the response mentions a rate
+// limit. The part of the body read is put back in front of the rest.
+func isRateLimitBody(resp *http.Response) bool {
+	peek, _ := io.ReadAll(io.LimitReader(resp.Body, rateLimitBodyPeek))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(peek), resp.Body), resp.Body}
+
+	return strings.Contains(strings.ToLower(string(peek)), "rate limit")
+}
+
+// requestResource returns the rate limit resource a request counts against.
+func requestResource(req *http.Request) string {
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/graphql"):
+		return rateLimitResourceGraphQL
+	case strings.Contains(req.URL.Path, "/search/"):
+		return rateLimitResourceSearch
+	default:
+		return rateLimitResourceCore
+	}
+}
+
+// rateLimitTransport is an http.RoundTripper scheduling the requests within
+// the GitHub API rate limits, and retrying the requests rejected by a primary
+// or secondary rate limit.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	limiter *rateLimiter
+}
+
+func newRateLimitTransport(next http.RoundTripper, limiter *rateLimiter) *rateLimitTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &rateLimitTransport{next: next, limiter: limiter}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	resource := requestResource(req)
+
+	for attempt := 0; ; attempt++ {
+		if err := t.limiter.wait(ctx, resource); err != nil {
+			return nil, err
+		}
+
+		r := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(r)
+		if err != nil {
+			return nil, err
+		}
+		t.limiter.observeHeaders(ctx, resource, resp.Header)
+
+		wait, limited := t.limiter.retryAfter(resp, attempt)
+		if !limited {
+			return resp, nil
+		}
+
+		// a request whose body cannot be sent again is not retried
+		canRetry := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		if attempt >= t.limiter.cfg.MaxRetries || wait > t.limiter.cfg.MaxWait || !canRetry {
+			t.limiter.logger.Warn("request rejected by the GitHub API rate limit",
+				zap.String("resource", resource), zap.Int("status_code", resp.StatusCode))
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if err := t.limiter.throttle(ctx, resource, wait); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubscraper
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadatatest"
+)
+
+// newTestRateLimiter returns a rate limiter whose clock only moves forward
+// when it sleeps, recording the waits.
+func newTestRateLimiter(t *testing.T, tel *componenttest.Telemetry, cfg RateLimitConfig) (*rateLimiter, *[]time.Duration) {
+	telemetry, err := metadata.NewTelemetryBuilder(metadatatest.NewSettings(tel).TelemetrySettings)
+	require.NoError(t, err)
+
+	rl := newRateLimiter(cfg, telemetry, zap.NewNop())
+
+	clock := time.Unix(1700000000, 0)
+	var waits []time.Duration
+	rl.now = func() time.Time { return clock }
+	rl.sleep = func(_ context.Context, d time.Duration) error {
+		clock = clock.Add(d)
+		waits = append(waits, d)
+		return nil
+	}
+
+	return rl, &waits
+}
+
+func TestRateLimiterDelay(t *testing.T) {
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+	rl, _ := newTestRateLimiter(t, tel, RateLimitConfig{MinRemaining: 10, MaxWait: time.Hour})
+	now := rl.now()
+	ctx := context.Background()
+
+	// the budget of a resource is unknown until observed
+	assert.Zero(t, rl.delay(rateLimitResourceCore))
+
+	// plenty of budget remains
+	rl.observe(ctx, rateLimitResourceCore, 5000, 4000, now.Add(time.Hour))
+	assert.Zero(t, rl.delay(rateLimitResourceCore))
+
+	// the budget is low, so the requests are spread until the reset
+	rl.observe(ctx, rateLimitResourceCore, 5000, 70, now.Add(time.Hour))
+	assert.Zero(t, rl.delay(rateLimitResourceCore))
+	assert.Equal(t, time.Minute, rl.delay(rateLimitResourceCore))
+	assert.Equal(t, 2*time.Minute, rl.delay(rateLimitResourceCore))
+
+	// the reserve is reached, so the requests pause until the reset
+	rl.observe(ctx, rateLimitResourceCore, 5000, 10, now.Add(time.Hour))
+	assert.Equal(t, time.Hour, rl.delay(rateLimitResourceCore))
+
+	// the rate limit was reset
+	rl.observe(ctx, rateLimitResourceCore, 5000, 10, now.Add(-time.Second))
+	assert.Zero(t, rl.delay(rateLimitResourceCore))
+
+	// the budget of the GraphQL API is tracked separately
+	rl.observe(ctx, rateLimitResourceGraphQL, 5000, 0, now.Add(time.Hour))
+	assert.Equal(t, time.Hour, rl.delay(rateLimitResourceGraphQL))
+	assert.Zero(t, rl.delay(rateLimitResourceCore))
+
+	metadatatest.AssertEqualReceiverGithubScraperRateLimitRemaining(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 10, Attributes: attribute.NewSet(attribute.String("resource", rateLimitResourceCore))},
+			{Value: 0, Attributes: attribute.NewSet(attribute.String("resource", rateLimitResourceGraphQL))},
+		},
+		metricdatatest.IgnoreTimestamp())
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	reset := strconv.FormatInt(time.Unix(1700000000, 0).Add(30*time.Second).Unix(), 10)
+
+	testCases := []struct {
+		desc           string
+		responses      []func(w http.ResponseWriter)
+		expectedStatus int
+		expectedWaits  []time.Duration
+	}{
+		{
+			desc: "retry after",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "5")
+					w.WriteHeader(http.StatusTooManyRequests)
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedWaits:  []time.Duration{5 * time.Second},
+		},
+		{
+			desc: "primary rate limit exhausted",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("X-RateLimit-Remaining", "0")
+					w.Header().Set("X-RateLimit-Reset", reset)
+					w.WriteHeader(http.StatusForbidden)
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedWaits:  []time.Duration{30 * time.Second},
+		},
+		{
+			desc: "secondary rate limit backs off",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message":"You have exceeded a secondary rate limit."}`))
+				},
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message":"You have exceeded a secondary rate limit."}`))
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedWaits:  []time.Duration{time.Minute, 2 * time.Minute},
+		},
+		{
+			desc: "permission error is not retried",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+				},
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			desc: "wait longer than the maximum is not retried",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) {
+					w.Header().Set("Retry-After", "3600")
+					w.WriteHeader(http.StatusTooManyRequests)
+				},
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			desc: "retries are exhausted",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusTooManyRequests) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusTooManyRequests) },
+				func(w http.ResponseWriter) { w.WriteHeader(http.StatusTooManyRequests) },
+			},
+			expectedStatus: http.StatusTooManyRequests,
+			expectedWaits:  []time.Duration{time.Minute, 2 * time.Minute},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			var bodies []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				bodies = append(bodies, string(body))
+
+				if len(bodies) <= len(tc.responses) {
+					tc.responses[len(bodies)-1](w)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data":{}}`))
+			}))
+			defer server.Close()
+
+			tel := componenttest.NewTelemetry()
+			defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+			rl, waits := newTestRateLimiter(t, tel, RateLimitConfig{MaxWait: 5 * time.Minute, MaxRetries: 2})
+			client := &http.Client{Transport: newRateLimitTransport(nil, rl)}
+
+			resp, err := client.Post(server.URL+"/api/graphql", "application/json", strings.NewReader(`{"query":"q"}`))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tc.expectedWaits, *waits)
+
+			// the body of the request is sent again on each retry
+			for _, body := range bodies {
+				assert.JSONEq(t, `{"query":"q"}`, body)
+			}
+
+			// the body of a response which is not retried is left readable
+			if tc.expectedStatus == http.StatusForbidden {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), "Resource not accessible")
+			}
+		})
+	}
+}
+
+func TestRateLimitTransportThrottledTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "100")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Unix(1700000000, 0).Add(time.Minute).Unix(), 10))
+		w.Header().Set("X-RateLimit-Resource", "core")
+	}))
+	defer server.Close()
+
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+	rl, waits := newTestRateLimiter(t, tel, RateLimitConfig{MinRemaining: 100, MaxWait: 5 * time.Minute})
+	client := &http.Client{Transport: newRateLimitTransport(nil, rl)}
+
+	// the first request finds the reserve reached, so the second one waits
+	// for the rate limit to reset
+	for range 2 {
+		resp, err := client.Get(server.URL + "/repos/liatrio/repo1/contributors")
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, []time.Duration{time.Minute}, *waits)
+	metadatatest.AssertEqualReceiverGithubScraperThrottledTime(t, tel,
+		[]metricdata.DataPoint[float64]{
+			{Value: 60, Attributes: attribute.NewSet(attribute.String("resource", rateLimitResourceCore))},
+		},
+		metricdatatest.IgnoreTimestamp())
+}
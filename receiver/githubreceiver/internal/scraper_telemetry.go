@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+// errorTypeOther is the error.type of a request which failed without a
+// response, following the OpenTelemetry semantic conventions.
+const errorTypeOther = "_OTHER"
+
+var shaPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// ScraperTelemetry records the internal telemetry of a scraper: the requests
+// it sends to the GitHub APIs, the duration of its scrapes and the number of
+// repositories they processed.
+type ScraperTelemetry struct {
+	telemetry *metadata.TelemetryBuilder
+	scraper   attribute.KeyValue
+}
+
+// NewScraperTelemetry returns the internal telemetry of the scraper of the
+// given type, recorded with the given telemetry builder.
+func NewScraperTelemetry(telemetry *metadata.TelemetryBuilder, scraper string) *ScraperTelemetry {
+	return &ScraperTelemetry{
+		telemetry: telemetry,
+		scraper:   attribute.String("scraper", scraper),
+	}
+}
+
+// Transport returns an http.RoundTripper recording the count, the duration
+// and the errors of the requests sent through next.
+func (st *ScraperTelemetry) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &instrumentedTransport{next: next, telemetry: st}
+}
+
+// RecordScrape records the duration of a scrape which started at start. It
+// does nothing on a nil ScraperTelemetry, such as the one of a scraper which
+// was not started.
+func (st *ScraperTelemetry) RecordScrape(ctx context.Context, start time.Time) {
+	if st == nil {
+		return
+	}
+
+	st.telemetry.ReceiverScraperDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(st.scraper))
+}
+
+// RecordRepositories records the number of repositories processed by a
+// scrape. It does nothing on a nil ScraperTelemetry.
+func (st *ScraperTelemetry) RecordRepositories(ctx context.Context, repositories int) {
+	if st == nil {
+		return
+	}
+
+	st.telemetry.ReceiverScraperRepositories.Record(ctx, int64(repositories), metric.WithAttributes(st.scraper))
+}
+
+type instrumentedTransport struct {
+	next      http.RoundTripper
+	telemetry *ScraperTelemetry
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	endpoint := attribute.String("endpoint", requestEndpoint(req))
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start).Seconds()
+
+	tel := t.telemetry.telemetry
+	if err != nil {
+		attrs := metric.WithAttributes(t.telemetry.scraper, endpoint)
+		tel.ReceiverScraperRequests.Add(ctx, 1, attrs)
+		tel.ReceiverScraperRequestDuration.Record(ctx, duration, attrs)
+		tel.ReceiverScraperRequestErrors.Add(ctx, 1,
+			metric.WithAttributes(t.telemetry.scraper, endpoint, attribute.String("error.type", errorTypeOther)))
+		return nil, err
+	}
+
+	attrs := metric.WithAttributes(t.telemetry.scraper, endpoint, attribute.Int("http.response.status_code", resp.StatusCode))
+	tel.ReceiverScraperRequests.Add(ctx, 1, attrs)
+	tel.ReceiverScraperRequestDuration.Record(ctx, duration, attrs)
+	if resp.StatusCode >= http.StatusBadRequest {
+		tel.ReceiverScraperRequestErrors.Add(ctx, 1,
+			metric.WithAttributes(t.telemetry.scraper, endpoint, attribute.String("error.type", strconv.Itoa(resp.StatusCode))))
+	}
+
+	return resp, nil
+}
+
+// requestEndpoint returns the route of the GitHub API a request is sent to,
+// with the names of the accounts and repositories, the numeric IDs and the
+// commit SHAs in its path replaced by placeholders so that the endpoint has a
+// low cardinality. The /api/v3 prefix of GitHub Enterprise Server is removed.
+func requestEndpoint(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) >= 2 && segments[0] == "api" && segments[1] == "v3" {
+		segments = segments[2:]
+	} else if len(segments) >= 2 && segments[0] == "api" && segments[1] == "graphql" {
+		segments = segments[1:]
+	}
+
+	for i, segment := range segments {
+		switch {
+		case i == 1 && segments[0] == "repos":
+			segments[i] = "{owner}"
+		case i == 2 && segments[0] == "repos":
+			segments[i] = "{repo}"
+		case i == 1 && (segments[0] == "orgs" || segments[0] == "users" || segments[0] == "enterprises"):
+			segments[i] = "{" + strings.TrimSuffix(segments[0], "s") + "}"
+		case isNumeric(segment):
+			segments[i] = "{id}"
+		case shaPattern.MatchString(segment):
+			segments[i] = "{sha}"
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func isNumeric(s string) bool {
+	_, err := strconv.ParseUint(s, 10, 64)
+	return err == nil
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadatatest"
+)
+
+func TestRequestEndpoint(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://api.github.com/graphql", expected: "/graphql"},
+		{url: "https://ghes.example.com/api/graphql", expected: "/graphql"},
+		{url: "https://api.github.com/repos/liatrio/repo1/contributors?per_page=100", expected: "/repos/{owner}/{repo}/contributors"},
+		{url: "https://ghes.example.com/api/v3/repos/liatrio/repo1/code-scanning/alerts", expected: "/repos/{owner}/{repo}/code-scanning/alerts"},
+		{url: "https://api.github.com/orgs/liatrio/actions/runners", expected: "/orgs/{org}/actions/runners"},
+		{url: "https://api.github.com/users/octocat", expected: "/users/{user}"},
+		{url: "https://api.github.com/repos/liatrio/repo1/actions/runs/42/jobs", expected: "/repos/{owner}/{repo}/actions/runs/{id}/jobs"},
+		{
+			url:      "https://api.github.com/repos/liatrio/repo1/commits/0123456789abcdef0123456789abcdef01234567",
+			expected: "/repos/{owner}/{repo}/commits/{sha}",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, requestEndpoint(req))
+		})
+	}
+}
+
+func TestScraperTelemetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/repos/liatrio/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+	telemetry, err := metadata.NewTelemetryBuilder(metadatatest.NewSettings(tel).TelemetrySettings)
+	require.NoError(t, err)
+
+	st := NewScraperTelemetry(telemetry, "scraper")
+	client := &http.Client{Transport: st.Transport(nil)}
+
+	for _, path := range []string{"/graphql", "/graphql", "/repos/liatrio/missing"} {
+		resp, err := client.Post(server.URL+path, "application/json", http.NoBody)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// a request failing without a response is an error without status code
+	_, err = client.Get("http://127.0.0.1:0/graphql")
+	require.Error(t, err)
+
+	st.RecordScrape(context.Background(), time.Now())
+	st.RecordRepositories(context.Background(), 2)
+
+	scraper := attribute.String("scraper", "scraper")
+	graphql := attribute.String("endpoint", "/graphql")
+	repo := attribute.String("endpoint", "/repos/{owner}/{repo}")
+
+	metadatatest.AssertEqualReceiverScraperRequests(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 2, Attributes: attribute.NewSet(scraper, graphql, attribute.Int("http.response.status_code", http.StatusOK))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, repo, attribute.Int("http.response.status_code", http.StatusNotFound))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, graphql)},
+		},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualReceiverScraperRequestErrors(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 1, Attributes: attribute.NewSet(scraper, repo, attribute.String("error.type", "404"))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, graphql, attribute.String("error.type", "_OTHER"))},
+		},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualReceiverScraperRepositories(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 2, Attributes: attribute.NewSet(scraper)},
+		},
+		metricdatatest.IgnoreTimestamp())
+}
+
+func TestScraperTelemetryNotStarted(t *testing.T) {
+	var st *ScraperTelemetry
+	assert.NotPanics(t, func() {
+		st.RecordScrape(context.Background(), time.Now())
+		st.RecordRepositories(context.Background(), 1)
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal"
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.uber.org/multierr"
+)
+
+const (
+	// DefaultAPIURL is the base URL of the REST API of github.com.
+	DefaultAPIURL = "https://api.github.com"
+	// DefaultWebURL is the URL of the web interface of github.com.
+	DefaultWebURL = "https://github.com"
+)
+
+// ErrInvalidServerURL is returned when a configured server URL is not valid.
+var ErrInvalidServerURL = errors.New("must be an absolute http or https URL")
+
+// ServerConfig holds the URLs of the GitHub server the receiver talks to and
+// reports links for. Both default to github.com. For GitHub Enterprise
+// Server, the API URL is typically https://HOSTNAME/api/v3 and the web URL
+// https://HOSTNAME.
+type ServerConfig struct {
+	// APIURL is the base URL of the GitHub REST API.
+	APIURL string `mapstructure:"api_url"`
+	// WebURL is the URL of the GitHub web interface.
+	WebURL string `mapstructure:"web_url"`
+}
+
+// Validate checks that the configured URLs are absolute http(s) URLs.
+func (c ServerConfig) Validate() error {
+	var errs error
+	if !isServerURL(c.APIURL) {
+		errs = multierr.Append(errs, fmt.Errorf("api_url: %w", ErrInvalidServerURL))
+	}
+	if !isServerURL(c.WebURL) {
+		errs = multierr.Append(errs, fmt.Errorf("web_url: %w", ErrInvalidServerURL))
+	}
+	return errs
+}
+
+// isServerURL returns true when the URL is unset or an absolute http(s) URL.
+func isServerURL(u string) bool {
+	if u == "" {
+		return true
+	}
+	parsed, err := url.Parse(u)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// IsEnterprise returns true when a server other than github.com is
+// configured.
+func (c ServerConfig) IsEnterprise() bool {
+	return c.RESTURL() != DefaultAPIURL
+}
+
+// RESTURL returns the base URL of the REST API without a trailing slash.
+func (c ServerConfig) RESTURL() string {
+	if c.APIURL == "" {
+		return DefaultAPIURL
+	}
+	return strings.TrimSuffix(c.APIURL, "/")
+}
+
+// HTMLURL returns the URL of the web interface without a trailing slash.
+func (c ServerConfig) HTMLURL() string {
+	if c.WebURL == "" {
+		return DefaultWebURL
+	}
+	return strings.TrimSuffix(c.WebURL, "/")
+}
+
+// GraphQLURL returns the URL of the GraphQL API, which is
+// https://api.github.com/graphql on github.com and
+// https://HOSTNAME/api/graphql on GitHub Enterprise Server.
+func (c ServerConfig) GraphQLURL() string {
+	rest := c.RESTURL()
+	if base, ok := strings.CutSuffix(rest, "/v3"); ok {
+		return base + "/graphql"
+	}
+	return rest + "/graphql"
+}
+
+// ReplaceAPIURL converts the REST API URL of a repository resource, such as a
+// workflow run attempt, into the URL of the same resource in the web
+// interface. URLs of other servers are returned unchanged.
+func (c ServerConfig) ReplaceAPIURL(apiURL string) string {
+	if path, ok := strings.CutPrefix(apiURL, c.RESTURL()+"/repos/"); ok {
+		return c.HTMLURL() + "/" + path
+	}
+	return apiURL
+}
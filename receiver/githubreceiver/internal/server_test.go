@@ -0,0 +1,125 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var enterpriseServer = ServerConfig{
+	APIURL: "https://ghes.example.com/api/v3/",
+	WebURL: "https://ghes.example.com/",
+}
+
+func TestServerConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     ServerConfig
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+		},
+		{
+			name: "enterprise server",
+			cfg:  enterpriseServer,
+		},
+		{
+			name:    "relative api url",
+			cfg:     ServerConfig{APIURL: "ghes.example.com/api/v3"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported web url scheme",
+			cfg:     ServerConfig{WebURL: "ftp://ghes.example.com"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidServerURL)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestServerConfigURLs(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          ServerConfig
+		isEnterprise bool
+		restURL      string
+		htmlURL      string
+		graphQLURL   string
+	}{
+		{
+			name:       "defaults to github.com",
+			restURL:    "https://api.github.com",
+			htmlURL:    "https://github.com",
+			graphQLURL: "https://api.github.com/graphql",
+		},
+		{
+			name:         "enterprise server",
+			cfg:          enterpriseServer,
+			isEnterprise: true,
+			restURL:      "https://ghes.example.com/api/v3",
+			htmlURL:      "https://ghes.example.com",
+			graphQLURL:   "https://ghes.example.com/api/graphql",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.isEnterprise, tt.cfg.IsEnterprise())
+			assert.Equal(t, tt.restURL, tt.cfg.RESTURL())
+			assert.Equal(t, tt.htmlURL, tt.cfg.HTMLURL())
+			assert.Equal(t, tt.graphQLURL, tt.cfg.GraphQLURL())
+		})
+	}
+}
+
+func TestReplaceAPIURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      ServerConfig
+		input    string
+		expected string
+	}{
+		{
+			name:     "converts api.github.com URL to html URL",
+			input:    "https://api.github.com/repos/open-telemetry/opentelemetry-collector-contrib/pull/1234",
+			expected: "https://github.com/open-telemetry/opentelemetry-collector-contrib/pull/1234",
+		},
+		{
+			name:     "converts api.github.com workflow URL to html URL",
+			input:    "https://api.github.com/repos/open-telemetry/opentelemetry-collector-contrib/actions/runs/1234",
+			expected: "https://github.com/open-telemetry/opentelemetry-collector-contrib/actions/runs/1234",
+		},
+		{
+			name:     "converts enterprise server URL to html URL",
+			cfg:      enterpriseServer,
+			input:    "https://ghes.example.com/api/v3/repos/liatrio/otel-testing/actions/runs/1234/attempts/1",
+			expected: "https://ghes.example.com/liatrio/otel-testing/actions/runs/1234/attempts/1",
+		},
+		{
+			name:     "leaves URLs of other servers unchanged",
+			cfg:      enterpriseServer,
+			input:    "https://api.github.com/repos/liatrio/otel-testing/actions/runs/1234",
+			expected: "https://api.github.com/repos/liatrio/otel-testing/actions/runs/1234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.cfg.ReplaceAPIURL(tt.input))
+		})
+	}
+}
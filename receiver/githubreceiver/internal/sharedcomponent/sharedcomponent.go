@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sharedcomponent exposes functionality for components
+// to register against a shared key, such as a configuration object, in order
+// to be reused across signal types. The shared component is started by the
+// first signal started, and shut down once every signal is shut down.
+package sharedcomponent // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/sharedcomponent"
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/collector/component"
+)
+
+// Map keeps a reference to the components created for a given key, so that
+// the signals created with the same key share a single component.
+type Map[K comparable, V component.Component] struct {
+	lock       sync.Mutex
+	components map[K]*Component[V]
+}
+
+// NewMap creates an empty Map.
+func NewMap[K comparable, V component.Component]() *Map[K, V] {
+	return &Map[K, V]{
+		components: map[K]*Component[V]{},
+	}
+}
+
+// LoadOrStore returns the component created for the key, creating it when
+// none exists yet, and adds a reference to it. Each reference must be shut
+// down for the component to be shut down.
+func (m *Map[K, V]) LoadOrStore(key K, create func() (V, error)) (*Component[V], error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if c, ok := m.components[key]; ok {
+		c.refs++
+		return c, nil
+	}
+
+	comp, err := create()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Component[V]{component: comp, refs: 1}
+	c.release = func() bool {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		c.refs--
+		if c.refs > 0 {
+			return false
+		}
+
+		delete(m.components, key)
+		return true
+	}
+	m.components[key] = c
+	return c, nil
+}
+
+// Component wraps a component shared by several signals. It is started by
+// the first call to Start, and shut down once Shutdown is called for each of
+// its references.
+type Component[V component.Component] struct {
+	component V
+
+	// refs is the number of references to the component, guarded by the
+	// lock of its Map.
+	refs int
+	// release releases a reference to the component, returning whether it
+	// was the last one.
+	release func() bool
+
+	lock    sync.Mutex
+	started bool
+	stopped bool
+}
+
+// Unwrap returns the shared component.
+func (c *Component[V]) Unwrap() V {
+	return c.component
+}
+
+// Start starts the shared component unless it is already started.
+func (c *Component[V]) Start(ctx context.Context, host component.Host) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.started || c.stopped {
+		return nil
+	}
+
+	c.started = true
+	return c.component.Start(ctx, host)
+}
+
+// Shutdown releases a reference to the shared component, and shuts it down
+// and removes it from its Map once no reference is left.
+func (c *Component[V]) Shutdown(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.stopped {
+		return nil
+	}
+
+	if !c.release() {
+		return nil
+	}
+
+	c.stopped = true
+	return c.component.Shutdown(ctx)
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sharedcomponent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+type countingComponent struct {
+	starts    int
+	shutdowns int
+}
+
+func (c *countingComponent) Start(context.Context, component.Host) error {
+	c.starts++
+	return nil
+}
+
+func (c *countingComponent) Shutdown(context.Context) error {
+	c.shutdowns++
+	return nil
+}
+
+func TestLoadOrStore(t *testing.T) {
+	m := NewMap[string, *countingComponent]()
+
+	created := 0
+	create := func() (*countingComponent, error) {
+		created++
+		return &countingComponent{}, nil
+	}
+
+	first, err := m.LoadOrStore("key", create)
+	require.NoError(t, err)
+	second, err := m.LoadOrStore("key", create)
+	require.NoError(t, err)
+	other, err := m.LoadOrStore("other", create)
+	require.NoError(t, err)
+
+	assert.Same(t, first, second)
+	assert.NotSame(t, first, other)
+	assert.Equal(t, 2, created)
+
+	_, err = m.LoadOrStore("error", func() (*countingComponent, error) {
+		return nil, errors.New("failed")
+	})
+	assert.Error(t, err)
+	assert.Len(t, m.components, 2)
+}
+
+func TestStartShutdown(t *testing.T) {
+	m := NewMap[string, *countingComponent]()
+	create := func() (*countingComponent, error) {
+		return &countingComponent{}, nil
+	}
+
+	traces, err := m.LoadOrStore("key", create)
+	require.NoError(t, err)
+	logs, err := m.LoadOrStore("key", create)
+	require.NoError(t, err)
+	comp := traces.Unwrap()
+
+	// the component is started once by the first signal
+	require.NoError(t, traces.Start(context.Background(), componenttest.NewNopHost()))
+	require.NoError(t, logs.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, 1, comp.starts)
+
+	// the component is not shut down while a signal still uses it
+	require.NoError(t, traces.Shutdown(context.Background()))
+	assert.Equal(t, 0, comp.shutdowns)
+	assert.Contains(t, m.components, "key")
+
+	require.NoError(t, logs.Shutdown(context.Background()))
+	assert.Equal(t, 1, comp.shutdowns)
+	assert.NotContains(t, m.components, "key")
+
+	// extra shutdowns and starts after the shutdown are noops
+	require.NoError(t, logs.Shutdown(context.Background()))
+	require.NoError(t, logs.Start(context.Background(), componenttest.NewNopHost()))
+	assert.Equal(t, 1, comp.starts)
+	assert.Equal(t, 1, comp.shutdowns)
+
+	// a new component is created for the key once the previous one is shut
+	// down
+	next, err := m.LoadOrStore("key", create)
+	require.NoError(t, err)
+	assert.NotSame(t, comp, next.Unwrap())
+}
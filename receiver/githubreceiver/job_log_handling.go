@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.uber.org/zap"
+)
+
+const (
+	eventNameJobLog = "github.workflow_job.log"
+
+	// jobLogRedacted replaces the matches of the redact expressions, the same
+	// way GitHub masks secrets.
+	jobLogRedacted = "***"
+)
+
+// handleJobLogsReq queues the download of the log of a completed workflow job
+// to be passed to the logs consumer once the delivery is acknowledged. Job
+// logs are emitted alongside the traces of the same event, so failures are
+// logged rather than returned to GitHub.
+func (gtr *githubTracesReceiver) handleJobLogsReq(event any) {
+	e, ok := event.(*github.WorkflowJobEvent)
+	if !ok || !gtr.cfg.WebHook.JobLogs.Enabled || gtr.logConsumer == nil || gtr.ghClient == nil {
+		return
+	}
+
+	job := e.GetWorkflowJob()
+	if strings.ToLower(job.GetStatus()) != "completed" {
+		return
+	}
+
+	conclusions := gtr.cfg.WebHook.JobLogs.Conclusions
+	if len(conclusions) > 0 && !slices.Contains(conclusions, strings.ToLower(job.GetConclusion())) {
+		gtr.logger.Debug("workflow job conclusion not selected, skipping log...", zap.String("conclusion", job.GetConclusion()))
+		return
+	}
+
+	gtr.fetches.enqueue(func(ctx context.Context) {
+		gtr.fetchJobLog(ctx, e)
+	})
+}
+
+// fetchJobLog downloads the log of the workflow job and passes it to the logs
+// consumer.
+func (gtr *githubTracesReceiver) fetchJobLog(ctx context.Context, e *github.WorkflowJobEvent) {
+	job := e.GetWorkflowJob()
+	jobLog, err := gtr.downloadJobLog(ctx, e)
+	if err != nil {
+		gtr.logger.Error("failed to download workflow job log", zap.Int64("job_id", job.GetID()), zap.Error(err))
+		return
+	}
+	defer jobLog.Close()
+
+	ld, err := gtr.handleJobLog(e, io.LimitReader(jobLog, gtr.cfg.WebHook.JobLogs.MaxSize))
+	if err != nil {
+		gtr.logger.Error("failed to read workflow job log", zap.Int64("job_id", job.GetID()), zap.Error(err))
+		return
+	}
+
+	if ld.LogRecordCount() == 0 {
+		return
+	}
+
+	ctx = gtr.obsrecv.StartLogsOp(ctx)
+	err = gtr.logConsumer.ConsumeLogs(ctx, ld)
+	if err != nil {
+		gtr.logger.Error("failed to consume workflow job logs", zap.Error(err))
+	}
+	gtr.obsrecv.EndLogsOp(ctx, "protobuf", ld.LogRecordCount(), err)
+}
+
+// downloadJobLog returns the plain text log of the workflow job.
+func (gtr *githubTracesReceiver) downloadJobLog(ctx context.Context, e *github.WorkflowJobEvent) (io.ReadCloser, error) {
+	u, _, err := gtr.ghClient.Actions.GetWorkflowJobLogs(
+		ctx,
+		e.GetRepo().GetOwner().GetLogin(),
+		e.GetRepo().GetName(),
+		e.GetWorkflowJob().GetID(),
+		downloadMaxRedirects,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return gtr.download(ctx, u)
+}
+
+// handleJobLog creates a log record for each line of the workflow job log.
+// Each line is correlated with the span of the step it was written by, which
+// is the last step started before the line was written.
+func (gtr *githubTracesReceiver) handleJobLog(e *github.WorkflowJobEvent, jobLog io.Reader) (plog.Logs, error) {
+	l := plog.NewLogs()
+	r := l.ResourceLogs().AppendEmpty()
+
+	err := gtr.getWorkflowJobAttrs(r.Resource(), e)
+	if err != nil {
+		return plog.Logs{}, fmt.Errorf("failed to get workflow job attributes: %w", err)
+	}
+
+	job := e.GetWorkflowJob()
+	runID := job.GetRunID()
+	runAttempt := int(job.GetRunAttempt())
+
+	traceID, err := newTraceID(runID, runAttempt)
+	if err != nil {
+		return plog.Logs{}, fmt.Errorf("failed to generate trace ID: %w", err)
+	}
+
+	jobSpanID, err := newJobSpanID(runID, runAttempt, job.GetName())
+	if err != nil {
+		return plog.Logs{}, fmt.Errorf("failed to generate job span ID: %w", err)
+	}
+
+	// skipped steps do not write to the log, and post steps are not always
+	// listed in the order they ran in.
+	steps := slices.DeleteFunc(slices.Clone(job.Steps), func(step *github.TaskStep) bool {
+		return strings.ToLower(step.GetConclusion()) == "skipped" || step.GetStartedAt().IsZero()
+	})
+	slices.SortStableFunc(steps, func(a, b *github.TaskStep) int {
+		return a.GetStartedAt().Compare(b.GetStartedAt().Time)
+	})
+
+	stepSpanIDs := make([]pcommon.SpanID, len(steps))
+	for i, step := range steps {
+		stepSpanIDs[i], err = newStepSpanID(runID, runAttempt, job.GetName(), step.GetName(), int(step.GetNumber()))
+		if err != nil {
+			return plog.Logs{}, fmt.Errorf("failed to generate step span ID: %w", err)
+		}
+	}
+
+	records := r.ScopeLogs().AppendEmpty().LogRecords()
+
+	scanner := bufio.NewScanner(jobLog)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), int(gtr.cfg.WebHook.JobLogs.MaxSize)+1)
+
+	var ts time.Time
+	step := -1
+	for scanner.Scan() {
+		line := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		if line == "" {
+			continue
+		}
+
+		// lines are prefixed with the time they were written, lines without
+		// one continue the previous line.
+		var body string
+		ts, body = splitJobLogLine(line, ts)
+
+		// the times the steps started at are truncated to seconds
+		for step+1 < len(steps) && !ts.Truncate(time.Second).Before(steps[step+1].GetStartedAt().Time) {
+			step++
+		}
+
+		for _, re := range gtr.jobLogRedact {
+			body = re.ReplaceAllString(body, jobLogRedacted)
+		}
+
+		record := records.AppendEmpty()
+		setLogRecord(record, eventNameJobLog, ts)
+		setJobLogSeverity(record, body)
+		record.Body().SetStr(body)
+		record.SetTraceID(traceID)
+		record.SetSpanID(jobSpanID)
+
+		attrs := record.Attributes()
+		attrs.PutInt(string(semconv.CICDPipelineTaskRunIDKey), job.GetID())
+		if step >= 0 {
+			record.SetSpanID(stepSpanIDs[step])
+			attrs.PutStr(string(semconv.CICDPipelineTaskNameKey), steps[step].GetName())
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return plog.Logs{}, err
+	}
+
+	return l, nil
+}
+
+// splitJobLogLine splits a job log line into the time it was written and its
+// message. When the line has no time, the provided time is used.
+func splitJobLogLine(line string, previous time.Time) (time.Time, string) {
+	prefix, message, ok := strings.Cut(line, " ")
+	if !ok {
+		return previous, line
+	}
+
+	ts, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return previous, line
+	}
+
+	return ts, message
+}
+
+// setJobLogSeverity sets the severity of a job log record from the error and
+// warning annotations written by GitHub Actions.
+func setJobLogSeverity(record plog.LogRecord, body string) {
+	switch {
+	case strings.HasPrefix(body, "##[error]"):
+		record.SetSeverityNumber(plog.SeverityNumberError)
+		record.SetSeverityText(plog.SeverityNumberError.String())
+	case strings.HasPrefix(body, "##[warning]"):
+		record.SetSeverityNumber(plog.SeverityNumberWarn)
+		record.SetSeverityText(plog.SeverityNumberWarn.String())
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+)
+
+func readWorkflowJobEvent(t *testing.T) *github.WorkflowJobEvent {
+	data, err := os.ReadFile(filepath.Join("testdata", "workflow-job-completed.json"))
+	require.NoError(t, err)
+
+	var event github.WorkflowJobEvent
+	require.NoError(t, json.Unmarshal(data, &event))
+	return &event
+}
+
+func TestHandleJobLogWithGoldenFile(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.JobLogs.Enabled = true
+	cfg.WebHook.JobLogs.Redact = []string{`ghp_[A-Za-z0-9]+`}
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	jobLog, err := os.Open(filepath.Join("testdata", "workflow-job-log.txt"))
+	require.NoError(t, err)
+	defer jobLog.Close()
+
+	logs, err := receiver.handleJobLog(readWorkflowJobEvent(t), jobLog)
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "workflow-job-log-expected.yaml")
+
+	// Uncomment the following line to update the golden file
+	// golden.WriteLogs(t, expectedFile, logs)
+
+	expectedLogs, err := golden.ReadLogs(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, plogtest.CompareLogs(expectedLogs, logs, plogtest.IgnoreObservedTimestamp()))
+}
+
+func TestHandleJobLogsReq(t *testing.T) {
+	jobLog, err := os.ReadFile(filepath.Join("testdata", "workflow-job-log.txt"))
+	require.NoError(t, err)
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/open-telemetry/open-telemetry-otel-collector/actions/jobs/40685651258/logs":
+			http.Redirect(w, r, server.URL+"/download", http.StatusFound)
+		case "/download":
+			// the log is downloaded without the credentials of the client
+			require.Empty(t, r.Header.Get("Authorization"))
+			_, _ = w.Write(jobLog)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		desc         string
+		conclusions  []string
+		maxSize      int64
+		expectedLogs int
+	}{
+		{
+			desc:         "conclusion not selected",
+			conclusions:  []string{"failure"},
+			maxSize:      defaultJobLogsMaxSize,
+			expectedLogs: 0,
+		},
+		{
+			desc:         "all conclusions",
+			maxSize:      defaultJobLogsMaxSize,
+			expectedLogs: 6,
+		},
+		{
+			desc:         "log larger than max size",
+			conclusions:  []string{"success"},
+			maxSize:      128,
+			expectedLogs: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.WebHook.Client = &confighttp.ClientConfig{Endpoint: server.URL}
+			cfg.WebHook.JobLogs.Enabled = true
+			cfg.WebHook.JobLogs.Conclusions = test.conclusions
+			cfg.WebHook.JobLogs.MaxSize = test.maxSize
+
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+
+			receiver.ghClient, err = receiver.newGitHubClient(context.Background(), nil)
+			require.NoError(t, err)
+			receiver.downloadClient, err = receiver.newDownloadClient(context.Background(), nil)
+			require.NoError(t, err)
+
+			sink := new(consumertest.LogsSink)
+			receiver.logConsumer = sink
+
+			// the log is downloaded in the background once queued
+			receiver.fetches.start()
+			receiver.handleJobLogsReq(readWorkflowJobEvent(t))
+			require.NoError(t, receiver.fetches.shutdown(context.Background()))
+			require.Equal(t, test.expectedLogs, sink.LogRecordCount())
+		})
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+// jobStateEvictInterval is the maximum interval at which expired jobs are
+// evicted from a jobStateTable.
+const jobStateEvictInterval = time.Minute
+
+// jobStatus is the status of a workflow job, ordered by its progression so
+// that events delivered out of order never move a job backwards.
+type jobStatus int
+
+const (
+	jobStatusQueued jobStatus = iota
+	jobStatusInProgress
+	jobStatusCompleted
+)
+
+// jobState is the last known state of a workflow job.
+type jobState struct {
+	status  jobStatus
+	repo    string
+	labels  []string
+	updated time.Time
+}
+
+// jobStateTable tracks the state of the workflow jobs seen by the webhook
+// keyed by job ID. Completed jobs are kept until they expire so that late
+// queued or in_progress events for them are ignored, and so that their
+// repository and runner labels keep reporting a gauge of zero.
+type jobStateTable struct {
+	mu   sync.Mutex
+	ttl  time.Duration
+	jobs map[int64]*jobState
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newJobStateTable(ttl time.Duration) *jobStateTable {
+	return &jobStateTable{
+		ttl:  ttl,
+		jobs: map[int64]*jobState{},
+	}
+}
+
+// update records the status of the job in the event. Events with a status
+// that isn't tracked, such as waiting, are ignored.
+func (t *jobStateTable) update(e *github.WorkflowJobEvent, now time.Time) {
+	var status jobStatus
+	switch strings.ToLower(e.GetWorkflowJob().GetStatus()) {
+	case "queued":
+		status = jobStatusQueued
+	case "in_progress":
+		status = jobStatusInProgress
+	case "completed":
+		status = jobStatusCompleted
+	default:
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := e.GetWorkflowJob().GetID()
+	if job, ok := t.jobs[id]; ok {
+		if status < job.status {
+			return
+		}
+		job.status = status
+		job.updated = now
+		return
+	}
+
+	t.jobs[id] = &jobState{
+		status:  status,
+		repo:    e.GetRepo().GetName(),
+		labels:  sortedRunnerLabels(e.GetWorkflowJob().Labels),
+		updated: now,
+	}
+}
+
+// start evicts the expired jobs in the background until shutdown is called,
+// so that events do not pay for scanning every tracked job.
+func (t *jobStateTable) start() {
+	var ctx context.Context
+	ctx, t.cancel = context.WithCancel(context.Background())
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+
+		ticker := time.NewTicker(min(t.ttl, jobStateEvictInterval))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				t.evict(now)
+			}
+		}
+	}()
+}
+
+func (t *jobStateTable) shutdown() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.wg.Wait()
+}
+
+// evict removes the jobs which have not been updated within the TTL.
+func (t *jobStateTable) evict(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for id, job := range t.jobs {
+		if now.Sub(job.updated) > t.ttl {
+			delete(t.jobs, id)
+		}
+	}
+}
+
+// jobCount is the number of queued and running jobs for a repository and set
+// of runner labels.
+type jobCount struct {
+	repo       string
+	labels     []string
+	queued     int64
+	inProgress int64
+}
+
+// counts returns the number of queued and running jobs per repository and
+// runner labels. Jobs which expired since the last eviction are not counted.
+func (t *jobStateTable) counts(now time.Time) []*jobCount {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	byKey := map[string]*jobCount{}
+	var counts []*jobCount
+	for _, job := range t.jobs {
+		if now.Sub(job.updated) > t.ttl {
+			continue
+		}
+
+		key := job.repo + "/" + strings.Join(job.labels, ",")
+		c, ok := byKey[key]
+		if !ok {
+			c = &jobCount{repo: job.repo, labels: job.labels}
+			byKey[key] = c
+			counts = append(counts, c)
+		}
+
+		switch job.status {
+		case jobStatusQueued:
+			c.queued++
+		case jobStatusInProgress:
+			c.inProgress++
+		}
+	}
+
+	return counts
+}
+
+// scrapeJobState reports the number of queued and running workflow jobs per
+// repository and runner labels. It is called by the scraper controller at the
+// collection interval.
+func (gtr *githubTracesReceiver) scrapeJobState(context.Context) (pmetric.Metrics, error) {
+	now := time.Now()
+	counts := gtr.jobs.counts(now)
+	if len(counts) == 0 {
+		return pmetric.NewMetrics(), nil
+	}
+
+	ts := pcommon.NewTimestampFromTime(now)
+
+	gtr.mbMu.Lock()
+	defer gtr.mbMu.Unlock()
+
+	for _, c := range counts {
+		labels := runnerLabelsValue(c.labels)
+		gtr.mb.RecordCicdPipelineTaskRunActiveDataPoint(ts, c.queued, c.repo, labels, metadata.AttributeCicdPipelineRunStatePending)
+		gtr.mb.RecordCicdPipelineTaskRunActiveDataPoint(ts, c.inProgress, c.repo, labels, metadata.AttributeCicdPipelineRunStateExecuting)
+	}
+
+	rb := gtr.mb.NewResourceBuilder()
+	rb.SetVcsVendorName("github")
+
+	return gtr.mb.Emit(metadata.WithResource(rb.Emit())), nil
+}
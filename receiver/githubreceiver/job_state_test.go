@@ -0,0 +1,188 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+func newJobEvent(id int64, repo string, status string, labels ...string) *github.WorkflowJobEvent {
+	return &github.WorkflowJobEvent{
+		Repo: &github.Repository{Name: github.Ptr(repo)},
+		WorkflowJob: &github.WorkflowJob{
+			ID:     github.Ptr(id),
+			Status: github.Ptr(status),
+			Labels: labels,
+		},
+	}
+}
+
+func TestJobStateTable(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		desc     string
+		events   []*github.WorkflowJobEvent
+		at       time.Time
+		expected []*jobCount
+	}{
+		{
+			desc: "queued and in progress jobs",
+			events: []*github.WorkflowJobEvent{
+				newJobEvent(1, "repo", "queued", "self-hosted", "Linux"),
+				newJobEvent(2, "repo", "queued", "linux", "self-hosted"),
+				newJobEvent(3, "repo", "queued", "self-hosted", "linux"),
+				newJobEvent(3, "repo", "in_progress", "self-hosted", "linux"),
+			},
+			at: now,
+			expected: []*jobCount{
+				{repo: "repo", labels: []string{"linux", "self-hosted"}, queued: 2, inProgress: 1},
+			},
+		},
+		{
+			desc: "completed jobs are not counted",
+			events: []*github.WorkflowJobEvent{
+				newJobEvent(1, "repo", "queued", "ubuntu-latest"),
+				newJobEvent(1, "repo", "completed", "ubuntu-latest"),
+			},
+			at: now,
+			expected: []*jobCount{
+				{repo: "repo", labels: []string{"ubuntu-latest"}},
+			},
+		},
+		{
+			desc: "out of order events do not move a job backwards",
+			events: []*github.WorkflowJobEvent{
+				newJobEvent(1, "repo", "completed", "ubuntu-latest"),
+				newJobEvent(1, "repo", "in_progress", "ubuntu-latest"),
+				newJobEvent(2, "repo", "in_progress", "ubuntu-latest"),
+				newJobEvent(2, "repo", "queued", "ubuntu-latest"),
+			},
+			at: now,
+			expected: []*jobCount{
+				{repo: "repo", labels: []string{"ubuntu-latest"}, inProgress: 1},
+			},
+		},
+		{
+			desc: "waiting jobs are not tracked",
+			events: []*github.WorkflowJobEvent{
+				newJobEvent(1, "repo", "waiting", "ubuntu-latest"),
+			},
+			at: now,
+		},
+		{
+			desc: "jobs expire after the ttl",
+			events: []*github.WorkflowJobEvent{
+				newJobEvent(1, "repo", "queued", "ubuntu-latest"),
+			},
+			at: now.Add(2 * time.Hour),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			table := newJobStateTable(time.Hour)
+			for _, e := range test.events {
+				table.update(e, now)
+			}
+
+			require.ElementsMatch(t, test.expected, table.counts(test.at))
+		})
+	}
+}
+
+func TestJobStateEviction(t *testing.T) {
+	now := time.Now()
+
+	table := newJobStateTable(time.Hour)
+	table.update(newJobEvent(1, "repo", "queued", "ubuntu-latest"), now.Add(-2*time.Hour))
+	table.update(newJobEvent(2, "repo", "queued", "ubuntu-latest"), now)
+
+	table.evict(now)
+	require.Len(t, table.jobs, 1)
+	require.Contains(t, table.jobs, int64(2))
+
+	// expired jobs are evicted in the background once started
+	table = newJobStateTable(10 * time.Millisecond)
+	table.update(newJobEvent(1, "repo", "queued", "ubuntu-latest"), now)
+	table.start()
+	defer table.shutdown()
+
+	require.Eventually(t, func() bool {
+		table.mu.Lock()
+		defer table.mu.Unlock()
+		return len(table.jobs) == 0
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestScrapeJobState(t *testing.T) {
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), consumertest.NewNop())
+	require.NoError(t, err)
+
+	md, err := receiver.scrapeJobState(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 0, md.DataPointCount())
+
+	receiver.jobs.update(newJobEvent(1, "repo", "queued", "ubuntu-latest"), time.Now())
+
+	md, err = receiver.scrapeJobState(context.Background())
+	require.NoError(t, err)
+
+	m := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	require.Equal(t, "cicd.pipeline.task.run.active", m.Name())
+	require.Equal(t, 2, m.Gauge().DataPoints().Len())
+
+	for i := 0; i < m.Gauge().DataPoints().Len(); i++ {
+		dp := m.Gauge().DataPoints().At(i)
+		state, ok := dp.Attributes().Get("cicd.pipeline.run.state")
+		require.True(t, ok)
+
+		switch state.Str() {
+		case "pending":
+			require.Equal(t, int64(1), dp.IntValue())
+		case "executing":
+			require.Equal(t, int64(0), dp.IntValue())
+		default:
+			t.Fatalf("unexpected state %q", state.Str())
+		}
+	}
+}
+
+func TestHandleReqTracksJobState(t *testing.T) {
+	job, err := os.ReadFile(filepath.Join("testdata", "workflow-job-completed.json"))
+	require.NoError(t, err)
+	queued := bytes.Replace(job, []byte(`"status": "completed"`), []byte(`"status": "queued"`), 1)
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), consumertest.NewNop())
+	require.NoError(t, err)
+	receiver.metricsConsumer = consumertest.NewNop()
+
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(queued))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(github.EventTypeHeader, "workflow_job")
+
+	w := httptest.NewRecorder()
+	receiver.handleReq(w, req)
+
+	// queued jobs are not traced, but are tracked
+	require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+
+	counts := receiver.jobs.counts(time.Now())
+	require.Len(t, counts, 1)
+	require.Equal(t, int64(1), counts[0].queued)
+}
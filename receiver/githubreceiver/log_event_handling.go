@@ -0,0 +1,208 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+const (
+	eventNamePullRequest       = "github.pull_request"
+	eventNamePullRequestReview = "github.pull_request_review"
+	eventNamePush              = "github.push"
+
+	refHeadsPrefix = "refs/heads/"
+	refTagsPrefix  = "refs/tags/"
+)
+
+// handlePullRequest creates a log record for a GitHub Pull Request event. A
+// `pull_request` maps to the semantic conventions for a `vcs.change`.
+func (gtr *githubTracesReceiver) handlePullRequest(e *github.PullRequestEvent) (plog.Logs, error) {
+	l := plog.NewLogs()
+	r := l.ResourceLogs().AppendEmpty()
+
+	repo := e.GetRepo()
+	err := gtr.getRepositoryAttrs(r.Resource(), repo.CustomProperties["service_name"], repo.GetName(), repo.GetHTMLURL(), repo.GetOwner().GetLogin())
+	if err != nil {
+		return plog.Logs{}, fmt.Errorf("failed to get pull request attributes: %w", err)
+	}
+
+	pr := e.GetPullRequest()
+
+	record := r.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	setLogRecord(record, eventNamePullRequest, pr.GetUpdatedAt().Time)
+	record.Body().SetStr(fmt.Sprintf("pull request #%d %s", pr.GetNumber(), e.GetAction()))
+
+	attrs := record.Attributes()
+	attrs.PutStr(string(AttributeGitHubEventActionKey), e.GetAction())
+	attrs.PutStr(string(AttributeVCSChangeSenderLoginKey), e.GetSender().GetLogin())
+	putChangeAttrs(attrs, pr)
+
+	return l, nil
+}
+
+// handlePullRequestReview creates a log record for a GitHub Pull Request
+// Review event. The review is associated to the `vcs.change` it was submitted
+// against.
+func (gtr *githubTracesReceiver) handlePullRequestReview(e *github.PullRequestReviewEvent) (plog.Logs, error) {
+	l := plog.NewLogs()
+	r := l.ResourceLogs().AppendEmpty()
+
+	repo := e.GetRepo()
+	err := gtr.getRepositoryAttrs(r.Resource(), repo.CustomProperties["service_name"], repo.GetName(), repo.GetHTMLURL(), repo.GetOwner().GetLogin())
+	if err != nil {
+		return plog.Logs{}, fmt.Errorf("failed to get pull request review attributes: %w", err)
+	}
+
+	pr := e.GetPullRequest()
+	review := e.GetReview()
+	state := strings.ToLower(review.GetState())
+
+	record := r.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	setLogRecord(record, eventNamePullRequestReview, review.GetSubmittedAt().Time)
+	record.Body().SetStr(fmt.Sprintf("pull request #%d review %s: %s", pr.GetNumber(), e.GetAction(), state))
+
+	attrs := record.Attributes()
+	attrs.PutStr(string(AttributeGitHubEventActionKey), e.GetAction())
+	attrs.PutStr(string(AttributeVCSChangeSenderLoginKey), e.GetSender().GetLogin())
+	putChangeAttrs(attrs, pr)
+
+	attrs.PutInt(string(AttributeVCSChangeReviewIDKey), review.GetID())
+	attrs.PutStr(string(AttributeVCSChangeReviewStateKey), state)
+	attrs.PutStr(string(AttributeVCSChangeReviewAuthorLoginKey), review.GetUser().GetLogin())
+	attrs.PutStr(string(AttributeVCSChangeReviewURLFullKey), review.GetHTMLURL())
+
+	return l, nil
+}
+
+// handlePush creates a log record for a GitHub Push event. A push updates the
+// revision of a `vcs.ref`.
+func (gtr *githubTracesReceiver) handlePush(e *github.PushEvent) (plog.Logs, error) {
+	l := plog.NewLogs()
+	r := l.ResourceLogs().AppendEmpty()
+
+	repo := e.GetRepo()
+	err := gtr.getRepositoryAttrs(r.Resource(), repo.CustomProperties["service_name"], repo.GetName(), repo.GetHTMLURL(), repo.GetOwner().GetLogin())
+	if err != nil {
+		return plog.Logs{}, fmt.Errorf("failed to get push attributes: %w", err)
+	}
+
+	refName, refType, err := splitRef(e.GetRef())
+	if err != nil {
+		return plog.Logs{}, err
+	}
+
+	// A deleted ref has no head commit, in which case the time the event was
+	// received is used.
+	ts := e.GetHeadCommit().GetTimestamp().Time
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+
+	record := r.ScopeLogs().AppendEmpty().LogRecords().AppendEmpty()
+	setLogRecord(record, eventNamePush, ts)
+	record.Body().SetStr(fmt.Sprintf("push to %s %s with %d commit(s)", refType, refName, len(e.Commits)))
+
+	attrs := record.Attributes()
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), refName)
+	attrs.PutStr(string(semconv.VCSRefHeadTypeKey), refType)
+	attrs.PutStr(string(semconv.VCSRefHeadRevisionKey), e.GetAfter())
+	attrs.PutStr(string(AttributeVCSRefHeadPreviousRevisionKey), e.GetBefore())
+	attrs.PutStr(AttributeVCSRefHeadRevisionAuthorName, e.GetHeadCommit().GetAuthor().GetName())
+	attrs.PutStr(AttributeVCSRefHeadRevisionAuthorEmail, e.GetHeadCommit().GetAuthor().GetEmail())
+	attrs.PutBool(string(AttributeVCSRefPushCreatedKey), e.GetCreated())
+	attrs.PutBool(string(AttributeVCSRefPushDeletedKey), e.GetDeleted())
+	attrs.PutBool(string(AttributeVCSRefPushForcedKey), e.GetForced())
+	attrs.PutInt(string(AttributeVCSRefPushCommitCountKey), int64(len(e.Commits)))
+	attrs.PutStr(string(AttributeVCSRefPushSenderLoginKey), e.GetSender().GetLogin())
+	attrs.PutStr(string(AttributeVCSRefPushCompareURLFullKey), e.GetCompare())
+
+	return l, nil
+}
+
+// getRepositoryAttrs sets the resource attributes shared by all the log
+// records. The push event uses a different repository type than the pull
+// request events, so the values are passed in individually.
+func (gtr *githubTracesReceiver) getRepositoryAttrs(resource pcommon.Resource, customProps any, name, url, owner string) error {
+	attrs := resource.Attributes()
+	var err error
+
+	svc, err := gtr.getServiceName(customProps, name)
+	if err != nil {
+		err = errors.New("failed to get service.name")
+	}
+
+	attrs.PutStr(string(semconv.ServiceNameKey), svc)
+
+	// VCS Attributes
+	attrs.PutStr(string(semconv.VCSRepositoryNameKey), name)
+	attrs.PutStr(string(semconv.VCSRepositoryURLFullKey), url)
+	attrs.PutStr(AttributeVCSRepositoryOwner, owner)
+	attrs.PutStr(AttributeVCSVendorName, "github")
+
+	return err
+}
+
+// putChangeAttrs sets the `vcs.change` and `vcs.ref` attributes of a pull
+// request on the provided attribute map.
+func putChangeAttrs(attrs pcommon.Map, pr *github.PullRequest) {
+	attrs.PutStr(string(semconv.VCSChangeIDKey), strconv.Itoa(pr.GetNumber()))
+	attrs.PutStr(string(semconv.VCSChangeTitleKey), pr.GetTitle())
+	attrs.PutStr(string(semconv.VCSChangeStateKey), changeState(pr))
+	attrs.PutStr(string(AttributeVCSChangeURLFullKey), pr.GetHTMLURL())
+	attrs.PutStr(string(AttributeVCSChangeAuthorLoginKey), pr.GetUser().GetLogin())
+	attrs.PutBool(string(AttributeVCSChangeDraftKey), pr.GetDraft())
+
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), pr.GetHead().GetRef())
+	attrs.PutStr(string(semconv.VCSRefHeadTypeKey), AttributeVCSRefHeadTypeBranch)
+	attrs.PutStr(string(semconv.VCSRefHeadRevisionKey), pr.GetHead().GetSHA())
+	attrs.PutStr(string(semconv.VCSRefBaseNameKey), pr.GetBase().GetRef())
+	attrs.PutStr(string(semconv.VCSRefBaseTypeKey), AttributeVCSRefBaseTypeBranch)
+	attrs.PutStr(string(semconv.VCSRefBaseRevisionKey), pr.GetBase().GetSHA())
+}
+
+// changeState maps the state of a pull request to the `vcs.change.state`
+// enum values. GitHub reports merged pull requests as closed.
+func changeState(pr *github.PullRequest) string {
+	switch {
+	case pr.GetMerged():
+		return AttributeVCSChangeStateMerged
+	case pr.GetState() == "closed":
+		return AttributeVCSChangeStateClosed
+	default:
+		return AttributeVCSChangeStateOpen
+	}
+}
+
+// splitRef splits a fully qualified git ref into its short name and
+// `vcs.ref.type`.
+func splitRef(ref string) (name, refType string, err error) {
+	switch {
+	case strings.HasPrefix(ref, refHeadsPrefix):
+		return strings.TrimPrefix(ref, refHeadsPrefix), AttributeVCSRefTypeBranch, nil
+	case strings.HasPrefix(ref, refTagsPrefix):
+		return strings.TrimPrefix(ref, refTagsPrefix), AttributeVCSRefTypeTag, nil
+	default:
+		return "", "", fmt.Errorf("unsupported ref %q", ref)
+	}
+}
+
+// setLogRecord sets the fields common to all log records created from
+// webhook events.
+func setLogRecord(record plog.LogRecord, eventName string, ts time.Time) {
+	record.SetEventName(eventName)
+	record.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Now()))
+	record.SetSeverityNumber(plog.SeverityNumberInfo)
+	record.SetSeverityText(plog.SeverityNumberInfo.String())
+}
@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/plogtest"
+)
+
+func TestHandleLogEventsWithGoldenFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputFile    string
+		expectedFile string
+		handle       func(*githubTracesReceiver, []byte) (plog.Logs, error)
+	}{
+		{
+			name:         "pull request",
+			inputFile:    "pull-request-opened.json",
+			expectedFile: "pull-request-expected.yaml",
+			handle: func(r *githubTracesReceiver, data []byte) (plog.Logs, error) {
+				var event github.PullRequestEvent
+				if err := json.Unmarshal(data, &event); err != nil {
+					return plog.Logs{}, err
+				}
+				return r.handlePullRequest(&event)
+			},
+		},
+		{
+			name:         "pull request review",
+			inputFile:    "pull-request-review-submitted.json",
+			expectedFile: "pull-request-review-expected.yaml",
+			handle: func(r *githubTracesReceiver, data []byte) (plog.Logs, error) {
+				var event github.PullRequestReviewEvent
+				if err := json.Unmarshal(data, &event); err != nil {
+					return plog.Logs{}, err
+				}
+				return r.handlePullRequestReview(&event)
+			},
+		},
+		{
+			name:         "push",
+			inputFile:    "push.json",
+			expectedFile: "push-expected.yaml",
+			handle: func(r *githubTracesReceiver, data []byte) (plog.Logs, error) {
+				var event github.PushEvent
+				if err := json.Unmarshal(data, &event); err != nil {
+					return plog.Logs{}, err
+				}
+				return r.handlePush(&event)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defaultConfig := createDefaultConfig().(*Config)
+			defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumertest.NewNop())
+			require.NoError(t, err, "failed to create receiver")
+
+			data, err := os.ReadFile(filepath.Join("testdata", tt.inputFile))
+			require.NoError(t, err, "Failed to read test data file")
+
+			logs, err := tt.handle(receiver, data)
+			require.NoError(t, err, "Failed to handle event")
+
+			expectedFile := filepath.Join("testdata", tt.expectedFile)
+
+			// Uncomment the following line to update the golden file
+			// golden.WriteLogs(t, expectedFile, logs)
+
+			expectedLogs, err := golden.ReadLogs(expectedFile)
+			require.NoError(t, err, "Failed to read expected logs")
+
+			require.NoError(t, plogtest.CompareLogs(expectedLogs, logs, plogtest.IgnoreObservedTimestamp()))
+		})
+	}
+}
+
+func TestHandleLogsReq(t *testing.T) {
+	pr, err := os.ReadFile(filepath.Join("testdata", "pull-request-opened.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc           string
+		logsEnabled    bool
+		expectedStatus int
+		expectedLogs   int
+	}{
+		{
+			desc:           "logs signal enabled",
+			logsEnabled:    true,
+			expectedStatus: http.StatusOK,
+			expectedLogs:   1,
+		},
+		{
+			desc:           "logs signal not enabled",
+			expectedStatus: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), createDefaultConfig().(*Config), consumertest.NewNop())
+			require.NoError(t, err)
+
+			sink := new(consumertest.LogsSink)
+			if test.logsEnabled {
+				receiver.logConsumer = sink
+			}
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(pr))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(github.EventTypeHeader, "pull_request")
+
+			w := httptest.NewRecorder()
+			receiver.handleReq(w, req)
+
+			require.Equal(t, test.expectedStatus, w.Result().StatusCode)
+			require.Equal(t, test.expectedLogs, sink.LogRecordCount())
+		})
+	}
+}
+
+func TestChangeState(t *testing.T) {
+	tests := []struct {
+		name     string
+		pr       *github.PullRequest
+		expected string
+	}{
+		{
+			name:     "open",
+			pr:       &github.PullRequest{State: github.Ptr("open")},
+			expected: AttributeVCSChangeStateOpen,
+		},
+		{
+			name:     "closed without merge",
+			pr:       &github.PullRequest{State: github.Ptr("closed"), Merged: github.Ptr(false)},
+			expected: AttributeVCSChangeStateClosed,
+		},
+		{
+			name:     "merged",
+			pr:       &github.PullRequest{State: github.Ptr("closed"), Merged: github.Ptr(true)},
+			expected: AttributeVCSChangeStateMerged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, changeState(tt.pr))
+		})
+	}
+}
+
+func TestSplitRef(t *testing.T) {
+	tests := []struct {
+		ref          string
+		expectedName string
+		expectedType string
+		wantErr      bool
+	}{
+		{ref: "refs/heads/main", expectedName: "main", expectedType: AttributeVCSRefTypeBranch},
+		{ref: "refs/heads/feat/logs", expectedName: "feat/logs", expectedType: AttributeVCSRefTypeBranch},
+		{ref: "refs/tags/v1.0.0", expectedName: "v1.0.0", expectedType: AttributeVCSRefTypeTag},
+		{ref: "main", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			name, refType, err := splitRef(tt.ref)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedName, name)
+			require.Equal(t, tt.expectedType, refType)
+		})
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+// Metrics derived from workflow_run and workflow_job webhook events.
+const (
+	metricPipelineRunDuration          = "cicd.pipeline.run.duration"
+	metricPipelineTaskRunDuration      = "cicd.pipeline.task.run.duration"
+	metricPipelineTaskRunQueueDuration = "cicd.pipeline.task.run.queue.duration"
+)
+
+// handleMetricsReq records the metrics of a completed workflow run or job and
+// passes them to the metrics consumer. Metrics are recorded alongside the
+// traces of the same event, so failures are logged rather than returned to
+// GitHub.
+func (gtr *githubTracesReceiver) handleMetricsReq(ctx context.Context, event any) {
+	if gtr.metricsConsumer == nil {
+		return
+	}
+
+	// queued and in progress jobs are tracked so their number can be reported
+	// by scrapeJobState.
+	if e, ok := event.(*github.WorkflowJobEvent); ok {
+		gtr.jobs.update(e, time.Now())
+	}
+
+	var md pmetric.Metrics
+	switch e := event.(type) {
+	case *github.WorkflowRunEvent:
+		if strings.ToLower(e.GetWorkflowRun().GetStatus()) != "completed" {
+			return
+		}
+		md = gtr.handleWorkflowRunMetrics(e)
+	case *github.WorkflowJobEvent:
+		if strings.ToLower(e.GetWorkflowJob().GetStatus()) != "completed" {
+			return
+		}
+		md = gtr.handleWorkflowJobMetrics(e)
+	default:
+		return
+	}
+
+	// every webhook metric can be disabled
+	if md.DataPointCount() == 0 {
+		return
+	}
+
+	ctx = gtr.obsrecv.StartMetricsOp(ctx)
+	err := gtr.metricsConsumer.ConsumeMetrics(ctx, md)
+	if err != nil {
+		gtr.logger.Error("failed to consume webhook metrics", zap.Error(err))
+	}
+	gtr.obsrecv.EndMetricsOp(ctx, "protobuf", md.DataPointCount(), err)
+}
+
+// handleWorkflowRunMetrics records the duration and result of a completed
+// workflow run.
+func (gtr *githubTracesReceiver) handleWorkflowRunMetrics(e *github.WorkflowRunEvent) pmetric.Metrics {
+	run := e.GetWorkflowRun()
+	ts := pcommon.NewTimestampFromTime(run.GetUpdatedAt().Time)
+	result := pipelineResult(run.GetConclusion())
+
+	gtr.mbMu.Lock()
+	defer gtr.mbMu.Unlock()
+
+	gtr.mb.RecordCicdPipelineRunCountDataPoint(ts, 1, e.GetRepo().GetName(), run.GetName(), run.GetHeadBranch(), result)
+	md := gtr.emitWebhookMetrics(ts)
+
+	if gtr.cfg.WebHook.Metrics.Histograms.PipelineRunDuration.Enabled {
+		attrs := pcommon.NewMap()
+		attrs.PutStr(string(semconv.VCSRepositoryNameKey), e.GetRepo().GetName())
+		attrs.PutStr(string(semconv.CICDPipelineNameKey), run.GetName())
+		attrs.PutStr(string(semconv.VCSRefHeadNameKey), run.GetHeadBranch())
+		attrs.PutStr(string(semconv.CICDPipelineResultKey), result)
+
+		gtr.recordDuration(
+			webhookMetricSlice(md),
+			metricPipelineRunDuration,
+			"Duration of a completed workflow run.",
+			ts,
+			run.GetUpdatedAt().Sub(run.GetRunStartedAt().Time),
+			attrs,
+		)
+	}
+
+	return md
+}
+
+// handleWorkflowJobMetrics records the duration, queue time, and result of a
+// completed workflow job.
+func (gtr *githubTracesReceiver) handleWorkflowJobMetrics(e *github.WorkflowJobEvent) pmetric.Metrics {
+	job := e.GetWorkflowJob()
+	ts := pcommon.NewTimestampFromTime(job.GetCompletedAt().Time)
+	labels := sortedRunnerLabels(job.Labels)
+	result := pipelineResult(job.GetConclusion())
+
+	gtr.mbMu.Lock()
+	defer gtr.mbMu.Unlock()
+
+	gtr.mb.RecordCicdPipelineTaskRunCountDataPoint(
+		ts,
+		1,
+		e.GetRepo().GetName(),
+		job.GetWorkflowName(),
+		job.GetHeadBranch(),
+		runnerLabelsValue(labels),
+		result,
+	)
+	md := gtr.emitWebhookMetrics(ts)
+
+	histograms := gtr.cfg.WebHook.Metrics.Histograms
+
+	attrs := pcommon.NewMap()
+	attrs.PutStr(string(semconv.VCSRepositoryNameKey), e.GetRepo().GetName())
+	attrs.PutStr(string(semconv.CICDPipelineNameKey), job.GetWorkflowName())
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), job.GetHeadBranch())
+	putRunnerLabels(attrs, labels)
+
+	// queue time is recorded before the result is known, so it is not
+	// attributed by result.
+	if histograms.PipelineTaskRunQueueDuration.Enabled && !job.GetStartedAt().IsZero() && !job.GetCreatedAt().IsZero() {
+		gtr.recordDuration(
+			webhookMetricSlice(md),
+			metricPipelineTaskRunQueueDuration,
+			"Time a workflow job waited for a runner before starting.",
+			pcommon.NewTimestampFromTime(job.GetStartedAt().Time),
+			job.GetStartedAt().Sub(job.GetCreatedAt().Time),
+			attrs,
+		)
+	}
+
+	if histograms.PipelineTaskRunDuration.Enabled {
+		attrs.PutStr(string(semconv.CICDPipelineResultKey), result)
+
+		gtr.recordDuration(
+			webhookMetricSlice(md),
+			metricPipelineTaskRunDuration,
+			"Duration of a completed workflow job.",
+			ts,
+			job.GetCompletedAt().Sub(job.GetStartedAt().Time),
+			attrs,
+		)
+	}
+
+	return md
+}
+
+// emitWebhookMetrics returns the metrics recorded by the metrics builder for
+// a single webhook event, all of them observed at ts. The caller must hold
+// mbMu.
+func (gtr *githubTracesReceiver) emitWebhookMetrics(ts pcommon.Timestamp) pmetric.Metrics {
+	rb := gtr.mb.NewResourceBuilder()
+	rb.SetVcsVendorName("github")
+
+	return gtr.mb.Emit(metadata.WithResource(rb.Emit()), metadata.WithStartTimeOverride(ts))
+}
+
+// webhookMetricSlice returns the slice the histograms of a webhook event are
+// appended to. The histograms are not generated by mdatagen, so they are
+// appended to the metrics emitted by the metrics builder, or to new metrics
+// when every metric of the builder is disabled.
+func webhookMetricSlice(md pmetric.Metrics) pmetric.MetricSlice {
+	if md.ResourceMetrics().Len() > 0 {
+		return md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	}
+
+	return appendWebhookMetrics(md)
+}
+
+// appendWebhookMetrics appends the resource and scope of the webhook metrics
+// to md and returns the slice new metrics are appended to.
+func appendWebhookMetrics(md pmetric.Metrics) pmetric.MetricSlice {
+	rm := md.ResourceMetrics().AppendEmpty()
+	rm.Resource().Attributes().PutStr(AttributeVCSVendorName, "github")
+
+	sm := rm.ScopeMetrics().AppendEmpty()
+	sm.Scope().SetName(metadata.ScopeName)
+
+	return sm.Metrics()
+}
+
+// recordDuration appends a delta histogram holding a single observation of d,
+// in seconds, to metrics.
+func (gtr *githubTracesReceiver) recordDuration(
+	metrics pmetric.MetricSlice,
+	name string,
+	description string,
+	ts pcommon.Timestamp,
+	d time.Duration,
+	attrs pcommon.Map,
+) {
+	// Durations can be negative when GitHub reports incomplete timestamps,
+	// such as jobs which were skipped before ever starting.
+	secs := max(d.Seconds(), 0)
+	bounds := gtr.cfg.WebHook.Metrics.HistogramBuckets
+
+	m := metrics.AppendEmpty()
+	m.SetName(name)
+	m.SetDescription(description)
+	m.SetUnit("s")
+
+	h := m.SetEmptyHistogram()
+	h.SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
+
+	dp := h.DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(ts)
+	dp.SetTimestamp(ts)
+	attrs.CopyTo(dp.Attributes())
+
+	counts := make([]uint64, len(bounds)+1)
+	counts[sort.SearchFloat64s(bounds, secs)]++
+
+	dp.ExplicitBounds().FromRaw(bounds)
+	dp.BucketCounts().FromRaw(counts)
+	dp.SetCount(1)
+	dp.SetSum(secs)
+	dp.SetMin(secs)
+	dp.SetMax(secs)
+}
+
+// sortedRunnerLabels returns the lower case runner labels of a job, sorted so
+// that jobs requesting the same labels in a different order share a time
+// series.
+func sortedRunnerLabels(labels []string) []string {
+	sorted := make([]string, 0, len(labels))
+	for _, label := range labels {
+		sorted = append(sorted, strings.ToLower(label))
+	}
+	slices.Sort(sorted)
+
+	return sorted
+}
+
+// runnerLabelsValue returns the runner labels, as returned by
+// sortedRunnerLabels, as the value of a slice attribute of the metrics
+// builder.
+func runnerLabelsValue(labels []string) []any {
+	value := make([]any, 0, len(labels))
+	for _, label := range labels {
+		value = append(value, label)
+	}
+
+	return value
+}
+
+// putRunnerLabels sets the runner labels, as returned by sortedRunnerLabels,
+// on attrs.
+func putRunnerLabels(attrs pcommon.Map, labels []string) {
+	if len(labels) == 0 {
+		return
+	}
+
+	s := attrs.PutEmptySlice(string(AttributeCICDPipelineWorkerLabelsKey))
+	s.EnsureCapacity(len(labels))
+	for _, label := range labels {
+		s.AppendEmpty().SetStr(label)
+	}
+}
+
+// pipelineResult maps a GitHub workflow run or job conclusion to the
+// `cicd.pipeline.result` semantic convention values. Conclusions without an
+// equivalent value are passed through as is.
+func pipelineResult(conclusion string) string {
+	switch strings.ToLower(conclusion) {
+	case "success":
+		return semconv.CICDPipelineResultSuccess.Value.AsString()
+	case "failure":
+		return semconv.CICDPipelineResultFailure.Value.AsString()
+	case "cancelled":
+		return semconv.CICDPipelineResultCancellation.Value.AsString()
+	case "timed_out":
+		return semconv.CICDPipelineResultTimeout.Value.AsString()
+	case "skipped":
+		return semconv.CICDPipelineResultSkip.Value.AsString()
+	case "startup_failure":
+		return semconv.CICDPipelineResultError.Value.AsString()
+	default:
+		return strings.ToLower(conclusion)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/pmetrictest"
+)
+
+func TestHandleMetricEventsWithGoldenFile(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputFile    string
+		expectedFile string
+		handle       func(*githubTracesReceiver, []byte) (pmetric.Metrics, error)
+	}{
+		{
+			name:         "workflow run",
+			inputFile:    "workflow-run-completed.json",
+			expectedFile: "workflow-run-metrics-expected.yaml",
+			handle: func(r *githubTracesReceiver, data []byte) (pmetric.Metrics, error) {
+				var event github.WorkflowRunEvent
+				if err := json.Unmarshal(data, &event); err != nil {
+					return pmetric.Metrics{}, err
+				}
+				return r.handleWorkflowRunMetrics(&event), nil
+			},
+		},
+		{
+			name:         "workflow job",
+			inputFile:    "workflow-job-completed.json",
+			expectedFile: "workflow-job-metrics-expected.yaml",
+			handle: func(r *githubTracesReceiver, data []byte) (pmetric.Metrics, error) {
+				var event github.WorkflowJobEvent
+				if err := json.Unmarshal(data, &event); err != nil {
+					return pmetric.Metrics{}, err
+				}
+				return r.handleWorkflowJobMetrics(&event), nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defaultConfig := createDefaultConfig().(*Config)
+			defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumertest.NewNop())
+			require.NoError(t, err, "failed to create receiver")
+
+			data, err := os.ReadFile(filepath.Join("testdata", tt.inputFile))
+			require.NoError(t, err, "Failed to read test data file")
+
+			metrics, err := tt.handle(receiver, data)
+			require.NoError(t, err, "Failed to handle event")
+
+			expectedFile := filepath.Join("testdata", tt.expectedFile)
+
+			// Uncomment the following line to update the golden file
+			// golden.WriteMetrics(t, expectedFile, metrics)
+
+			expectedMetrics, err := golden.ReadMetrics(expectedFile)
+			require.NoError(t, err, "Failed to read expected metrics")
+
+			require.NoError(t, pmetrictest.CompareMetrics(expectedMetrics, metrics, pmetrictest.IgnoreStartTimestamp(), pmetrictest.IgnoreTimestamp()))
+		})
+	}
+}
+
+func TestHandleMetricsReq(t *testing.T) {
+	run, err := os.ReadFile(filepath.Join("testdata", "workflow-run-completed.json"))
+	require.NoError(t, err)
+	job, err := os.ReadFile(filepath.Join("testdata", "workflow-job-completed.json"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc           string
+		event          string
+		body           []byte
+		metricsEnabled bool
+		configure      func(*Config)
+		expectedPoints int
+	}{
+		{
+			desc:           "workflow run completed",
+			event:          "workflow_run",
+			body:           run,
+			metricsEnabled: true,
+			expectedPoints: 2,
+		},
+		{
+			desc:           "workflow job completed",
+			event:          "workflow_job",
+			body:           job,
+			metricsEnabled: true,
+			expectedPoints: 3,
+		},
+		{
+			desc:           "workflow job in progress is skipped",
+			event:          "workflow_job",
+			body:           bytes.Replace(job, []byte(`"status": "completed"`), []byte(`"status": "in_progress"`), 1),
+			metricsEnabled: true,
+		},
+		{
+			desc:           "workflow job count disabled",
+			event:          "workflow_job",
+			body:           job,
+			metricsEnabled: true,
+			configure: func(cfg *Config) {
+				cfg.Metrics.CicdPipelineTaskRunCount.Enabled = false
+			},
+			expectedPoints: 2,
+		},
+		{
+			desc:           "workflow run metrics disabled",
+			event:          "workflow_run",
+			body:           run,
+			metricsEnabled: true,
+			configure: func(cfg *Config) {
+				cfg.Metrics.CicdPipelineRunCount.Enabled = false
+				cfg.WebHook.Metrics.Histograms.PipelineRunDuration.Enabled = false
+			},
+		},
+		{
+			desc:  "metrics not enabled",
+			event: "workflow_run",
+			body:  run,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			if test.configure != nil {
+				test.configure(cfg)
+			}
+			receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+			require.NoError(t, err)
+
+			sink := new(consumertest.MetricsSink)
+			if test.metricsEnabled {
+				receiver.metricsConsumer = sink
+			}
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(test.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(github.EventTypeHeader, test.event)
+
+			w := httptest.NewRecorder()
+			receiver.handleReq(w, req)
+
+			require.Equal(t, test.expectedPoints, sink.DataPointCount())
+		})
+	}
+}
+
+func TestPipelineResult(t *testing.T) {
+	tests := []struct {
+		conclusion string
+		expected   string
+	}{
+		{conclusion: "success", expected: "success"},
+		{conclusion: "failure", expected: "failure"},
+		{conclusion: "cancelled", expected: "cancellation"},
+		{conclusion: "timed_out", expected: "timeout"},
+		{conclusion: "skipped", expected: "skip"},
+		{conclusion: "startup_failure", expected: "error"},
+		{conclusion: "neutral", expected: "neutral"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.conclusion, func(t *testing.T) {
+			require.Equal(t, tt.expected, pipelineResult(tt.conclusion))
+		})
+	}
+}
@@ -146,6 +146,40 @@ const (
 	AttributeVCSRefHeadRevisionAuthorEmail = "vcs.ref.head.revision.author.email" // GitHub's Head Revision Author Email
 	AttributeVCSRepositoryOwner            = "vcs.repository.owner"               // GitHub's Owner Login
 	AttributeVCSVendorName                 = "vcs.vendor.name"                    // GitHub
+
+	// The following attributes are used by the logs signal for pull request,
+	// pull request review, and push events. They are not part of the semantic
+	// conventions yet.
+	AttributeGitHubEventActionKey          = attribute.Key("github.event.action")            // GitHub's Event Action
+	AttributeVCSChangeURLFullKey           = attribute.Key("vcs.change.url.full")            // GitHub's Pull Request URL
+	AttributeVCSChangeAuthorLoginKey       = attribute.Key("vcs.change.author.login")        // GitHub's Pull Request Author Login
+	AttributeVCSChangeDraftKey             = attribute.Key("vcs.change.draft")               // GitHub's Pull Request Draft
+	AttributeVCSChangeSenderLoginKey       = attribute.Key("vcs.change.sender.login")        // GitHub's Event Sender Login
+	AttributeVCSChangeReviewIDKey          = attribute.Key("vcs.change.review.id")           // GitHub's Review ID
+	AttributeVCSChangeReviewStateKey       = attribute.Key("vcs.change.review.state")        // GitHub's Review State
+	AttributeVCSChangeReviewAuthorLoginKey = attribute.Key("vcs.change.review.author.login") // GitHub's Review Author Login
+	AttributeVCSChangeReviewURLFullKey     = attribute.Key("vcs.change.review.url.full")     // GitHub's Review URL
+	AttributeVCSRefHeadPreviousRevisionKey = attribute.Key("vcs.ref.head.previous_revision") // GitHub's Push Before SHA
+	AttributeVCSRefPushCreatedKey          = attribute.Key("vcs.ref.push.created")           // GitHub's Push Created
+	AttributeVCSRefPushDeletedKey          = attribute.Key("vcs.ref.push.deleted")           // GitHub's Push Deleted
+	AttributeVCSRefPushForcedKey           = attribute.Key("vcs.ref.push.forced")            // GitHub's Push Forced
+	AttributeVCSRefPushCommitCountKey      = attribute.Key("vcs.ref.push.commit.count")      // GitHub's Push Commit Count
+	AttributeVCSRefPushSenderLoginKey      = attribute.Key("vcs.ref.push.sender.login")      // GitHub's Push Sender Login
+	AttributeVCSRefPushCompareURLFullKey   = attribute.Key("vcs.ref.push.compare.url.full")  // GitHub's Push Compare URL
+
+	// The following attributes are used by the deployment spans created from
+	// deployment_status events. They are not part of the semantic conventions
+	// yet.
+	AttributeDeploymentCreatorLoginKey = attribute.Key("deployment.creator.login") // GitHub's Deployment Creator Login
+	AttributeDeploymentURLFullKey      = attribute.Key("deployment.url.full")      // GitHub's Deployment Status Log URL
+	AttributeGitHubDeploymentStateKey  = attribute.Key("github.deployment.state")  // GitHub's Deployment Status State
+	AttributeGitHubDeploymentTaskKey   = attribute.Key("github.deployment.task")   // GitHub's Deployment Task
+
+	// The following attributes are set on the span events created from check
+	// run annotations.
+	AttributeGitHubCheckRunAnnotationLevelKey   = attribute.Key("github.check_run.annotation.level")   // GitHub's Annotation Level
+	AttributeGitHubCheckRunAnnotationTitleKey   = attribute.Key("github.check_run.annotation.title")   // GitHub's Annotation Title
+	AttributeGitHubCheckRunAnnotationMessageKey = attribute.Key("github.check_run.annotation.message") // GitHub's Annotation Message
 )
 
 // getWorkflowRunAttrs returns a pcommon.Map of attributes for the Workflow Run
@@ -195,9 +229,8 @@ func (gtr *githubTracesReceiver) getWorkflowRunAttrs(resource pcommon.Resource,
 
 	// Previous attempt URL
 	if e.GetWorkflowRun().GetPreviousAttemptURL() != "" {
-		// Replace API URL with regular GitHub URL to match expected test output
-		prevAttemptURL := e.GetWorkflowRun().GetPreviousAttemptURL()
-		prevAttemptURL = strings.Replace(prevAttemptURL, "api.github.com/repos", "github.com", 1)
+		// Replace API URL with the URL of the run attempt in the web interface
+		prevAttemptURL := gtr.cfg.ReplaceAPIURL(e.GetWorkflowRun().GetPreviousAttemptURL())
 		attrs.PutStr(string(AttributeCICDPipelineRunPreviousAttemptURLFullKey), prevAttemptURL)
 	}
 
@@ -341,9 +374,3 @@ func (gtr *githubTracesReceiver) getServiceName(customProps any, repoName string
 func formatString(input string) string {
 	return strings.ToLower(strings.ReplaceAll(input, "_", "-"))
 }
-
-// replaceAPIURL replaces a GitHub API URL with the HTML URL version.
-func replaceAPIURL(apiURL string) (htmlURL string) {
-	// TODO: Support enterpise server configuration with custom domain.
-	return strings.Replace(apiURL, "api.github.com/repos", "github.com", 1)
-}
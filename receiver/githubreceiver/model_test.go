@@ -97,29 +97,3 @@ func TestSplitRefWorkflowPath(t *testing.T) {
 		})
 	}
 }
-
-func TestReplaceAPIURL(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		expected string
-	}{
-		{
-			name:     "converts api.github.com URL to html URL",
-			input:    "https://api.github.com/repos/open-telemetry/opentelemetry-collector-contrib/pull/1234",
-			expected: "https://github.com/open-telemetry/opentelemetry-collector-contrib/pull/1234",
-		},
-		{
-			name:     "converts api.github.com workflow URL to html URL",
-			input:    "https://api.github.com/repos/open-telemetry/opentelemetry-collector-contrib/actions/runs/1234",
-			expected: "https://github.com/open-telemetry/opentelemetry-collector-contrib/actions/runs/1234",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := replaceAPIURL(tt.input)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
@@ -0,0 +1,356 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	// checkpointsStorageKey is the storage key the time of the last successful
+	// poll of each repository is persisted under.
+	checkpointsStorageKey = "checkpoints"
+
+	// polledRunsStorageKey is the storage key the attempts of the workflow
+	// runs processed by the poller are persisted under.
+	polledRunsStorageKey = "runs"
+
+	// polledRunsCacheSize is the number of workflow run attempts remembered
+	// as processed by the poller.
+	polledRunsCacheSize = 10000
+
+	// pollRunMaxDuration is how long a workflow run can take, including its
+	// re-runs, and still be polled. Workflow runs are searched by creation
+	// time while they are polled by the time they were last updated.
+	pollRunMaxDuration = 24 * time.Hour
+)
+
+// poller polls the GitHub workflow runs API for the workflow runs which
+// completed since the last poll, for organizations where webhooks cannot be
+// registered. The workflow runs and their jobs are processed as workflow_run
+// and workflow_job events, so the resulting traces are identical to the ones
+// of the webhook. The attempts of the workflow runs processed are recorded the
+// same way as webhook deliveries, so that the workflow runs processed before a
+// poll failed are not processed again when it is retried.
+type poller struct {
+	gtr         *githubTracesReceiver
+	cfg         WebHookPoller
+	client      *github.Client
+	storage     storage.Client
+	checkpoints map[string]time.Time
+	runs        *lru.Cache[string, struct{}]
+	logger      *zap.Logger
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+}
+
+func newPoller(gtr *githubTracesReceiver, client *github.Client) (*poller, error) {
+	runs, err := lru.New[string, struct{}](polledRunsCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &poller{
+		gtr:         gtr,
+		cfg:         gtr.cfg.WebHook.Poller,
+		client:      client,
+		checkpoints: map[string]time.Time{},
+		runs:        runs,
+		logger:      gtr.logger,
+	}, nil
+}
+
+// start restores the persisted checkpoints, if any, and polls for completed
+// workflow runs at the configured interval until shutdown.
+func (p *poller) start(ctx context.Context, host component.Host) error {
+	if err := p.loadCheckpoints(ctx, host); err != nil {
+		return err
+	}
+
+	ctx, p.cancel = context.WithCancel(context.Background())
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		ticker := time.NewTicker(p.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			if err := p.poll(ctx, time.Now()); err != nil {
+				p.logger.Warn("failed to poll workflow runs", zap.Error(err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// loadCheckpoints restores the checkpoints and processed workflow runs
+// persisted by a previous run from the storage extension, which is then used
+// to persist them after each poll. The workflow runs of a repository without a
+// checkpoint are backfilled within the lookback.
+func (p *poller) loadCheckpoints(ctx context.Context, host component.Host) error {
+	if p.cfg.Storage == nil {
+		return nil
+	}
+
+	client, err := getStorageClient(ctx, host, *p.cfg.Storage, p.gtr.settings.ID, "poller")
+	if err != nil {
+		return err
+	}
+	p.storage = client
+
+	data, err := client.Get(ctx, checkpointsStorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to get checkpoints from storage: %w", err)
+	}
+	if data != nil {
+		if err := json.Unmarshal(data, &p.checkpoints); err != nil {
+			return fmt.Errorf("failed to unmarshal checkpoints: %w", err)
+		}
+	}
+
+	data, err = client.Get(ctx, polledRunsStorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow runs from storage: %w", err)
+	}
+	if data == nil {
+		return nil
+	}
+
+	var keys []string
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return fmt.Errorf("failed to unmarshal workflow runs: %w", err)
+	}
+
+	// keys are persisted from the oldest to the newest workflow run, so
+	// adding them in order preserves the eviction order.
+	for _, key := range keys {
+		p.runs.Add(key, struct{}{})
+	}
+
+	return nil
+}
+
+// shutdown stops polling and closes the storage client.
+func (p *poller) shutdown(ctx context.Context) error {
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.wg.Wait()
+
+	if p.storage == nil {
+		return nil
+	}
+
+	return p.storage.Close(ctx)
+}
+
+// poll processes the workflow runs of each repository which completed since
+// its checkpoint. The checkpoint of a repository is only moved forward once
+// all its workflow runs are processed, so that workflow runs failing to be
+// processed are retried on the next poll, while the workflow runs already
+// processed are skipped.
+func (p *poller) poll(ctx context.Context, now time.Time) error {
+	repos, err := p.repositories(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, repo := range repos {
+		if err := p.pollRepository(ctx, repo, now); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("failed to poll repository %s: %w", repo.GetFullName(), err))
+			continue
+		}
+		p.checkpoints[repo.GetName()] = now
+	}
+
+	if p.storage != nil {
+		errs = multierr.Append(errs, p.persist(ctx))
+	}
+
+	return errs
+}
+
+// persist writes the checkpoints and the processed workflow runs to the
+// storage extension.
+func (p *poller) persist(ctx context.Context) error {
+	checkpoints, err := json.Marshal(p.checkpoints)
+	if err != nil {
+		return err
+	}
+
+	runs, err := json.Marshal(p.runs.Keys())
+	if err != nil {
+		return err
+	}
+
+	return multierr.Combine(
+		p.storage.Set(ctx, checkpointsStorageKey, checkpoints),
+		p.storage.Set(ctx, polledRunsStorageKey, runs),
+	)
+}
+
+// repositories returns the configured repositories, or all the repositories
+// of the organization when none are configured.
+func (p *poller) repositories(ctx context.Context) ([]*github.Repository, error) {
+	if len(p.cfg.Repositories) > 0 {
+		repos := make([]*github.Repository, 0, len(p.cfg.Repositories))
+		for _, name := range p.cfg.Repositories {
+			repo, _, err := p.client.Repositories.Get(ctx, p.cfg.Owner, name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get repository %s: %w", name, err)
+			}
+			repos = append(repos, repo)
+		}
+		return repos, nil
+	}
+
+	var all []*github.Repository
+	opts := &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for {
+		repos, resp, err := p.client.Repositories.ListByOrg(ctx, p.cfg.Owner, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list repositories: %w", err)
+		}
+
+		all = append(all, repos...)
+		if resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// pollRepository processes the workflow runs of a repository which completed
+// after its checkpoint and up to now.
+func (p *poller) pollRepository(ctx context.Context, repo *github.Repository, now time.Time) error {
+	since, ok := p.checkpoints[repo.GetName()]
+	if !ok {
+		since = now.Add(-p.cfg.Lookback)
+	}
+
+	// a workflow run which is re-run is updated long after it was created,
+	// so runs are searched from before the checkpoint.
+	opts := &github.ListWorkflowRunsOptions{
+		Status:      "completed",
+		Created:     ">=" + since.Add(-pollRunMaxDuration).UTC().Format(time.RFC3339),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	var errs error
+	for {
+		runs, resp, err := p.client.Actions.ListRepositoryWorkflowRuns(ctx, repo.GetOwner().GetLogin(), repo.GetName(), opts)
+		if err != nil {
+			return multierr.Append(errs, fmt.Errorf("failed to list workflow runs: %w", err))
+		}
+
+		for _, run := range runs.WorkflowRuns {
+			updated := run.GetUpdatedAt().Time
+			if !updated.After(since) || updated.After(now) {
+				continue
+			}
+
+			// a re-run is a new attempt of the same workflow run
+			key := polledRunKey(run)
+			if p.runs.Contains(key) {
+				p.logger.Debug("workflow run already processed, skipping...", zap.Int64("run_id", run.GetID()), zap.Int("run_attempt", run.GetRunAttempt()))
+				continue
+			}
+
+			if err := p.processRun(ctx, repo, run); err != nil {
+				errs = multierr.Append(errs, fmt.Errorf("failed to process workflow run %d: %w", run.GetID(), err))
+				continue
+			}
+			p.runs.Add(key, struct{}{})
+		}
+
+		if resp.NextPage == 0 {
+			return errs
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// polledRunKey returns the key the attempt of a workflow run is recorded as
+// processed under.
+func polledRunKey(run *github.WorkflowRun) string {
+	return fmt.Sprintf("%d/%d", run.GetID(), run.GetRunAttempt())
+}
+
+// processRun processes the jobs of a workflow run as workflow_job events and
+// then the workflow run as a workflow_run event, in the order GitHub delivers
+// them.
+func (p *poller) processRun(ctx context.Context, repo *github.Repository, run *github.WorkflowRun) error {
+	// GitHub sends the events of a workflow run on behalf of its actor
+	sender := run.GetActor()
+
+	event := &github.WorkflowRunEvent{
+		Action:      github.Ptr("completed"),
+		WorkflowRun: run,
+		Repo:        repo,
+		Sender:      sender,
+	}
+
+	jobs, err := p.gtr.getWorkflowRunJobs(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow run jobs: %w", err)
+	}
+
+	for _, job := range jobs {
+		err := p.handle(ctx, &github.WorkflowJobEvent{
+			Action:      github.Ptr("completed"),
+			WorkflowJob: job,
+			Repo:        repo,
+			Sender:      sender,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := p.handle(ctx, event); err != nil {
+		return err
+	}
+
+	p.logger.Debug("processed workflow run", zap.String("repository", repo.GetFullName()), zap.Int64("run_id", run.GetID()))
+	return nil
+}
+
+// handle processes a polled event the same way as an event received by the
+// webhook.
+func (p *poller) handle(ctx context.Context, event any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.gtr.cfg.WebHook.Path, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	w := &discardResponseWriter{header: http.Header{}, status: http.StatusOK}
+	p.gtr.handleWorkflowEvent(w, req, event)
+	if w.status >= http.StatusInternalServerError {
+		return fmt.Errorf("failed to process event, status code %d", w.status)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+const workflowRunsPath = "/api/v3/repos/open-telemetry/open-telemetry-otel-collector/actions/runs"
+
+// newWorkflowRunsServer serves the repository, workflow runs and jobs APIs
+// from the workflow_run and workflow_job webhook payloads, recording the
+// creation date the workflow runs are searched from.
+func newWorkflowRunsServer(t *testing.T) (*httptest.Server, *[]string) {
+	runEvent := readWorkflowRunEvent(t)
+
+	data, err := os.ReadFile(filepath.Join("testdata", "workflow-job-completed.json"))
+	require.NoError(t, err)
+
+	var jobEvent github.WorkflowJobEvent
+	require.NoError(t, json.Unmarshal(data, &jobEvent))
+
+	var created []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/orgs/open-telemetry/repos":
+			_ = json.NewEncoder(w).Encode([]*github.Repository{runEvent.GetRepo()})
+		case "/api/v3/repos/open-telemetry/open-telemetry-otel-collector":
+			_ = json.NewEncoder(w).Encode(runEvent.GetRepo())
+		case workflowRunsPath:
+			require.Equal(t, "completed", r.URL.Query().Get("status"))
+			created = append(created, r.URL.Query().Get("created"))
+			_ = json.NewEncoder(w).Encode(&github.WorkflowRuns{
+				TotalCount:   github.Ptr(1),
+				WorkflowRuns: []*github.WorkflowRun{runEvent.GetWorkflowRun()},
+			})
+		case workflowRunsPath + "/14460881260/attempts/13/jobs":
+			_ = json.NewEncoder(w).Encode(&github.Jobs{
+				TotalCount: github.Ptr(1),
+				Jobs:       []*github.WorkflowJob{jobEvent.GetWorkflowJob()},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server, &created
+}
+
+func newTestPoller(t *testing.T, serverURL string, sink *consumertest.TracesSink) *poller {
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.Client = &confighttp.ClientConfig{Endpoint: serverURL}
+	cfg.WebHook.Poller = WebHookPoller{
+		Enabled:  true,
+		Owner:    "open-telemetry",
+		Interval: time.Minute,
+		Lookback: time.Hour,
+	}
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+
+	receiver.ghClient, err = receiver.newGitHubClient(context.Background(), nil)
+	require.NoError(t, err)
+
+	p, err := newPoller(receiver, receiver.ghClient)
+	require.NoError(t, err)
+
+	return p
+}
+
+func TestPoll(t *testing.T) {
+	server, created := newWorkflowRunsServer(t)
+	defer server.Close()
+
+	updated := readWorkflowRunEvent(t).GetWorkflowRun().GetUpdatedAt().Time
+	now := updated.Add(time.Minute)
+
+	sink := new(consumertest.TracesSink)
+	p := newTestPoller(t, server.URL, sink)
+
+	require.NoError(t, p.poll(context.Background(), now))
+	require.Equal(t, map[string]time.Time{"open-telemetry-otel-collector": now}, p.checkpoints)
+
+	// workflow runs re-run within a day of their creation are searched for
+	expected := ">=" + now.Add(-time.Hour-pollRunMaxDuration).UTC().Format(time.RFC3339)
+	require.Equal(t, []string{expected}, *created)
+
+	// the job and the workflow run are processed in the order GitHub sends
+	// them, into the same traces as the webhook events
+	require.Len(t, sink.AllTraces(), 2)
+	for i, file := range []string{"workflow-job-expected.yaml", "workflow-run-expected.yaml"} {
+		expectedTraces, err := golden.ReadTraces(filepath.Join("testdata", file))
+		require.NoError(t, err)
+		require.NoError(t, ptracetest.CompareTraces(expectedTraces, sink.AllTraces()[i]))
+	}
+
+	// the workflow run is not processed again once past the checkpoint
+	require.NoError(t, p.poll(context.Background(), now.Add(time.Minute)))
+	require.Len(t, sink.AllTraces(), 2)
+
+	// nor when the checkpoint was held back by another workflow run failing
+	// to be processed
+	p.checkpoints = map[string]time.Time{}
+	require.NoError(t, p.poll(context.Background(), now.Add(2*time.Minute)))
+	require.Len(t, sink.AllTraces(), 2)
+}
+
+func TestPollRepositories(t *testing.T) {
+	server, _ := newWorkflowRunsServer(t)
+	defer server.Close()
+
+	updated := readWorkflowRunEvent(t).GetWorkflowRun().GetUpdatedAt().Time
+	now := updated.Add(time.Minute)
+
+	// the configured repositories are polled
+	sink := new(consumertest.TracesSink)
+	p := newTestPoller(t, server.URL, sink)
+	p.cfg.Repositories = []string{"open-telemetry-otel-collector"}
+
+	require.NoError(t, p.poll(context.Background(), now))
+	require.Len(t, sink.AllTraces(), 2)
+
+	// the checkpoint of a repository failing to be polled is not moved forward
+	p = newTestPoller(t, server.URL, new(consumertest.TracesSink))
+	p.cfg.Repositories = []string{"missing"}
+
+	require.Error(t, p.poll(context.Background(), now))
+	require.Empty(t, p.checkpoints)
+}
+
+func TestPollLookback(t *testing.T) {
+	server, _ := newWorkflowRunsServer(t)
+	defer server.Close()
+
+	updated := readWorkflowRunEvent(t).GetWorkflowRun().GetUpdatedAt().Time
+
+	// the workflow run completed before the lookback is not backfilled
+	sink := new(consumertest.TracesSink)
+	p := newTestPoller(t, server.URL, sink)
+
+	require.NoError(t, p.poll(context.Background(), updated.Add(2*time.Hour)))
+	require.Empty(t, sink.AllTraces())
+}
+
+func TestPollCheckpointPersistence(t *testing.T) {
+	server, _ := newWorkflowRunsServer(t)
+	defer server.Close()
+
+	now := readWorkflowRunEvent(t).GetWorkflowRun().GetUpdatedAt().Time.Add(time.Minute)
+
+	storageID := component.MustNewID("memory_storage")
+	host := &storageHost{extensions: map[component.ID]component.Component{
+		storageID: &memoryStorage{data: map[string][]byte{}},
+	}}
+
+	first := newTestPoller(t, server.URL, new(consumertest.TracesSink))
+	first.cfg.Storage = &storageID
+	require.NoError(t, first.loadCheckpoints(context.Background(), host))
+	require.Empty(t, first.checkpoints)
+
+	require.NoError(t, first.poll(context.Background(), now))
+	require.NoError(t, first.shutdown(context.Background()))
+
+	// the checkpoints of the last poll are restored after a restart
+	sink := new(consumertest.TracesSink)
+	second := newTestPoller(t, server.URL, sink)
+	second.cfg.Storage = &storageID
+	require.NoError(t, second.loadCheckpoints(context.Background(), host))
+	require.True(t, now.Equal(second.checkpoints["open-telemetry-otel-collector"]))
+	require.True(t, second.runs.Contains("14460881260/13"))
+
+	require.NoError(t, second.poll(context.Background(), now.Add(time.Minute)))
+	require.Empty(t, sink.AllTraces())
+}
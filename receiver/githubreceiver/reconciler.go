@@ -0,0 +1,351 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension/xextension/storage"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	reconcileModeRedeliver = "redeliver"
+	reconcileModeProcess   = "process"
+
+	// checkpointStorageKey is the storage key the time of the last successful
+	// poll is persisted under.
+	checkpointStorageKey = "checkpoint"
+)
+
+// deliveryAttempts is the recovery state of a failed delivery.
+type deliveryAttempts struct {
+	// count is the number of times the delivery was redelivered or
+	// processed.
+	count int
+	// done is set once the delivery was processed, or abandoned after too
+	// many attempts, so it is no longer recovered.
+	done bool
+}
+
+// reconciler polls the GitHub hook deliveries API for deliveries which failed,
+// such as while the collector was unavailable, and recovers them by either
+// requesting GitHub to redeliver them or by processing their payload directly.
+type reconciler struct {
+	gtr        *githubTracesReceiver
+	cfg        WebHookReconciler
+	client     *github.Client
+	storage    storage.Client
+	checkpoint time.Time
+	attempts   map[string]*deliveryAttempts
+	logger     *zap.Logger
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+func newReconciler(gtr *githubTracesReceiver, client *github.Client) *reconciler {
+	return &reconciler{
+		gtr:      gtr,
+		cfg:      gtr.cfg.WebHook.Reconciler,
+		client:   client,
+		attempts: map[string]*deliveryAttempts{},
+		logger:   gtr.logger,
+	}
+}
+
+// start restores the persisted checkpoint, if any, and polls for failed
+// deliveries at the configured interval until shutdown.
+func (r *reconciler) start(ctx context.Context, host component.Host) error {
+	if err := r.loadCheckpoint(ctx, host); err != nil {
+		return err
+	}
+
+	ctx, r.cancel = context.WithCancel(context.Background())
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			if err := r.reconcile(ctx, time.Now()); err != nil {
+				r.logger.Warn("failed to reconcile webhook deliveries", zap.Error(err))
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// loadCheckpoint restores the checkpoint persisted by a previous run from the
+// storage extension, which is then used to persist the checkpoint after each
+// poll. Without a persisted checkpoint, the deliveries within the lookback
+// are reconciled.
+func (r *reconciler) loadCheckpoint(ctx context.Context, host component.Host) error {
+	r.checkpoint = time.Now().Add(-r.cfg.Lookback)
+
+	if r.cfg.Storage == nil {
+		return nil
+	}
+
+	client, err := getStorageClient(ctx, host, *r.cfg.Storage, r.gtr.settings.ID, "reconciler")
+	if err != nil {
+		return err
+	}
+	r.storage = client
+
+	data, err := client.Get(ctx, checkpointStorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to get checkpoint from storage: %w", err)
+	}
+	if data == nil {
+		return nil
+	}
+
+	if err := r.checkpoint.UnmarshalText(data); err != nil {
+		return fmt.Errorf("failed to unmarshal checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+// shutdown stops polling and closes the storage client.
+func (r *reconciler) shutdown(ctx context.Context) error {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+
+	if r.storage == nil {
+		return nil
+	}
+
+	return r.storage.Close(ctx)
+}
+
+// reconcile recovers the deliveries which failed since the checkpoint. The
+// checkpoint is only moved forward once all failed deliveries are recovered,
+// so that deliveries failing to be recovered are retried on the next poll.
+// A delivery is recovered at most MaxAttempts times, after which it is
+// abandoned and no longer holds back the checkpoint.
+func (r *reconciler) reconcile(ctx context.Context, now time.Time) error {
+	failed, err := r.failedDeliveries(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the attempts of deliveries which are no longer listed, because they
+	// succeeded or are older than the checkpoint, are forgotten.
+	attempts := make(map[string]*deliveryAttempts, len(failed))
+
+	var errs error
+	for _, d := range failed {
+		a, ok := r.attempts[d.GetGUID()]
+		if !ok {
+			a = &deliveryAttempts{}
+		}
+		attempts[d.GetGUID()] = a
+
+		if a.done {
+			continue
+		}
+
+		// redelivered deliveries are listed again when the redelivery fails
+		if a.count >= r.cfg.MaxAttempts {
+			r.abandon(d, a, nil)
+			continue
+		}
+
+		a.count++
+		err := r.recover(ctx, d)
+		switch {
+		case err == nil:
+			// processed deliveries remain listed as failed by GitHub
+			a.done = r.cfg.Mode == reconcileModeProcess
+		case a.count >= r.cfg.MaxAttempts:
+			r.abandon(d, a, err)
+		default:
+			errs = multierr.Append(errs, fmt.Errorf("failed to recover delivery %s: %w", d.GetGUID(), err))
+		}
+	}
+	r.attempts = attempts
+
+	if errs != nil {
+		return errs
+	}
+
+	r.checkpoint = now
+	if r.storage != nil {
+		data, err := now.MarshalText()
+		if err != nil {
+			return err
+		}
+		return r.storage.Set(ctx, checkpointStorageKey, data)
+	}
+
+	return nil
+}
+
+// abandon gives up on recovering a delivery which failed to be recovered
+// MaxAttempts times.
+func (r *reconciler) abandon(d *github.HookDelivery, a *deliveryAttempts, err error) {
+	a.done = true
+	r.logger.Warn(
+		"giving up on webhook delivery",
+		zap.String("delivery_id", d.GetGUID()),
+		zap.String("event", d.GetEvent()),
+		zap.Int("attempts", a.count),
+		zap.Error(err),
+	)
+}
+
+// failedDeliveries returns the most recent delivery of each event which was
+// delivered since the checkpoint without ever succeeding.
+func (r *reconciler) failedDeliveries(ctx context.Context) ([]*github.HookDelivery, error) {
+	var failed []*github.HookDelivery
+	seen := map[string]bool{}
+
+	opts := &github.ListCursorOptions{PerPage: 100}
+	for {
+		deliveries, resp, err := r.listDeliveries(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+		}
+
+		// deliveries are listed from the newest to the oldest, so the first
+		// delivery seen for an event is its latest attempt. Redeliveries share
+		// the GUID of the original delivery.
+		done := false
+		for _, d := range deliveries {
+			if d.GetDeliveredAt().Before(r.checkpoint) {
+				done = true
+				break
+			}
+
+			if seen[d.GetGUID()] {
+				continue
+			}
+			seen[d.GetGUID()] = true
+
+			if isDeliveryFailed(d.GetStatusCode()) {
+				failed = append(failed, d)
+			}
+		}
+
+		if done || resp.Cursor == "" {
+			return failed, nil
+		}
+		opts.Cursor = resp.Cursor
+	}
+}
+
+// isDeliveryFailed returns true when a delivery failed in a way that would
+// succeed if retried, such as when the collector could not be reached or
+// failed to export the telemetry. Deliveries rejected with a 4xx, such as for
+// unsupported events, are not retried.
+func isDeliveryFailed(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// recover recovers a failed delivery according to the configured mode.
+func (r *reconciler) recover(ctx context.Context, d *github.HookDelivery) error {
+	if r.cfg.Mode == reconcileModeRedeliver {
+		err := r.redeliverDelivery(ctx, d.GetID())
+		// GitHub responds with 202 Accepted, which go-github reports as an
+		// AcceptedError.
+		var accepted *github.AcceptedError
+		if errors.As(err, &accepted) {
+			err = nil
+		}
+		if err == nil {
+			r.logger.Debug("requested redelivery of webhook delivery", zap.String("delivery_id", d.GetGUID()))
+		}
+		return err
+	}
+
+	full, err := r.getDelivery(ctx, d.GetID())
+	if err != nil {
+		return err
+	}
+
+	// the payload retrieved from the API is not the payload GitHub signed,
+	// so it is processed without validating the signature.
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.gtr.cfg.WebHook.Path, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	w := &discardResponseWriter{header: http.Header{}, status: http.StatusOK}
+	r.gtr.handlePayload(w, req, full.GetEvent(), full.GetGUID(), full.GetRequest().GetRawPayload())
+	if w.status >= http.StatusInternalServerError {
+		return fmt.Errorf("failed to process delivery, status code %d", w.status)
+	}
+
+	r.logger.Debug("processed webhook delivery", zap.String("delivery_id", d.GetGUID()))
+	return nil
+}
+
+func (r *reconciler) listDeliveries(ctx context.Context, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+	if r.cfg.Repository == "" {
+		return r.client.Organizations.ListHookDeliveries(ctx, r.cfg.Owner, r.cfg.HookID, opts)
+	}
+	return r.client.Repositories.ListHookDeliveries(ctx, r.cfg.Owner, r.cfg.Repository, r.cfg.HookID, opts)
+}
+
+func (r *reconciler) getDelivery(ctx context.Context, id int64) (*github.HookDelivery, error) {
+	var d *github.HookDelivery
+	var err error
+	if r.cfg.Repository == "" {
+		d, _, err = r.client.Organizations.GetHookDelivery(ctx, r.cfg.Owner, r.cfg.HookID, id)
+	} else {
+		d, _, err = r.client.Repositories.GetHookDelivery(ctx, r.cfg.Owner, r.cfg.Repository, r.cfg.HookID, id)
+	}
+	return d, err
+}
+
+func (r *reconciler) redeliverDelivery(ctx context.Context, id int64) error {
+	var err error
+	if r.cfg.Repository == "" {
+		_, _, err = r.client.Organizations.RedeliverHookDelivery(ctx, r.cfg.Owner, r.cfg.HookID, id)
+	} else {
+		_, _, err = r.client.Repositories.RedeliverHookDelivery(ctx, r.cfg.Owner, r.cfg.Repository, r.cfg.HookID, id)
+	}
+	return err
+}
+
+// discardResponseWriter is the http.ResponseWriter of deliveries processed
+// outside of a request, by the reconciler, the poller or from the webhook queue,
+// recording only the status code.
+type discardResponseWriter struct {
+	header http.Header
+	status int
+}
+
+func (w *discardResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (*discardResponseWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *discardResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+const deliveriesPath = "/api/v3/repos/liatrio/otel-testing/hooks/123456/deliveries"
+
+// newDeliveriesServer serves the hook deliveries API of a repository webhook,
+// recording the deliveries redelivery was requested for.
+func newDeliveriesServer(t *testing.T, now time.Time, payload []byte) (*httptest.Server, func() []int64) {
+	var mu sync.Mutex
+	var redelivered []int64
+
+	deliveries := []*github.HookDelivery{
+		// succeeded
+		{ID: github.Ptr(int64(6)), GUID: github.Ptr("f"), StatusCode: github.Ptr(200), DeliveredAt: &github.Timestamp{Time: now.Add(-1 * time.Minute)}},
+		// failed, then succeeded on redelivery
+		{ID: github.Ptr(int64(5)), GUID: github.Ptr("e"), StatusCode: github.Ptr(200), DeliveredAt: &github.Timestamp{Time: now.Add(-2 * time.Minute)}},
+		{ID: github.Ptr(int64(4)), GUID: github.Ptr("e"), StatusCode: github.Ptr(502), DeliveredAt: &github.Timestamp{Time: now.Add(-3 * time.Minute)}},
+		// rejected as unsupported
+		{ID: github.Ptr(int64(3)), GUID: github.Ptr("c"), StatusCode: github.Ptr(400), DeliveredAt: &github.Timestamp{Time: now.Add(-4 * time.Minute)}},
+		// collector unreachable
+		{ID: github.Ptr(int64(2)), GUID: github.Ptr("b"), StatusCode: github.Ptr(0), Event: github.Ptr("workflow_run"), DeliveredAt: &github.Timestamp{Time: now.Add(-5 * time.Minute)}},
+		// failed before the checkpoint
+		{ID: github.Ptr(int64(1)), GUID: github.Ptr("a"), StatusCode: github.Ptr(503), DeliveredAt: &github.Timestamp{Time: now.Add(-2 * time.Hour)}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == deliveriesPath:
+			// serve the deliveries over two pages
+			page := deliveries[:3]
+			if r.URL.Query().Get("cursor") == "next" {
+				page = deliveries[3:]
+			} else {
+				w.Header().Set("Link", fmt.Sprintf(`<%s%s?cursor=next>; rel="next"`, "http://"+r.Host, deliveriesPath))
+			}
+			_ = json.NewEncoder(w).Encode(page)
+		case r.Method == http.MethodGet && r.URL.Path == deliveriesPath+"/2":
+			raw := json.RawMessage(payload)
+			_ = json.NewEncoder(w).Encode(&github.HookDelivery{
+				ID:      github.Ptr(int64(2)),
+				GUID:    github.Ptr("b"),
+				Event:   github.Ptr("workflow_run"),
+				Request: &github.HookRequest{RawPayload: &raw},
+			})
+		case r.Method == http.MethodPost:
+			var id int64
+			_, err := fmt.Sscanf(r.URL.Path, deliveriesPath+"/%d/attempts", &id)
+			require.NoError(t, err)
+
+			mu.Lock()
+			redelivered = append(redelivered, id)
+			mu.Unlock()
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	return server, func() []int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		return redelivered
+	}
+}
+
+func newTestReconciler(t *testing.T, serverURL string, mode string, sink consumer.Traces) *reconciler {
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.Client = &confighttp.ClientConfig{Endpoint: serverURL}
+	cfg.WebHook.Reconciler = WebHookReconciler{
+		Enabled:     true,
+		Owner:       "liatrio",
+		Repository:  "otel-testing",
+		HookID:      123456,
+		Interval:    time.Minute,
+		Lookback:    time.Hour,
+		MaxAttempts: 2,
+		Mode:        mode,
+	}
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+	require.NoError(t, err)
+
+	client, err := receiver.newGitHubClient(context.Background(), nil)
+	require.NoError(t, err)
+
+	return newReconciler(receiver, client)
+}
+
+func TestReconcileRedeliver(t *testing.T) {
+	now := time.Now()
+	server, redelivered := newDeliveriesServer(t, now, nil)
+	defer server.Close()
+
+	r := newTestReconciler(t, server.URL, reconcileModeRedeliver, new(consumertest.TracesSink))
+	r.checkpoint = now.Add(-time.Hour)
+
+	require.NoError(t, r.reconcile(context.Background(), now))
+	require.Equal(t, []int64{2}, redelivered())
+	require.Equal(t, now, r.checkpoint)
+}
+
+func TestReconcileProcess(t *testing.T) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "workflow-run-completed.json"))
+	require.NoError(t, err)
+
+	now := time.Now()
+	server, redelivered := newDeliveriesServer(t, now, payload)
+	defer server.Close()
+
+	sink := new(consumertest.TracesSink)
+	r := newTestReconciler(t, server.URL, reconcileModeProcess, sink)
+	r.checkpoint = now.Add(-time.Hour)
+
+	require.NoError(t, r.reconcile(context.Background(), now))
+	require.Empty(t, redelivered())
+	require.Equal(t, 1, sink.SpanCount())
+
+	// the processed delivery is deduplicated if GitHub redelivers it as well
+	require.True(t, r.gtr.deliveries.cache.Contains("b"))
+}
+
+func TestReconcileMaxAttempts(t *testing.T) {
+	payload, err := os.ReadFile(filepath.Join("testdata", "workflow-run-completed.json"))
+	require.NoError(t, err)
+
+	now := time.Now()
+	server, redelivered := newDeliveriesServer(t, now, payload)
+	defer server.Close()
+
+	// a delivery failing to be processed holds back the checkpoint until it
+	// is abandoned
+	r := newTestReconciler(t, server.URL, reconcileModeProcess, consumertest.NewErr(errors.New("export failed")))
+	checkpoint := now.Add(-time.Hour)
+	r.checkpoint = checkpoint
+
+	require.Error(t, r.reconcile(context.Background(), now))
+	require.Equal(t, checkpoint, r.checkpoint)
+
+	require.NoError(t, r.reconcile(context.Background(), now))
+	require.Equal(t, now, r.checkpoint)
+	require.True(t, r.attempts["b"].done)
+
+	// a delivery which keeps failing once redelivered is no longer
+	// redelivered once abandoned
+	r = newTestReconciler(t, server.URL, reconcileModeRedeliver, new(consumertest.TracesSink))
+	for range 3 {
+		r.checkpoint = checkpoint
+		require.NoError(t, r.reconcile(context.Background(), now))
+	}
+	require.Equal(t, []int64{2, 2}, redelivered())
+	require.Equal(t, 2, r.attempts["b"].count)
+	require.True(t, r.attempts["b"].done)
+}
+
+func TestReconcileCheckpointPersistence(t *testing.T) {
+	now := time.Now()
+	server, _ := newDeliveriesServer(t, now, nil)
+	defer server.Close()
+
+	storageID := component.MustNewID("memory_storage")
+	host := &storageHost{extensions: map[component.ID]component.Component{
+		storageID: &memoryStorage{data: map[string][]byte{}},
+	}}
+
+	// without a persisted checkpoint, the lookback is used
+	first := newTestReconciler(t, server.URL, reconcileModeRedeliver, new(consumertest.TracesSink))
+	first.cfg.Storage = &storageID
+	require.NoError(t, first.loadCheckpoint(context.Background(), host))
+	require.WithinDuration(t, now.Add(-time.Hour), first.checkpoint, time.Minute)
+
+	require.NoError(t, first.reconcile(context.Background(), now))
+	require.NoError(t, first.shutdown(context.Background()))
+
+	// the checkpoint of the last poll is restored after a restart
+	second := newTestReconciler(t, server.URL, reconcileModeRedeliver, new(consumertest.TracesSink))
+	second.cfg.Storage = &storageID
+	require.NoError(t, second.loadCheckpoint(context.Background(), host))
+	require.True(t, now.Equal(second.checkpoint))
+}
+
+func TestIsDeliveryFailed(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		expected   bool
+	}{
+		{statusCode: 0, expected: true},
+		{statusCode: http.StatusOK},
+		{statusCode: http.StatusNoContent},
+		{statusCode: http.StatusBadRequest},
+		{statusCode: http.StatusTooManyRequests, expected: true},
+		{statusCode: http.StatusInternalServerError, expected: true},
+		{statusCode: http.StatusBadGateway, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(http.StatusText(test.statusCode), func(t *testing.T) {
+			require.Equal(t, test.expected, isDeliveryFailed(test.statusCode))
+		})
+	}
+}
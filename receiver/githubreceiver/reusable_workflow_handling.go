@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+)
+
+// reusableWorkflowSeparator separates the name of the job calling a reusable
+// workflow from the names of the jobs of the called workflow, eg.
+// `build / test`.
+const reusableWorkflowSeparator = " / "
+
+var errWorkflowRunJobs = errors.New("failed to get workflow run jobs")
+
+// reusableWorkflowCallers returns the prefixes of the job name naming the jobs
+// which called reusable workflows, from the outermost to the innermost. eg.
+// `deploy / build / test` returns `deploy` and `deploy / build`.
+func reusableWorkflowCallers(jobName string) []string {
+	parts := strings.Split(jobName, reusableWorkflowSeparator)
+	callers := make([]string, 0, len(parts)-1)
+	for i := 1; i < len(parts); i++ {
+		callers = append(callers, strings.Join(parts[:i], reusableWorkflowSeparator))
+	}
+	return callers
+}
+
+// nestsReusableWorkflows returns true when jobs of reusable workflows are
+// nested under a span of their called workflow. The called workflow spans are
+// created from the jobs of the workflow run, which are retrieved from the
+// GitHub API, so a client must be configured.
+func (gtr *githubTracesReceiver) nestsReusableWorkflows() bool {
+	return gtr.ghClient != nil
+}
+
+// newReusableWorkflowSpanID creates a deterministic Span ID for a called
+// workflow based on the provided runID, runAttempt, and the name of the
+// calling job. The `workflow` prefix differentiates the input from the job
+// based span IDs.
+func newReusableWorkflowSpanID(runID int64, runAttempt int, caller string) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("workflow%d%d%s", runID, runAttempt, caller)
+	return newSpanID(input)
+}
+
+// jobParentSpanID returns the span ID of the parent of a job span, which is
+// the span of the innermost called workflow for jobs of reusable workflows
+// and the root span of the workflow run otherwise. The parent only depends on
+// the job name, so it is the same whichever event of the run arrives first.
+func (gtr *githubTracesReceiver) jobParentSpanID(runID int64, runAttempt int, jobName string) (pcommon.SpanID, error) {
+	if gtr.nestsReusableWorkflows() {
+		if callers := reusableWorkflowCallers(jobName); len(callers) > 0 {
+			return newReusableWorkflowSpanID(runID, runAttempt, callers[len(callers)-1])
+		}
+	}
+
+	return newParentSpanID(runID, runAttempt)
+}
+
+// reusableWorkflow aggregates the jobs of a called workflow.
+type reusableWorkflow struct {
+	start      time.Time
+	end        time.Time
+	conclusion string
+}
+
+// add extends the called workflow with the provided job.
+func (w *reusableWorkflow) add(job *github.WorkflowJob) {
+	if start := job.GetCreatedAt().Time; w.start.IsZero() || start.Before(w.start) {
+		w.start = start
+	}
+	if end := job.GetCompletedAt().Time; end.After(w.end) {
+		w.end = end
+	}
+
+	// a failed job fails the called workflow, a cancelled job cancels it
+	// unless another job failed.
+	switch conclusion := strings.ToLower(job.GetConclusion()); {
+	case conclusion == "failure":
+		w.conclusion = conclusion
+	case conclusion == "cancelled" && w.conclusion != "failure":
+		w.conclusion = conclusion
+	case w.conclusion == "":
+		w.conclusion = "success"
+	}
+}
+
+// createReusableWorkflowSpans creates a span for each reusable workflow called
+// by the workflow run, between the root span and the spans of the jobs of the
+// called workflow. Nested reusable workflows are parented to the span of the
+// workflow calling them.
+func (gtr *githubTracesReceiver) createReusableWorkflowSpans(
+	ctx context.Context,
+	resourceSpans ptrace.ResourceSpans,
+	event *github.WorkflowRunEvent,
+	traceID pcommon.TraceID,
+) error {
+	runID := event.GetWorkflowRun().GetID()
+	runAttempt := event.GetWorkflowRun().GetRunAttempt()
+
+	var callers []string
+	workflows := map[string]*reusableWorkflow{}
+	// the jobs of called workflows are parented to their spans, so the
+	// delivery fails to be retried rather than leaving them orphaned.
+	jobs, err := gtr.getWorkflowRunJobs(ctx, event)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errWorkflowRunJobs, err)
+	}
+
+	for _, job := range jobs {
+		for _, caller := range reusableWorkflowCallers(job.GetName()) {
+			if _, ok := workflows[caller]; !ok {
+				callers = append(callers, caller)
+				workflows[caller] = &reusableWorkflow{}
+			}
+			workflows[caller].add(job)
+		}
+	}
+
+	if len(callers) == 0 {
+		return nil
+	}
+
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	for _, caller := range callers {
+		workflow := workflows[caller]
+
+		spanID, err := newReusableWorkflowSpanID(runID, runAttempt, caller)
+		if err != nil {
+			return fmt.Errorf("failed to generate reusable workflow span ID: %w", err)
+		}
+
+		// the parent of a called workflow is the workflow calling it, which
+		// is determined the same way as for its jobs.
+		parentSpanID, err := gtr.jobParentSpanID(runID, runAttempt, caller)
+		if err != nil {
+			return fmt.Errorf("failed to generate reusable workflow parent span ID: %w", err)
+		}
+
+		span := scopeSpans.Spans().AppendEmpty()
+		span.SetTraceID(traceID)
+		span.SetParentSpanID(parentSpanID)
+		span.SetSpanID(spanID)
+		span.SetName(caller)
+		span.SetKind(ptrace.SpanKindServer)
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(workflow.start))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(workflow.end))
+
+		switch workflow.conclusion {
+		case "success":
+			span.Status().SetCode(ptrace.StatusCodeOk)
+		case "failure":
+			span.Status().SetCode(ptrace.StatusCodeError)
+		default:
+			span.Status().SetCode(ptrace.StatusCodeUnset)
+		}
+
+		span.Status().SetMessage(workflow.conclusion)
+	}
+
+	return nil
+}
+
+// getWorkflowRunJobs returns the jobs of the attempt of the workflow run.
+// Webhook payloads of workflow runs do not include their jobs, so they are
+// retrieved from the GitHub API. The jobs retrieved before an error occurred
+// are returned with it.
+func (gtr *githubTracesReceiver) getWorkflowRunJobs(ctx context.Context, event *github.WorkflowRunEvent) ([]*github.WorkflowJob, error) {
+	var all []*github.WorkflowJob
+	opt := &github.ListOptions{PerPage: 100}
+	for {
+		jobs, resp, err := gtr.ghClient.Actions.ListWorkflowJobsAttempt(
+			ctx,
+			event.GetRepo().GetOwner().GetLogin(),
+			event.GetRepo().GetName(),
+			event.GetWorkflowRun().GetID(),
+			int64(event.GetWorkflowRun().GetRunAttempt()),
+			opt,
+		)
+		if err != nil {
+			return all, err
+		}
+
+		all = append(all, jobs.Jobs...)
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return all, nil
+}
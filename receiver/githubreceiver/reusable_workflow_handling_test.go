@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+func TestReusableWorkflowCallers(t *testing.T) {
+	tests := []struct {
+		jobName  string
+		expected []string
+	}{
+		{
+			jobName:  "build",
+			expected: []string{},
+		},
+		{
+			jobName:  "build (ubuntu-latest, 1.24)",
+			expected: []string{},
+		},
+		{
+			jobName:  "build / test",
+			expected: []string{"build"},
+		},
+		{
+			jobName:  "deploy / build / test",
+			expected: []string{"deploy", "deploy / build"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.jobName, func(t *testing.T) {
+			require.Equal(t, test.expected, reusableWorkflowCallers(test.jobName))
+		})
+	}
+}
+
+func newReusableWorkflowReceiver(t *testing.T) *githubTracesReceiver {
+	now := time.Date(2025, 4, 16, 21, 0, 0, 0, time.UTC)
+	newJob := func(name, conclusion string, start, end time.Duration) *github.WorkflowJob {
+		return &github.WorkflowJob{
+			Name:        github.Ptr(name),
+			Conclusion:  github.Ptr(conclusion),
+			CreatedAt:   &github.Timestamp{Time: now.Add(start)},
+			CompletedAt: &github.Timestamp{Time: now.Add(end)},
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/repos/liatrio/otel-testing/actions/runs/1234/attempts/2/jobs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&github.Jobs{
+			Jobs: []*github.WorkflowJob{
+				newJob("lint", "success", 0, time.Minute),
+				newJob("deploy / build / compile", "success", time.Minute, 2*time.Minute),
+				newJob("deploy / build / test", "failure", time.Minute, 3*time.Minute),
+				newJob("deploy / publish", "skipped", 4*time.Minute, 4*time.Minute),
+			},
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.Client = &confighttp.ClientConfig{Endpoint: server.URL}
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	receiver.ghClient, err = receiver.newGitHubClient(context.Background(), nil)
+	require.NoError(t, err)
+
+	return receiver
+}
+
+func TestCreateReusableWorkflowSpans(t *testing.T) {
+	receiver := newReusableWorkflowReceiver(t)
+
+	event := &github.WorkflowRunEvent{
+		Repo: &github.Repository{
+			Name:  github.Ptr("otel-testing"),
+			Owner: &github.User{Login: github.Ptr("liatrio")},
+		},
+		WorkflowRun: &github.WorkflowRun{
+			ID:         github.Ptr(int64(1234)),
+			RunAttempt: github.Ptr(2),
+		},
+	}
+
+	traceID, err := newTraceID(1234, 2)
+	require.NoError(t, err)
+
+	resourceSpans := ptrace.NewTraces().ResourceSpans().AppendEmpty()
+	require.NoError(t, receiver.createReusableWorkflowSpans(context.Background(), resourceSpans, event, traceID))
+
+	spans := resourceSpans.ScopeSpans().At(0).Spans()
+	require.Equal(t, 2, spans.Len())
+
+	rootSpanID, err := newParentSpanID(1234, 2)
+	require.NoError(t, err)
+	deploySpanID, err := newReusableWorkflowSpanID(1234, 2, "deploy")
+	require.NoError(t, err)
+	buildSpanID, err := newReusableWorkflowSpanID(1234, 2, "deploy / build")
+	require.NoError(t, err)
+
+	// the outermost called workflow is parented to the root span
+	deploy := spans.At(0)
+	require.Equal(t, "deploy", deploy.Name())
+	require.Equal(t, traceID, deploy.TraceID())
+	require.Equal(t, deploySpanID, deploy.SpanID())
+	require.Equal(t, rootSpanID, deploy.ParentSpanID())
+	require.Equal(t, ptrace.StatusCodeError, deploy.Status().Code())
+	require.Equal(t, 3*time.Minute, deploy.EndTimestamp().AsTime().Sub(deploy.StartTimestamp().AsTime()))
+
+	// a nested called workflow is parented to the workflow calling it
+	build := spans.At(1)
+	require.Equal(t, "deploy / build", build.Name())
+	require.Equal(t, buildSpanID, build.SpanID())
+	require.Equal(t, deploySpanID, build.ParentSpanID())
+	require.Equal(t, ptrace.StatusCodeError, build.Status().Code())
+	require.Equal(t, 2*time.Minute, build.EndTimestamp().AsTime().Sub(build.StartTimestamp().AsTime()))
+}
+
+func TestJobParentSpanID(t *testing.T) {
+	rootSpanID, err := newParentSpanID(1234, 2)
+	require.NoError(t, err)
+	buildSpanID, err := newReusableWorkflowSpanID(1234, 2, "deploy / build")
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc     string
+		nested   bool
+		jobName  string
+		expected pcommon.SpanID
+	}{
+		{
+			desc:     "job of the workflow run",
+			nested:   true,
+			jobName:  "lint",
+			expected: rootSpanID,
+		},
+		{
+			desc:     "job of a called workflow",
+			nested:   true,
+			jobName:  "deploy / build / test",
+			expected: buildSpanID,
+		},
+		{
+			desc:     "job of a called workflow without a client",
+			jobName:  "deploy / build / test",
+			expected: rootSpanID,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			receiver := &githubTracesReceiver{cfg: createDefaultConfig().(*Config)}
+			if test.nested {
+				receiver = newReusableWorkflowReceiver(t)
+			}
+
+			spanID, err := receiver.jobParentSpanID(1234, 2, test.jobName)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, spanID)
+		})
+	}
+}
@@ -0,0 +1,629 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+)
+
+const (
+	// testCaseResultSkip is the `test.case.result.status` of skipped test
+	// cases, which the semantic conventions do not define a value for.
+	testCaseResultSkip = "skip"
+
+	// testResultsCacheSize is the number of workflow runs whose failed tests
+	// are remembered to detect flaky tests when the run is retried. The
+	// failed tests are kept in memory only, so retries after a restart are
+	// not detected as flaky.
+	testResultsCacheSize = 1000
+)
+
+// junitTestSuite is a `testsuite` element of a JUnit XML report, or the
+// `testsuites` element wrapping them.
+type junitTestSuite struct {
+	XMLName    xml.Name
+	Name       string           `xml:"name,attr"`
+	Time       string           `xml:"time,attr"`
+	Timestamp  string           `xml:"timestamp,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+	TestCases  []junitTestCase  `xml:"testcase"`
+}
+
+// junitTestCase is a `testcase` element of a JUnit XML report.
+type junitTestCase struct {
+	Name      string       `xml:"name,attr"`
+	ClassName string       `xml:"classname,attr"`
+	Time      string       `xml:"time,attr"`
+	Failure   *junitResult `xml:"failure"`
+	Error     *junitResult `xml:"error"`
+	Skipped   *junitResult `xml:"skipped"`
+}
+
+// junitResult is the `failure`, `error` or `skipped` element of a test case
+// which did not pass.
+type junitResult struct {
+	Message string `xml:"message,attr"`
+}
+
+// testSuiteResult is a test suite of the test results of a workflow run.
+type testSuiteResult struct {
+	// key identifies the test suite within the workflow run attempt, eg.
+	// `test-results/report.xml#0`.
+	key      string
+	name     string
+	start    time.Time
+	duration time.Duration
+	cases    []testCaseResult
+}
+
+// testCaseResult is a test case of a test suite.
+type testCaseResult struct {
+	name     string
+	status   string
+	message  string
+	duration time.Duration
+}
+
+// testCaseID identifies a test case across the attempts of a workflow run.
+type testCaseID struct {
+	suite string
+	name  string
+}
+
+// handleTestResultsReq queues the download of the test results of a completed
+// workflow run once the delivery is acknowledged. Test results are emitted
+// alongside the traces of the same event, so failures are logged rather than
+// returned to GitHub.
+func (gtr *githubTracesReceiver) handleTestResultsReq(event any) {
+	e, ok := event.(*github.WorkflowRunEvent)
+	if !ok || !gtr.cfg.WebHook.TestResults.Enabled || gtr.ghClient == nil {
+		return
+	}
+
+	if strings.ToLower(e.GetWorkflowRun().GetStatus()) != "completed" {
+		return
+	}
+
+	gtr.fetches.enqueue(func(ctx context.Context) {
+		gtr.fetchTestResults(ctx, e)
+	})
+}
+
+// fetchTestResults downloads the JUnit XML reports uploaded as artifacts of
+// the workflow run and passes their test suites and test cases to the traces
+// consumer, and their counts to the metrics consumer.
+func (gtr *githubTracesReceiver) fetchTestResults(ctx context.Context, e *github.WorkflowRunEvent) {
+	suites := gtr.getTestResults(ctx, e)
+	if len(suites) == 0 {
+		return
+	}
+
+	if gtr.traceConsumer != nil {
+		td, err := gtr.handleTestResultTraces(e, suites)
+		if err != nil {
+			gtr.logger.Error("failed to create test result spans", zap.Error(err))
+		} else {
+			tctx := gtr.obsrecv.StartTracesOp(ctx)
+			err = gtr.traceConsumer.ConsumeTraces(tctx, td)
+			if err != nil {
+				gtr.logger.Error("failed to consume test result spans", zap.Error(err))
+			}
+			gtr.obsrecv.EndTracesOp(tctx, "protobuf", td.SpanCount(), err)
+		}
+	}
+
+	flaky := gtr.updateFailedTests(e.GetWorkflowRun().GetID(), e.GetWorkflowRun().GetRunAttempt(), suites)
+	for _, id := range flaky {
+		gtr.logger.Info(
+			"test passed after failing on a previous attempt of the workflow run",
+			zap.String("repository", e.GetRepo().GetFullName()),
+			zap.Int64("run_id", e.GetWorkflowRun().GetID()),
+			zap.Int("run_attempt", e.GetWorkflowRun().GetRunAttempt()),
+			zap.String("test_suite", id.suite),
+			zap.String("test_case", id.name),
+		)
+	}
+
+	if gtr.metricsConsumer != nil {
+		md := gtr.handleTestResultMetrics(e, suites, flaky)
+		if md.DataPointCount() == 0 {
+			return
+		}
+
+		mctx := gtr.obsrecv.StartMetricsOp(ctx)
+		err := gtr.metricsConsumer.ConsumeMetrics(mctx, md)
+		if err != nil {
+			gtr.logger.Error("failed to consume test result metrics", zap.Error(err))
+		}
+		gtr.obsrecv.EndMetricsOp(mctx, "protobuf", md.DataPointCount(), err)
+	}
+}
+
+// getTestResults returns the test suites of the JUnit XML reports uploaded as
+// artifacts of the workflow run attempt. Artifacts which fail to download or
+// parse are logged and skipped.
+func (gtr *githubTracesReceiver) getTestResults(ctx context.Context, e *github.WorkflowRunEvent) []testSuiteResult {
+	limits := &testResultsLimits{
+		size:  gtr.cfg.WebHook.TestResults.MaxUncompressedSize,
+		files: gtr.cfg.WebHook.TestResults.MaxFiles,
+	}
+
+	var suites []testSuiteResult
+	for _, artifact := range gtr.getTestResultArtifacts(ctx, e) {
+		data, err := gtr.downloadArtifact(ctx, e, artifact)
+		if err != nil {
+			gtr.logger.Error("failed to download test results artifact", zap.String("artifact", artifact.GetName()), zap.Error(err))
+			continue
+		}
+
+		results, err := parseTestResultsArtifact(artifact.GetName(), data, e.GetWorkflowRun().GetRunStartedAt().Time, limits)
+		if err != nil {
+			gtr.logger.Error("failed to read test results artifact", zap.String("artifact", artifact.GetName()), zap.Error(err))
+			continue
+		}
+
+		suites = append(suites, results...)
+	}
+
+	return suites
+}
+
+// getTestResultArtifacts returns the artifacts of the workflow run attempt
+// whose name matches the configured pattern. Artifacts are listed for the
+// whole run, so artifacts created before the attempt started belong to a
+// previous attempt and are skipped.
+func (gtr *githubTracesReceiver) getTestResultArtifacts(ctx context.Context, e *github.WorkflowRunEvent) []*github.Artifact {
+	cfg := gtr.cfg.WebHook.TestResults
+	started := e.GetWorkflowRun().GetRunStartedAt().Time
+
+	var artifacts []*github.Artifact
+	opt := &github.ListOptions{PerPage: 100}
+	for {
+		list, resp, err := gtr.ghClient.Actions.ListWorkflowRunArtifacts(
+			ctx,
+			e.GetRepo().GetOwner().GetLogin(),
+			e.GetRepo().GetName(),
+			e.GetWorkflowRun().GetID(),
+			opt,
+		)
+		if err != nil {
+			gtr.logger.Error("failed to get workflow run artifacts", zap.Error(err))
+			return artifacts
+		}
+
+		for _, artifact := range list.Artifacts {
+			if matched, _ := path.Match(cfg.ArtifactPattern, artifact.GetName()); !matched {
+				continue
+			}
+			if artifact.GetExpired() || artifact.GetCreatedAt().Before(started) {
+				continue
+			}
+			if artifact.GetSizeInBytes() > cfg.MaxSize {
+				gtr.logger.Warn("test results artifact larger than max size, skipping...", zap.String("artifact", artifact.GetName()))
+				continue
+			}
+			artifacts = append(artifacts, artifact)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opt.Page = resp.NextPage
+	}
+
+	return artifacts
+}
+
+// downloadArtifact returns the content of the zip archive of the artifact.
+func (gtr *githubTracesReceiver) downloadArtifact(ctx context.Context, e *github.WorkflowRunEvent, artifact *github.Artifact) ([]byte, error) {
+	u, _, err := gtr.ghClient.Actions.DownloadArtifact(
+		ctx,
+		e.GetRepo().GetOwner().GetLogin(),
+		e.GetRepo().GetName(),
+		artifact.GetID(),
+		downloadMaxRedirects,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := gtr.download(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	maxSize := gtr.cfg.WebHook.TestResults.MaxSize
+	data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("artifact is larger than %d bytes", maxSize)
+	}
+
+	return data, nil
+}
+
+// testResultsLimits is what is left of the number of bytes and reports read
+// from the artifacts of a workflow run attempt once uncompressed, so that an
+// archive which expands far beyond its download size is not read in full.
+type testResultsLimits struct {
+	size  int64
+	files int
+}
+
+// parseTestResultsArtifact returns the test suites of the JUnit XML reports
+// in the zip archive of an artifact, counting the reports read against the
+// limits. Test suites without a timestamp are assumed to have started with the
+// workflow run attempt.
+func parseTestResultsArtifact(name string, data []byte, runStarted time.Time, limits *testResultsLimits) ([]testSuiteResult, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	var suites []testSuiteResult
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || !strings.EqualFold(path.Ext(file.Name), ".xml") {
+			continue
+		}
+
+		if limits.files <= 0 {
+			return nil, errors.New("test results contain too many reports")
+		}
+		limits.files--
+
+		report, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+
+		// the size declared in the archive is not trusted, so reports are
+		// read up to the bytes left.
+		xmlData, err := io.ReadAll(io.LimitReader(report, limits.size+1))
+		report.Close()
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(xmlData)) > limits.size {
+			return nil, errors.New("test results are too large once uncompressed")
+		}
+		limits.size -= int64(len(xmlData))
+
+		junitSuites, err := parseJUnit(bytes.NewReader(xmlData))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file.Name, err)
+		}
+
+		for i, suite := range junitSuites {
+			key := fmt.Sprintf("%s/%s#%d", name, file.Name, i)
+			suites = append(suites, newTestSuiteResult(key, suite, runStarted))
+		}
+	}
+
+	return suites, nil
+}
+
+// parseJUnit returns the test suites of a JUnit XML report, which is either a
+// single `testsuite` element or a `testsuites` element wrapping them. Nested
+// test suites are flattened.
+func parseJUnit(r io.Reader) ([]junitTestSuite, error) {
+	var root junitTestSuite
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, err
+	}
+
+	if root.XMLName.Local != "testsuite" && root.XMLName.Local != "testsuites" {
+		return nil, fmt.Errorf("unexpected root element %q", root.XMLName.Local)
+	}
+
+	var suites []junitTestSuite
+	var flatten func(suite junitTestSuite)
+	flatten = func(suite junitTestSuite) {
+		if suite.XMLName.Local == "testsuite" {
+			suites = append(suites, suite)
+		}
+		for _, child := range suite.TestSuites {
+			flatten(child)
+		}
+	}
+	flatten(root)
+
+	return suites, nil
+}
+
+// newTestSuiteResult converts a JUnit test suite. JUnit reports only the
+// duration of test cases, so they are laid out one after the other from the
+// start of their suite.
+func newTestSuiteResult(key string, suite junitTestSuite, runStarted time.Time) testSuiteResult {
+	result := testSuiteResult{
+		key:      key,
+		name:     suite.Name,
+		start:    parseJUnitTimestamp(suite.Timestamp, runStarted),
+		duration: parseJUnitDuration(suite.Time),
+	}
+
+	var total time.Duration
+	for _, tc := range suite.TestCases {
+		c := testCaseResult{
+			name:     tc.Name,
+			status:   semconv.TestCaseResultStatusPass.Value.AsString(),
+			duration: parseJUnitDuration(tc.Time),
+		}
+		if tc.ClassName != "" {
+			c.name = tc.ClassName + "." + tc.Name
+		}
+
+		switch {
+		case tc.Failure != nil:
+			c.status = semconv.TestCaseResultStatusFail.Value.AsString()
+			c.message = tc.Failure.Message
+		case tc.Error != nil:
+			c.status = semconv.TestCaseResultStatusFail.Value.AsString()
+			c.message = tc.Error.Message
+		case tc.Skipped != nil:
+			c.status = testCaseResultSkip
+			c.message = tc.Skipped.Message
+		}
+
+		total += c.duration
+		result.cases = append(result.cases, c)
+	}
+
+	if result.duration < total {
+		result.duration = total
+	}
+
+	return result
+}
+
+// parseJUnitDuration parses a duration in seconds, as reported by the `time`
+// attribute of JUnit reports. Some tools group the thousands with commas.
+// Invalid durations are zero.
+func parseJUnitDuration(value string) time.Duration {
+	secs, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+	if err != nil || secs < 0 {
+		return 0
+	}
+
+	return time.Duration(secs * float64(time.Second))
+}
+
+// parseJUnitTimestamp parses the `timestamp` attribute of a JUnit test suite,
+// which usually has no time zone and is then assumed to be UTC. When the
+// timestamp is missing or invalid, the provided time is returned.
+func parseJUnitTimestamp(value string, fallback time.Time) time.Time {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if ts, err := time.Parse(layout, value); err == nil {
+			return ts
+		}
+	}
+
+	return fallback
+}
+
+// newTestSpanID creates a deterministic Span ID for a test suite or test case
+// based on the provided runID, runAttempt, and the key identifying it within
+// the run attempt. The `test` prefix differentiates the input from the job
+// based span IDs.
+func newTestSpanID(runID int64, runAttempt int, key string) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("test%d%d%s", runID, runAttempt, key)
+	return newSpanID(input)
+}
+
+// handleTestResultTraces creates a span for each test suite, parented to the
+// root span of the workflow run, and a span for each of its test cases.
+func (gtr *githubTracesReceiver) handleTestResultTraces(e *github.WorkflowRunEvent, suites []testSuiteResult) (ptrace.Traces, error) {
+	t := ptrace.NewTraces()
+	r := t.ResourceSpans().AppendEmpty()
+
+	err := gtr.getWorkflowRunAttrs(r.Resource(), e)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to get workflow run attributes: %w", err)
+	}
+
+	runID := e.GetWorkflowRun().GetID()
+	runAttempt := e.GetWorkflowRun().GetRunAttempt()
+
+	traceID, err := newTraceID(runID, runAttempt)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to generate trace ID: %w", err)
+	}
+
+	rootSpanID, err := newParentSpanID(runID, runAttempt)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to generate root span ID: %w", err)
+	}
+
+	spans := r.ScopeSpans().AppendEmpty().Spans()
+	for _, suite := range suites {
+		suiteSpanID, err := newTestSpanID(runID, runAttempt, suite.key)
+		if err != nil {
+			return ptrace.Traces{}, fmt.Errorf("failed to generate test suite span ID: %w", err)
+		}
+
+		span := spans.AppendEmpty()
+		span.SetTraceID(traceID)
+		span.SetParentSpanID(rootSpanID)
+		span.SetSpanID(suiteSpanID)
+		span.SetName(suite.name)
+		span.SetKind(ptrace.SpanKindServer)
+		span.SetStartTimestamp(pcommon.NewTimestampFromTime(suite.start))
+		span.SetEndTimestamp(pcommon.NewTimestampFromTime(suite.start.Add(suite.duration)))
+		span.Attributes().PutStr(string(semconv.TestSuiteNameKey), suite.name)
+
+		status := semconv.TestSuiteRunStatusSuccess.Value.AsString()
+		span.Status().SetCode(ptrace.StatusCodeOk)
+
+		start := suite.start
+		for i, c := range suite.cases {
+			caseSpanID, err := newTestSpanID(runID, runAttempt, fmt.Sprintf("%s#%d", suite.key, i))
+			if err != nil {
+				return ptrace.Traces{}, fmt.Errorf("failed to generate test case span ID: %w", err)
+			}
+
+			caseSpan := spans.AppendEmpty()
+			caseSpan.SetTraceID(traceID)
+			caseSpan.SetParentSpanID(suiteSpanID)
+			caseSpan.SetSpanID(caseSpanID)
+			caseSpan.SetName(c.name)
+			caseSpan.SetKind(ptrace.SpanKindServer)
+			caseSpan.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+			caseSpan.SetEndTimestamp(pcommon.NewTimestampFromTime(start.Add(c.duration)))
+			start = start.Add(c.duration)
+
+			attrs := caseSpan.Attributes()
+			attrs.PutStr(string(semconv.TestSuiteNameKey), suite.name)
+			attrs.PutStr(string(semconv.TestCaseNameKey), c.name)
+			attrs.PutStr(string(semconv.TestCaseResultStatusKey), c.status)
+
+			switch c.status {
+			case semconv.TestCaseResultStatusFail.Value.AsString():
+				caseSpan.Status().SetCode(ptrace.StatusCodeError)
+				status = semconv.TestSuiteRunStatusFailure.Value.AsString()
+				span.Status().SetCode(ptrace.StatusCodeError)
+			case testCaseResultSkip:
+				caseSpan.Status().SetCode(ptrace.StatusCodeUnset)
+			default:
+				caseSpan.Status().SetCode(ptrace.StatusCodeOk)
+			}
+			caseSpan.Status().SetMessage(c.message)
+		}
+
+		span.Attributes().PutStr(string(semconv.TestSuiteRunStatusKey), status)
+		span.Status().SetMessage(status)
+	}
+
+	return t, nil
+}
+
+// failedTests are the tests of a workflow run which failed on an attempt up to
+// the latest attempt recorded, and did not pass since.
+type failedTests struct {
+	attempt int
+	tests   map[testCaseID]struct{}
+}
+
+// updateFailedTests remembers the failed tests of the workflow run attempt and
+// returns the tests which passed on this attempt after failing on a previous
+// attempt. Retrying only the failed jobs of a run does not run the other
+// tests again, so the failures of previous attempts are kept until the test
+// passes. Attempts older than the latest attempt recorded, such as ones
+// redelivered late, are ignored.
+func (gtr *githubTracesReceiver) updateFailedTests(runID int64, attempt int, suites []testSuiteResult) []testCaseID {
+	// test results are fetched concurrently, so the failures of a run are
+	// read and written at once.
+	gtr.failedTestsMu.Lock()
+	defer gtr.failedTestsMu.Unlock()
+
+	failed := map[testCaseID]struct{}{}
+	if previous, ok := gtr.failedTests.Get(runID); ok {
+		if attempt < previous.attempt {
+			gtr.logger.Debug("test results of a previous attempt, skipping flaky tests...", zap.Int64("run_id", runID), zap.Int("run_attempt", attempt))
+			return nil
+		}
+		for id := range previous.tests {
+			failed[id] = struct{}{}
+		}
+	}
+
+	var flaky []testCaseID
+	current := map[testCaseID]struct{}{}
+	for _, suite := range suites {
+		for _, c := range suite.cases {
+			id := testCaseID{suite: suite.name, name: c.name}
+			switch c.status {
+			case semconv.TestCaseResultStatusPass.Value.AsString():
+				if _, ok := failed[id]; ok {
+					flaky = append(flaky, id)
+					delete(failed, id)
+				}
+			case semconv.TestCaseResultStatusFail.Value.AsString():
+				current[id] = struct{}{}
+			}
+		}
+	}
+
+	for id := range current {
+		failed[id] = struct{}{}
+	}
+	gtr.failedTests.Add(runID, &failedTests{attempt: attempt, tests: failed})
+
+	return flaky
+}
+
+// handleTestResultMetrics records the number of test cases of the workflow run
+// attempt by result, and the flaky tests which passed after failing on a
+// previous attempt.
+func (gtr *githubTracesReceiver) handleTestResultMetrics(e *github.WorkflowRunEvent, suites []testSuiteResult, flaky []testCaseID) pmetric.Metrics {
+	run := e.GetWorkflowRun()
+	ts := pcommon.NewTimestampFromTime(run.GetUpdatedAt().Time)
+
+	counts := map[string]int64{}
+	for _, suite := range suites {
+		for _, c := range suite.cases {
+			counts[c.status]++
+		}
+	}
+
+	// test cases are identified by the spans and logs of flaky tests, as
+	// their names would make the cardinality of the metric unbounded.
+	var flakySuites []string
+	flakyCounts := map[string]int64{}
+	for _, id := range flaky {
+		if _, ok := flakyCounts[id.suite]; !ok {
+			flakySuites = append(flakySuites, id.suite)
+		}
+		flakyCounts[id.suite]++
+	}
+
+	gtr.mbMu.Lock()
+	defer gtr.mbMu.Unlock()
+
+	for _, status := range []string{
+		semconv.TestCaseResultStatusPass.Value.AsString(),
+		semconv.TestCaseResultStatusFail.Value.AsString(),
+		testCaseResultSkip,
+	} {
+		if counts[status] == 0 {
+			continue
+		}
+		gtr.mb.RecordCicdPipelineTestCaseCountDataPoint(
+			ts,
+			counts[status],
+			e.GetRepo().GetName(),
+			run.GetName(),
+			run.GetHeadBranch(),
+			metadata.MapAttributeTestCaseResultStatus[status],
+		)
+	}
+
+	for _, suite := range flakySuites {
+		gtr.mb.RecordCicdPipelineTestCaseFlakyCountDataPoint(ts, flakyCounts[suite], e.GetRepo().GetName(), run.GetName(), run.GetHeadBranch(), suite)
+	}
+
+	return gtr.emitWebhookMetrics(ts)
+}
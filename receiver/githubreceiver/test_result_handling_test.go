@@ -0,0 +1,320 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v89/github"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+)
+
+func readWorkflowRunEvent(t *testing.T) *github.WorkflowRunEvent {
+	data, err := os.ReadFile(filepath.Join("testdata", "workflow-run-completed.json"))
+	require.NoError(t, err)
+
+	var event github.WorkflowRunEvent
+	require.NoError(t, json.Unmarshal(data, &event))
+	return &event
+}
+
+// newArtifactZip returns a zip archive holding the provided files.
+func newArtifactZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestParseJUnit(t *testing.T) {
+	tests := []struct {
+		desc          string
+		report        string
+		expectedNames []string
+		expectedErr   bool
+	}{
+		{
+			desc:          "single test suite",
+			report:        `<testsuite name="receiver"><testcase name="TestA"/></testsuite>`,
+			expectedNames: []string{"receiver"},
+		},
+		{
+			desc: "nested test suites",
+			report: `<testsuites>
+				<testsuite name="receiver"><testsuite name="config"><testcase name="TestA"/></testsuite></testsuite>
+				<testsuite name="scraper"/>
+			</testsuites>`,
+			expectedNames: []string{"receiver", "config", "scraper"},
+		},
+		{
+			desc:        "not a JUnit report",
+			report:      `<project name="receiver"/>`,
+			expectedErr: true,
+		},
+		{
+			desc:        "invalid XML",
+			report:      `<testsuite name="receiver">`,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			suites, err := parseJUnit(strings.NewReader(test.report))
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			names := make([]string, 0, len(suites))
+			for _, suite := range suites {
+				names = append(names, suite.Name)
+			}
+			require.Equal(t, test.expectedNames, names)
+		})
+	}
+}
+
+func TestParseTestResultsArtifactLimits(t *testing.T) {
+	report := `<testsuite name="receiver"><testcase name="TestA"/></testsuite>`
+	data := newArtifactZip(t, map[string]string{"a.xml": report, "b.xml": report})
+
+	tests := []struct {
+		desc         string
+		limits       testResultsLimits
+		expectedErr  bool
+		expectedLeft testResultsLimits
+	}{
+		{
+			desc:         "within the limits",
+			limits:       testResultsLimits{size: 1024, files: 3},
+			expectedLeft: testResultsLimits{size: 1024 - 2*int64(len(report)), files: 1},
+		},
+		{
+			desc:        "too many reports",
+			limits:      testResultsLimits{size: 1024, files: 1},
+			expectedErr: true,
+		},
+		{
+			desc:        "too large once uncompressed",
+			limits:      testResultsLimits{size: int64(len(report)) + 1, files: 3},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			limits := test.limits
+			suites, err := parseTestResultsArtifact("junit", data, time.Now(), &limits)
+			if test.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, suites, 2)
+			require.Equal(t, test.expectedLeft, limits)
+		})
+	}
+}
+
+func TestParseJUnitDuration(t *testing.T) {
+	require.Equal(t, 1500*time.Millisecond, parseJUnitDuration("1.5"))
+	require.Equal(t, 1200*time.Second, parseJUnitDuration("1,200"))
+	require.Equal(t, time.Duration(0), parseJUnitDuration(""))
+	require.Equal(t, time.Duration(0), parseJUnitDuration("-1"))
+}
+
+func TestHandleTestResultTracesWithGoldenFile(t *testing.T) {
+	report, err := os.ReadFile(filepath.Join("testdata", "junit-report.xml"))
+	require.NoError(t, err)
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.TestResults.Enabled = true
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	event := readWorkflowRunEvent(t)
+	data := newArtifactZip(t, map[string]string{"junit-report.xml": string(report), "coverage.txt": "ok"})
+
+	limits := &testResultsLimits{size: cfg.WebHook.TestResults.MaxUncompressedSize, files: cfg.WebHook.TestResults.MaxFiles}
+	suites, err := parseTestResultsArtifact("junit", data, event.GetWorkflowRun().GetRunStartedAt().Time, limits)
+	require.NoError(t, err)
+	require.Len(t, suites, 2)
+
+	traces, err := receiver.handleTestResultTraces(event, suites)
+	require.NoError(t, err)
+
+	expectedFile := filepath.Join("testdata", "workflow-run-test-results-expected.yaml")
+
+	// Uncomment the following line to update the golden file
+	// golden.WriteTraces(t, expectedFile, traces)
+
+	expectedTraces, err := golden.ReadTraces(expectedFile)
+	require.NoError(t, err)
+
+	require.NoError(t, ptracetest.CompareTraces(expectedTraces, traces))
+}
+
+func TestHandleTestResultsReq(t *testing.T) {
+	event := readWorkflowRunEvent(t)
+	started := event.GetWorkflowRun().GetRunStartedAt().Time
+
+	failed := newArtifactZip(t, map[string]string{"report.xml": `<testsuite name="receiver">
+		<testcase name="TestA" classname="githubreceiver"/>
+		<testcase name="TestB" classname="githubreceiver"><failure message="timeout"/></testcase>
+	</testsuite>`})
+	passed := newArtifactZip(t, map[string]string{"report.xml": `<testsuite name="receiver">
+		<testcase name="TestA" classname="githubreceiver"/>
+		<testcase name="TestB" classname="githubreceiver"/>
+	</testsuite>`})
+
+	// the artifact of the current attempt is served, the previous attempt
+	// failing and the retry passing.
+	artifact := failed
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/open-telemetry/open-telemetry-otel-collector/actions/runs/14460881260/artifacts":
+			_ = json.NewEncoder(w).Encode(&github.ArtifactList{
+				Artifacts: []*github.Artifact{
+					{
+						ID:          github.Ptr(int64(1)),
+						Name:        github.Ptr("junit-results"),
+						SizeInBytes: github.Ptr(int64(len(artifact))),
+						CreatedAt:   &github.Timestamp{Time: started.Add(time.Minute)},
+					},
+					{
+						ID:        github.Ptr(int64(2)),
+						Name:      github.Ptr("junit-results-previous-attempt"),
+						CreatedAt: &github.Timestamp{Time: started.Add(-time.Hour)},
+					},
+					{
+						ID:        github.Ptr(int64(3)),
+						Name:      github.Ptr("coverage"),
+						CreatedAt: &github.Timestamp{Time: started.Add(time.Minute)},
+					},
+				},
+			})
+		case "/api/v3/repos/open-telemetry/open-telemetry-otel-collector/actions/artifacts/1/zip":
+			http.Redirect(w, r, server.URL+"/download", http.StatusFound)
+		case "/download":
+			// the artifact is downloaded without the credentials of the client
+			require.Empty(t, r.Header.Get("Authorization"))
+			_, _ = w.Write(artifact)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.Client = &confighttp.ClientConfig{Endpoint: server.URL}
+	cfg.WebHook.TestResults.Enabled = true
+
+	traces := new(consumertest.TracesSink)
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, traces)
+	require.NoError(t, err)
+
+	receiver.ghClient, err = receiver.newGitHubClient(context.Background(), nil)
+	require.NoError(t, err)
+	receiver.downloadClient, err = receiver.newDownloadClient(context.Background(), nil)
+	require.NoError(t, err)
+
+	metrics := new(consumertest.MetricsSink)
+	receiver.metricsConsumer = metrics
+
+	// the test results are downloaded in the background once queued
+	receiver.fetches.start()
+	receiver.handleTestResultsReq(event)
+	require.NoError(t, receiver.fetches.shutdown(context.Background()))
+	require.Equal(t, 3, traces.SpanCount())
+	require.Len(t, metrics.AllMetrics(), 1)
+
+	sm := metrics.AllMetrics()[0].ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, 1, sm.Metrics().Len())
+	require.Equal(t, "cicd.pipeline.test.case.count", sm.Metrics().At(0).Name())
+	require.Equal(t, 2, sm.Metrics().At(0).Sum().DataPoints().Len())
+
+	artifact = passed
+	event.GetWorkflowRun().RunAttempt = github.Ptr(event.GetWorkflowRun().GetRunAttempt() + 1)
+	receiver.fetchTestResults(context.Background(), event)
+	require.Equal(t, 6, traces.SpanCount())
+	require.Len(t, metrics.AllMetrics(), 2)
+
+	// the test which failed on the previous attempt is flaky
+	sm = metrics.AllMetrics()[1].ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, 2, sm.Metrics().Len())
+
+	flaky := sm.Metrics().At(1)
+	require.Equal(t, "cicd.pipeline.test.case.flaky.count", flaky.Name())
+	require.Equal(t, 1, flaky.Sum().DataPoints().Len())
+
+	// flaky tests are counted by suite rather than by test case
+	attrs := flaky.Sum().DataPoints().At(0).Attributes().AsRaw()
+	require.Equal(t, "receiver", attrs["test.suite.name"])
+	require.NotContains(t, attrs, "test.case.name")
+	require.Equal(t, int64(1), flaky.Sum().DataPoints().At(0).IntValue())
+
+	// the test is no longer flaky once it passed
+	receiver.fetchTestResults(context.Background(), event)
+	sm = metrics.AllMetrics()[2].ResourceMetrics().At(0).ScopeMetrics().At(0)
+	require.Equal(t, 1, sm.Metrics().Len())
+}
+
+func TestUpdateFailedTests(t *testing.T) {
+	cfg := createDefaultConfig().(*Config)
+	cfg.WebHook.TestResults.Enabled = true
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, consumertest.NewNop())
+	require.NoError(t, err)
+
+	newSuite := func(statuses ...string) []testSuiteResult {
+		suite := testSuiteResult{name: "receiver"}
+		for i, status := range statuses {
+			suite.cases = append(suite.cases, testCaseResult{name: string(rune('A' + i)), status: status})
+		}
+		return []testSuiteResult{suite}
+	}
+
+	// the first attempt fails A and B
+	require.Empty(t, receiver.updateFailedTests(1, 1, newSuite("fail", "fail")))
+
+	// retrying only the job of A passes it, B is still failed
+	require.Equal(t, []testCaseID{{suite: "receiver", name: "A"}}, receiver.updateFailedTests(1, 2, newSuite("pass")))
+
+	// an attempt redelivered after a later one is ignored
+	require.Empty(t, receiver.updateFailedTests(1, 1, newSuite("fail", "fail")))
+
+	// retrying the job of B passes it
+	require.Equal(t, []testCaseID{{suite: "receiver", name: "B"}}, receiver.updateFailedTests(1, 3, newSuite("skip", "pass")))
+
+	// failures of other runs are not flaky
+	require.Empty(t, receiver.updateFailedTests(2, 1, newSuite("pass", "pass")))
+}
@@ -4,6 +4,7 @@
 package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
@@ -18,7 +19,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func (gtr *githubTracesReceiver) handleWorkflowRun(e *github.WorkflowRunEvent) (ptrace.Traces, error) {
+func (gtr *githubTracesReceiver) handleWorkflowRun(ctx context.Context, e *github.WorkflowRunEvent) (ptrace.Traces, error) {
 	t := ptrace.NewTraces()
 	r := t.ResourceSpans().AppendEmpty()
 
@@ -39,6 +40,15 @@ func (gtr *githubTracesReceiver) handleWorkflowRun(e *github.WorkflowRunEvent) (
 		gtr.logger.Sugar().Error("failed to create root span", zap.Error(err))
 		return ptrace.Traces{}, errors.New("failed to create root span")
 	}
+
+	if gtr.nestsReusableWorkflows() {
+		err = gtr.createReusableWorkflowSpans(ctx, r, e, traceID)
+		if err != nil {
+			gtr.logger.Sugar().Error("failed to create reusable workflow spans", zap.Error(err))
+			return ptrace.Traces{}, fmt.Errorf("failed to create reusable workflow spans: %w", err)
+		}
+	}
+
 	return t, nil
 }
 
@@ -87,6 +97,20 @@ func (gtr *githubTracesReceiver) handleWorkflowJob(e *github.WorkflowJobEvent) (
 // differentiate between a deterministic traceID and the parentSpanID.
 func newTraceID(runID int64, runAttempt int) (pcommon.TraceID, error) {
 	input := fmt.Sprintf("%d%dt", runID, runAttempt)
+	return newTraceIDFromInput(input)
+}
+
+// newParentId creates a deterministic Parent Span ID based on the provided
+// runID and runAttempt. `s` is appended to the end of the input to
+// differentiate between a deterministic traceID and the parentSpanID.
+func newParentSpanID(runID int64, runAttempt int) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("%d%ds", runID, runAttempt)
+	return newSpanID(input)
+}
+
+// newTraceIDFromInput hashes the provided input into a deterministic Trace
+// ID.
+func newTraceIDFromInput(input string) (pcommon.TraceID, error) {
 	// TODO: Determine if this is the best hashing algorithm to use. This is
 	// more likely to generate a unique hash compared to MD5 or SHA1. Could
 	// alternatively use UUID library to generate a unique ID by also using a
@@ -103,11 +127,8 @@ func newTraceID(runID int64, runAttempt int) (pcommon.TraceID, error) {
 	return id, nil
 }
 
-// newParentId creates a deterministic Parent Span ID based on the provided
-// runID and runAttempt. `s` is appended to the end of the input to
-// differentiate between a deterministic traceID and the parentSpanID.
-func newParentSpanID(runID int64, runAttempt int) (pcommon.SpanID, error) {
-	input := fmt.Sprintf("%d%ds", runID, runAttempt)
+// newSpanID hashes the provided input into a deterministic Span ID.
+func newSpanID(input string) (pcommon.SpanID, error) {
 	hash := sha256.Sum256([]byte(input))
 	spanIDHex := hex.EncodeToString(hash[:])
 
@@ -153,17 +174,24 @@ func (gtr *githubTracesReceiver) createRootSpan(
 
 	span.Status().SetMessage(event.GetWorkflowRun().GetConclusion())
 
-	// Attempt to link to previous trace ID if applicable
-	if event.GetWorkflowRun().GetPreviousAttemptURL() != "" && event.GetWorkflowRun().GetRunAttempt() > 1 {
-		gtr.logger.Debug("Linking to previous trace ID for WorkflowRunEvent")
+	// Link to the root span of the previous attempt, which is in a separate
+	// trace since the trace ID is derived from the run attempt.
+	if event.GetWorkflowRun().GetRunAttempt() > 1 {
+		gtr.logger.Debug("Linking to previous attempt for WorkflowRunEvent")
 		previousRunAttempt := event.GetWorkflowRun().GetRunAttempt() - 1
 		previousTraceID, err := newTraceID(event.GetWorkflowRun().GetID(), previousRunAttempt)
 		if err != nil {
 			return fmt.Errorf("failed to generate previous traceID: %w", err)
 		}
 
+		previousSpanID, err := newParentSpanID(event.GetWorkflowRun().GetID(), previousRunAttempt)
+		if err != nil {
+			return fmt.Errorf("failed to generate previous root span ID: %w", err)
+		}
+
 		link := span.Links().AppendEmpty()
 		link.SetTraceID(previousTraceID)
+		link.SetSpanID(previousSpanID)
 		gtr.logger.Debug("successfully linked to previous trace ID", zap.String("previousTraceID", previousTraceID.String()))
 	}
 
@@ -180,7 +208,7 @@ func (gtr *githubTracesReceiver) createParentSpan(
 	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
 	span := scopeSpans.Spans().AppendEmpty()
 
-	parentSpanID, err := newParentSpanID(event.GetWorkflowJob().GetRunID(), int(event.GetWorkflowJob().GetRunAttempt()))
+	parentSpanID, err := gtr.jobParentSpanID(event.GetWorkflowJob().GetRunID(), int(event.GetWorkflowJob().GetRunAttempt()), event.GetWorkflowJob().GetName())
 	if err != nil {
 		return pcommon.SpanID{}, fmt.Errorf("failed to generate parent span ID: %w", err)
 	}
@@ -227,16 +255,7 @@ func (gtr *githubTracesReceiver) createParentSpan(
 // runAttempt, and the name of the job.
 func newJobSpanID(runID int64, runAttempt int, jobName string) (pcommon.SpanID, error) {
 	input := fmt.Sprintf("%d%d%s", runID, runAttempt, jobName)
-	hash := sha256.Sum256([]byte(input))
-	spanIDHex := hex.EncodeToString(hash[:])
-
-	var spanID pcommon.SpanID
-	_, err := hex.Decode(spanID[:], []byte(spanIDHex[16:32]))
-	if err != nil {
-		return pcommon.SpanID{}, err
-	}
-
-	return spanID, nil
+	return newSpanID(input)
 }
 
 // createStepSpans is a wrapper function to create spans for each step in the
@@ -356,16 +375,7 @@ func (gtr *githubTracesReceiver) createStepSpan(
 // inputs.
 func newStepSpanID(runID int64, runAttempt int, jobName string, stepName string, number int) (pcommon.SpanID, error) {
 	input := fmt.Sprintf("%d%d%s%s%d", runID, runAttempt, jobName, stepName, number)
-	hash := sha256.Sum256([]byte(input))
-	spanIDHex := hex.EncodeToString(hash[:])
-
-	var spanID pcommon.SpanID
-	_, err := hex.Decode(spanID[:], []byte(spanIDHex[16:32]))
-	if err != nil {
-		return pcommon.SpanID{}, err
-	}
-
-	return spanID, nil
+	return newSpanID(input)
 }
 
 // createJobQueueSpan creates a span for the job queue based on the provided
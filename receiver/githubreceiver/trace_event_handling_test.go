@@ -4,6 +4,7 @@
 package githubreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver"
 
 import (
+	"context"
 	"encoding/json"
 	"os"
 	"path/filepath"
@@ -39,7 +40,7 @@ func TestHandleWorkflowRunWithGoldenFile(t *testing.T) {
 	err = json.Unmarshal(data, &event)
 	require.NoError(t, err, "Failed to unmarshal workflow run event")
 
-	traces, err := receiver.handleWorkflowRun(&event)
+	traces, err := receiver.handleWorkflowRun(context.Background(), &event)
 	require.NoError(t, err, "Failed to handle workflow run event")
 
 	expectedFile := filepath.Join("testdata", "workflow-run-expected.yaml")
@@ -158,6 +159,64 @@ func TestNewParentSpanID_Consistency(t *testing.T) {
 	}
 }
 
+func TestCreateRootSpanLinksPreviousAttempt(t *testing.T) {
+	tests := []struct {
+		name       string
+		runAttempt int
+		wantLink   bool
+	}{
+		{
+			name:       "first attempt",
+			runAttempt: 1,
+		},
+		{
+			name:       "re-run attempt",
+			runAttempt: 3,
+			wantLink:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver := &githubTracesReceiver{
+				logger:   zap.NewNop(),
+				cfg:      createDefaultConfig().(*Config),
+				settings: receivertest.NewNopSettings(metadata.Type),
+			}
+
+			runID := int64(12345)
+			event := &github.WorkflowRunEvent{
+				WorkflowRun: &github.WorkflowRun{
+					ID:         github.Ptr(runID),
+					RunAttempt: github.Ptr(tt.runAttempt),
+				},
+			}
+
+			traceID, err := newTraceID(runID, tt.runAttempt)
+			require.NoError(t, err)
+
+			resourceSpans := ptrace.NewTraces().ResourceSpans().AppendEmpty()
+			require.NoError(t, receiver.createRootSpan(resourceSpans, event, traceID))
+
+			links := resourceSpans.ScopeSpans().At(0).Spans().At(0).Links()
+			if !tt.wantLink {
+				require.Equal(t, 0, links.Len())
+				return
+			}
+
+			// the link points to the root span created for the previous attempt
+			previousTraceID, err := newTraceID(runID, tt.runAttempt-1)
+			require.NoError(t, err)
+			previousSpanID, err := newParentSpanID(runID, tt.runAttempt-1)
+			require.NoError(t, err)
+
+			require.Equal(t, 1, links.Len())
+			require.Equal(t, previousTraceID, links.At(0).TraceID())
+			require.Equal(t, previousSpanID, links.At(0).SpanID())
+		})
+	}
+}
+
 func TestNewUniqueSteps(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -672,7 +731,7 @@ func TestNewJobSpanID_Consistency(t *testing.T) {
 // require.NoError(t, err, "Failed to unmarshal workflow run event")
 //
 // // Process the event
-// traces, err := receiver.handleWorkflowRun(&event)
+// traces, err := receiver.handleWorkflowRun(context.Background(), &event)
 // require.NoError(t, err, "Failed to handle workflow run event")
 //
 // // Validate the generated traces
@@ -7,35 +7,71 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/go-github/v89/github"
 	"github.com/gorilla/mux"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/config/configauth"
+	"go.opentelemetry.io/collector/config/configoptional"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/plog"
 	"go.opentelemetry.io/collector/pdata/ptrace"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/multierr"
 	"go.uber.org/zap"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/githubreceiver/internal/metadata"
 )
 
 var errMissingEndpoint = errors.New("missing a receiver endpoint")
 
-const healthyResponse = `{"text": "GitHub receiver webhook is healthy"}`
+const (
+	healthyResponse = `{"text": "GitHub receiver webhook is healthy"}`
+
+	// downloadMaxRedirects is the number of redirects followed to get the
+	// URL of a file downloaded from the GitHub API.
+	downloadMaxRedirects = 4
+
+	// defaultDownloadTimeout is the timeout of downloading a file from the
+	// GitHub API when the webhook client does not set one.
+	defaultDownloadTimeout = time.Minute
+)
 
 const transportProtocol = "http"
 
 type githubTracesReceiver struct {
-	traceConsumer consumer.Traces
-	cfg           *Config
-	server        *http.Server
-	shutdownWG    sync.WaitGroup
-	settings      receiver.Settings
-	logger        *zap.Logger
-	obsrecv       *receiverhelper.ObsReport
+	traceConsumer   consumer.Traces
+	logConsumer     consumer.Logs
+	metricsConsumer consumer.Metrics
+	jobs            *jobStateTable
+	mb              *metadata.MetricsBuilder
+	mbMu            sync.Mutex
+	deliveries      *deliveryCache
+	reconciler      *reconciler
+	poller          *poller
+	queue           *webhookQueue
+	fetches         *fetchQueue
+	jobLogRedact    []*regexp.Regexp
+	failedTests     *lru.Cache[int64, *failedTests]
+	failedTestsMu   sync.Mutex
+	ghClient        *github.Client
+	downloadClient  *http.Client
+	cfg             *Config
+	server          *http.Server
+	shutdownWG      sync.WaitGroup
+	settings        receiver.Settings
+	logger          *zap.Logger
+	obsrecv         *receiverhelper.ObsReport
 }
 
 func newTracesReceiver(
@@ -59,11 +95,48 @@ func newTracesReceiver(
 	gtr := &githubTracesReceiver{
 		traceConsumer: traceConsumer,
 		cfg:           config,
+		jobs:          newJobStateTable(config.WebHook.Metrics.JobStateTTL),
+		mb:            metadata.NewMetricsBuilder(config.MetricsBuilderConfig, params),
 		settings:      params,
 		logger:        params.Logger,
 		obsrecv:       obsrecv,
 	}
 
+	if config.WebHook.Dedup.CacheSize > 0 {
+		gtr.deliveries, err = newDeliveryCache(config.WebHook.Dedup.CacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.WebHook.JobLogs.Enabled {
+		for _, expr := range config.WebHook.JobLogs.Redact {
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return nil, err
+			}
+			gtr.jobLogRedact = append(gtr.jobLogRedact, re)
+		}
+	}
+
+	if config.WebHook.JobLogs.Enabled || config.WebHook.TestResults.Enabled {
+		gtr.fetches = newFetchQueue(params.Logger)
+	}
+
+	if config.WebHook.TestResults.Enabled {
+		gtr.failedTests, err = lru.New[int64, *failedTests](testResultsCacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if config.WebHook.Async.Enabled {
+		gtr.queue, err = newWebhookQueue(config.WebHook.Async, params.TelemetrySettings, gtr.handleDelivery)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return gtr, nil
 }
 
@@ -76,6 +149,36 @@ func (gtr *githubTracesReceiver) Start(ctx context.Context, host component.Host)
 		return nil
 	}
 
+	// Initialize extensions as nil, which is safe to pass to ToClient when host is nil
+	// The OpenTelemetry client will handle the nil extensions case appropriately
+	var extensions map[component.ID]component.Component
+	if host != nil {
+		extensions = host.GetExtensions()
+	}
+
+	// optional GitHub API client for data not included in webhook payloads
+	if gtr.cfg.WebHook.Client != nil {
+		client, err := gtr.newGitHubClient(ctx, extensions)
+		if err != nil {
+			return err
+		}
+		gtr.ghClient = client
+
+		gtr.downloadClient, err = gtr.newDownloadClient(ctx, extensions)
+		if err != nil {
+			return err
+		}
+	}
+
+	// restore the deliveries processed before a restart
+	if gtr.deliveries != nil && gtr.cfg.WebHook.Dedup.Storage != nil {
+		err := gtr.deliveries.load(ctx, host, *gtr.cfg.WebHook.Dedup.Storage, gtr.settings.ID)
+		if err != nil {
+			return err
+		}
+		gtr.deliveries.start(gtr.logger)
+	}
+
 	// create listener from config
 	ln, err := gtr.cfg.WebHook.ToListener(ctx)
 	if err != nil {
@@ -88,16 +191,9 @@ func (gtr *githubTracesReceiver) Start(ctx context.Context, host component.Host)
 	// setup health route
 	router.HandleFunc(gtr.cfg.WebHook.HealthPath, gtr.handleHealthCheck)
 
-	// setup webhook route for traces
+	// setup webhook route for traces and logs
 	router.HandleFunc(gtr.cfg.WebHook.Path, gtr.handleReq)
 
-	// Initialize extensions as nil, which is safe to pass to ToClient when host is nil
-	// The OpenTelemetry client will handle the nil extensions case appropriately
-	var extensions map[component.ID]component.Component
-	if host != nil {
-		extensions = host.GetExtensions()
-	}
-
 	// webhook server standup and configuration
 	gtr.server, err = gtr.cfg.WebHook.ToServer(ctx, extensions, gtr.settings.TelemetrySettings, router)
 	if err != nil {
@@ -106,6 +202,19 @@ func (gtr *githubTracesReceiver) Start(ctx context.Context, host component.Host)
 
 	gtr.logger.Info("Health check now listening at", zap.String("health_path", gtr.cfg.WebHook.HealthPath))
 
+	if gtr.queue != nil {
+		gtr.queue.start(ctx)
+	}
+
+	if gtr.fetches != nil {
+		gtr.fetches.start()
+	}
+
+	// the jobs tracked for the webhook metrics expire in the background
+	if gtr.metricsConsumer != nil {
+		gtr.jobs.start()
+	}
+
 	gtr.shutdownWG.Add(1)
 	go func() {
 		defer gtr.shutdownWG.Done()
@@ -115,25 +224,130 @@ func (gtr *githubTracesReceiver) Start(ctx context.Context, host component.Host)
 		}
 	}()
 
+	// recover the deliveries which failed while the receiver was unavailable
+	if gtr.cfg.WebHook.Reconciler.Enabled {
+		gtr.reconciler = newReconciler(gtr, gtr.ghClient)
+		if err := gtr.reconciler.start(ctx, host); err != nil {
+			return err
+		}
+	}
+
+	// poll the workflow runs of repositories which cannot send webhooks
+	if gtr.cfg.WebHook.Poller.Enabled {
+		gtr.poller, err = newPoller(gtr, gtr.ghClient)
+		if err != nil {
+			return err
+		}
+		if err := gtr.poller.start(ctx, host); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (gtr *githubTracesReceiver) Shutdown(_ context.Context) error {
+// newGitHubClient creates a GitHub REST API client from the webhook client
+// configuration. When an endpoint is set, it is used as the GitHub Enterprise
+// Server URL. Otherwise the API URL of the configured server is used.
+func (gtr *githubTracesReceiver) newGitHubClient(ctx context.Context, extensions map[component.ID]component.Component) (*github.Client, error) {
+	httpClient, err := gtr.cfg.WebHook.Client.ToClient(ctx, extensions, gtr.settings.TelemetrySettings)
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint := gtr.cfg.WebHook.Client.Endpoint; endpoint != "" {
+		return github.NewClient(github.WithHTTPClient(httpClient), github.WithEnterpriseURLs(endpoint, endpoint))
+	}
+
+	if gtr.cfg.IsEnterprise() {
+		apiURL := gtr.cfg.RESTURL()
+		return github.NewClient(github.WithHTTPClient(httpClient), github.WithURLs(&apiURL, nil))
+	}
+
+	return github.NewClient(github.WithHTTPClient(httpClient))
+}
+
+// newDownloadClient creates the HTTP client downloading the files the GitHub
+// API redirects to from the webhook client configuration. The URLs are short
+// lived and signed, so they are downloaded without the credentials and
+// headers of the GitHub client.
+func (gtr *githubTracesReceiver) newDownloadClient(ctx context.Context, extensions map[component.ID]component.Component) (*http.Client, error) {
+	cfg := *gtr.cfg.WebHook.Client
+	cfg.Auth = configoptional.None[configauth.Config]()
+	cfg.Headers = nil
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultDownloadTimeout
+	}
+
+	return cfg.ToClient(ctx, extensions, gtr.settings.TelemetrySettings)
+}
+
+// download returns the body of a file the GitHub API redirected to, such as a
+// job log or an artifact.
+func (gtr *githubTracesReceiver) download(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := gtr.downloadClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code: %s", resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+func (gtr *githubTracesReceiver) Shutdown(ctx context.Context) error {
 	// server must exist to be closed.
 	if gtr.server == nil {
 		return nil
 	}
 
-	err := gtr.server.Close()
+	var err error
+	if gtr.reconciler != nil {
+		err = gtr.reconciler.shutdown(ctx)
+		gtr.reconciler = nil
+	}
+
+	if gtr.poller != nil {
+		err = multierr.Append(err, gtr.poller.shutdown(ctx))
+		gtr.poller = nil
+	}
+
+	err = multierr.Append(err, gtr.server.Close())
 	gtr.shutdownWG.Wait()
+
+	// process the deliveries already acknowledged before persisting the
+	// processed deliveries
+	if gtr.queue != nil {
+		err = multierr.Append(err, gtr.queue.shutdown(ctx))
+	}
+
+	// the fetches queued by the deliveries run after they are acknowledged
+	if gtr.fetches != nil {
+		err = multierr.Append(err, gtr.fetches.shutdown(ctx))
+	}
+
+	if gtr.deliveries != nil {
+		err = multierr.Append(err, gtr.deliveries.save(ctx))
+	}
+
+	gtr.jobs.shutdown()
+
+	// reset the server so a second shutdown is a noop.
+	gtr.server = nil
 	return err
 }
 
 // handleReq handles incoming request sent to the webhook endoint. On success
 // returns a 200 response code.
 func (gtr *githubTracesReceiver) handleReq(w http.ResponseWriter, req *http.Request) {
-	ctx := gtr.obsrecv.StartTracesOp(req.Context())
-
 	p, err := github.ValidatePayload(req, []byte(gtr.cfg.WebHook.Secret))
 	if err != nil {
 		gtr.logger.Sugar().Debugf("unable to validate payload", zap.Error(err))
@@ -141,7 +355,47 @@ func (gtr *githubTracesReceiver) handleReq(w http.ResponseWriter, req *http.Requ
 		return
 	}
 
-	eventType := github.WebHookType(req)
+	// acknowledge the delivery right away when processing asynchronously
+	if gtr.queue != nil {
+		status := gtr.queue.enqueue(req, p)
+		if status != http.StatusAccepted {
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		w.WriteHeader(status)
+		return
+	}
+
+	gtr.handleDelivery(w, req, p)
+}
+
+// handleDelivery handles a delivery whose payload was validated.
+func (gtr *githubTracesReceiver) handleDelivery(w http.ResponseWriter, req *http.Request, p []byte) {
+	gtr.handlePayload(w, req, github.WebHookType(req), github.DeliveryID(req), p)
+}
+
+// handlePayload handles the validated payload of a webhook delivery, received
+// by the webhook endpoint or recovered by the reconciler.
+func (gtr *githubTracesReceiver) handlePayload(w http.ResponseWriter, req *http.Request, eventType string, deliveryID string, p []byte) {
+	// GitHub retries failed deliveries and allows deliveries to be manually
+	// redelivered, both of which reuse the delivery ID.
+	if gtr.deliveries != nil && deliveryID != "" {
+		if !gtr.deliveries.claim(deliveryID) {
+			gtr.logger.Debug("delivery already processed, skipping...", zap.String("delivery_id", deliveryID))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		w = rec
+		defer func() {
+			// server errors are retried by GitHub and must be processed again.
+			if rec.status >= http.StatusInternalServerError {
+				gtr.deliveries.release(deliveryID)
+			}
+		}()
+	}
+
 	event, err := github.ParseWebHook(eventType, p)
 	if err != nil {
 		gtr.logger.Sugar().Debugf("failed to parse event", zap.Error(err))
@@ -149,7 +403,54 @@ func (gtr *githubTracesReceiver) handleReq(w http.ResponseWriter, req *http.Requ
 		return
 	}
 
+	switch event.(type) {
+	case *github.WorkflowRunEvent, *github.WorkflowJobEvent:
+		gtr.handleWorkflowEvent(w, req, event)
+	case *github.DeploymentEvent, *github.CheckSuiteEvent, *github.CheckRunEvent:
+		gtr.handleTracesReq(w, req, event)
+	case *github.DeploymentStatusEvent:
+		// go-github does not expose the workflow run that created the
+		// deployment, so the payload is parsed into a type that does.
+		e, err := parseDeploymentStatusEvent(p)
+		if err != nil {
+			gtr.logger.Sugar().Debugf("failed to parse event", zap.Error(err))
+			http.Error(w, "failed to parse event", http.StatusBadRequest)
+			return
+		}
+		gtr.handleTracesReq(w, req, e)
+	case *github.PullRequestEvent, *github.PullRequestReviewEvent, *github.PushEvent:
+		gtr.handleLogsReq(w, req, event)
+	case *github.PingEvent:
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+	default:
+		gtr.logger.Sugar().Debugf("event type not supported", zap.String("event_type", eventType))
+		http.Error(w, "event type not supported", http.StatusBadRequest)
+	}
+}
+
+// handleWorkflowEvent handles a workflow run or job event, received by the
+// webhook or polled from the GitHub API.
+func (gtr *githubTracesReceiver) handleWorkflowEvent(w http.ResponseWriter, req *http.Request, event any) {
+	gtr.handleMetricsReq(req.Context(), event)
+	gtr.handleJobLogsReq(event)
+	gtr.handleTestResultsReq(event)
+	gtr.handleTracesReq(w, req, event)
+}
+
+// handleTracesReq converts workflow, deployment, and check events into traces
+// and passes them to the traces consumer.
+func (gtr *githubTracesReceiver) handleTracesReq(w http.ResponseWriter, req *http.Request, event any) {
+	if gtr.traceConsumer == nil {
+		gtr.logger.Debug("traces signal not enabled, skipping...")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ctx := gtr.obsrecv.StartTracesOp(req.Context())
+
 	var td ptrace.Traces
+	var err error
 	switch e := event.(type) {
 	case *github.WorkflowRunEvent:
 		if strings.ToLower(e.GetWorkflowRun().GetStatus()) != "completed" {
@@ -157,7 +458,7 @@ func (gtr *githubTracesReceiver) handleReq(w http.ResponseWriter, req *http.Requ
 			w.WriteHeader(http.StatusNoContent)
 			return
 		}
-		td, err = gtr.handleWorkflowRun(e)
+		td, err = gtr.handleWorkflowRun(ctx, e)
 	case *github.WorkflowJobEvent:
 		if strings.ToLower(e.GetWorkflowJob().GetStatus()) != "completed" {
 			gtr.logger.Debug("workflow job not complete, skipping...", zap.String("status", e.GetWorkflowJob().GetStatus()))
@@ -165,13 +466,55 @@ func (gtr *githubTracesReceiver) handleReq(w http.ResponseWriter, req *http.Requ
 			return
 		}
 		td, err = gtr.handleWorkflowJob(e)
-	case *github.PingEvent:
-		w.Header().Add("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
+	case *github.DeploymentEvent:
+		// the deployment span is created once a final deployment status is
+		// received.
+		gtr.logger.Debug("deployment not complete, skipping...", zap.Int64("deployment_id", e.GetDeployment().GetID()))
+		w.WriteHeader(http.StatusNoContent)
 		return
-	default:
-		gtr.logger.Sugar().Debugf("event type not supported", zap.String("event_type", eventType))
-		http.Error(w, "event type not supported", http.StatusBadRequest)
+	case *deploymentStatusEvent:
+		if !isDeploymentFinished(e.GetDeploymentStatus().GetState()) {
+			gtr.logger.Debug("deployment not complete, skipping...", zap.String("state", e.GetDeploymentStatus().GetState()))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		td, err = gtr.handleDeploymentStatus(e)
+	case *github.CheckSuiteEvent:
+		if isGitHubActionsApp(e.GetCheckSuite().GetApp()) {
+			gtr.logger.Debug("check suite created by github actions, skipping...")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if strings.ToLower(e.GetCheckSuite().GetStatus()) != "completed" {
+			gtr.logger.Debug("check suite not complete, skipping...", zap.String("status", e.GetCheckSuite().GetStatus()))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		td, err = gtr.handleCheckSuite(e)
+	case *github.CheckRunEvent:
+		if isGitHubActionsApp(e.GetCheckRun().GetApp()) {
+			gtr.logger.Debug("check run created by github actions, skipping...")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if strings.ToLower(e.GetCheckRun().GetStatus()) != "completed" {
+			gtr.logger.Debug("check run not complete, skipping...", zap.String("status", e.GetCheckRun().GetStatus()))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		td, err = gtr.handleCheckRun(ctx, e)
+	}
+
+	if err != nil {
+		gtr.logger.Debug("failed to handle event", zap.Error(err))
+		// the jobs of a workflow run are retrieved from the GitHub API, which
+		// may succeed once the delivery is retried.
+		status := http.StatusBadRequest
+		if errors.Is(err, errWorkflowRunJobs) {
+			status = http.StatusInternalServerError
+		}
+		http.Error(w, "failed to handle event", status)
+		gtr.obsrecv.EndTracesOp(ctx, "protobuf", 0, err)
 		return
 	}
 
@@ -187,6 +530,48 @@ func (gtr *githubTracesReceiver) handleReq(w http.ResponseWriter, req *http.Requ
 	gtr.obsrecv.EndTracesOp(ctx, "protobuf", td.SpanCount(), err)
 }
 
+// handleLogsReq converts pull request, pull request review, and push events
+// into log records and passes them to the logs consumer.
+func (gtr *githubTracesReceiver) handleLogsReq(w http.ResponseWriter, req *http.Request, event any) {
+	if gtr.logConsumer == nil {
+		gtr.logger.Debug("logs signal not enabled, skipping...")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ctx := gtr.obsrecv.StartLogsOp(req.Context())
+
+	var ld plog.Logs
+	var err error
+	switch e := event.(type) {
+	case *github.PullRequestEvent:
+		ld, err = gtr.handlePullRequest(e)
+	case *github.PullRequestReviewEvent:
+		ld, err = gtr.handlePullRequestReview(e)
+	case *github.PushEvent:
+		ld, err = gtr.handlePush(e)
+	}
+
+	if err != nil {
+		gtr.logger.Debug("failed to handle event", zap.Error(err))
+		http.Error(w, "failed to handle event", http.StatusBadRequest)
+		gtr.obsrecv.EndLogsOp(ctx, "protobuf", 0, err)
+		return
+	}
+
+	if ld.LogRecordCount() > 0 {
+		err = gtr.logConsumer.ConsumeLogs(ctx, ld)
+		if err != nil {
+			http.Error(w, "failed to consume logs", http.StatusInternalServerError)
+			gtr.obsrecv.EndLogsOp(ctx, "protobuf", ld.LogRecordCount(), err)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+
+	gtr.obsrecv.EndLogsOp(ctx, "protobuf", ld.LogRecordCount(), nil)
+}
+
 // Simple healthcheck endpoint.
 func (gtr *githubTracesReceiver) handleHealthCheck(w http.ResponseWriter, _ *http.Request) {
 	w.Header().Add("Content-Type", "application/json")
@@ -6,10 +6,14 @@ package gitlabreceiver // import "github.com/liatrio/liatrio-otel-collector/rece
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
+	"go.uber.org/multierr"
 
 	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadata"
@@ -17,24 +21,128 @@ import (
 
 const (
 	scrapersKey = "scrapers"
+
+	// GitLab Webhook Headers: https://docs.gitlab.com/user/project/integrations/webhooks/#delivery-headers
+	defaultGitLabEventHeader       = "X-Gitlab-Event"        // The name of the webhook type (ie. Pipeline Hook).
+	defaultGitLabTokenHeader       = "X-Gitlab-Token"        // The secret token configured on the webhook.
+	defaultGitLabEventUUIDHeader   = "X-Gitlab-Event-UUID"   // A unique identifier shared by recursive webhooks.
+	defaultGitLabWebhookUUIDHeader = "X-Gitlab-Webhook-UUID" // A unique identifier of the webhook.
+	defaultGitLabInstanceHeader    = "X-Gitlab-Instance"     // The hostname of the GitLab instance that sent the webhook.
+	defaultUserAgentHeader         = "User-Agent"            // Value always prefixed with "GitLab/"
 )
 
-// Config that is exposed to this github receiver through the OTEL config.yaml
+// Config that is exposed to this gitlab receiver through the OTEL config.yaml
 type Config struct {
 	scraperhelper.ControllerConfig `mapstructure:",squash"`
 	Scrapers                       map[string]internal.Config `mapstructure:"scrapers"`
 	metadata.MetricsBuilderConfig  `mapstructure:",squash"`
+	WebHook                        WebHook `mapstructure:"webhook"`
 }
 
-var _ component.Config = (*Config)(nil)
-var _ confmap.Unmarshaler = (*Config)(nil)
+//nolint:lll
+type WebHook struct {
+	confighttp.ServerConfig `mapstructure:",squash"`       // squash ensures fields are correctly decoded in embedded struct
+	Path                    string                         `mapstructure:"path"`             // path for data collection. Default is /events
+	HealthPath              string                         `mapstructure:"health_path"`      // path for health check api. Default is /health
+	RequiredHeaders         map[string]configopaque.String `mapstructure:"required_headers"` // optional setting to set one or more required headers for all requests to have (except the health check)
+	GitLabHeaders           GitLabHeaders                  `mapstructure:",squash"`          // GitLab headers set by default
+	Secret                  string                         `mapstructure:"secret"`           // secret token compared against the X-Gitlab-Token header
+	ServiceName             string                         `mapstructure:"service_name"`
+	Async                   WebHookAsync                   `mapstructure:"async"` // optional asynchronous processing of deliveries
+}
+
+// WebHookAsync configures processing deliveries asynchronously. Validated
+// deliveries are acknowledged with a 202 right away and processed from a
+// bounded queue by a pool of workers, so a slow pipeline does not make GitLab
+// time out.
+type WebHookAsync struct {
+	Enabled    bool `mapstructure:"enabled"`
+	QueueSize  int  `mapstructure:"queue_size"`  // maximum number of deliveries waiting to be processed
+	NumWorkers int  `mapstructure:"num_workers"` // number of deliveries processed concurrently
+	// Overflow is the behavior when the queue is full: block until there is
+	// room, drop the delivery, or reject it with a 429.
+	Overflow string `mapstructure:"overflow"`
+}
+
+type GitLabHeaders struct {
+	Customizable map[string]string `mapstructure:","` // can be overwritten via required_headers
+	Fixed        map[string]string `mapstructure:","` // are not allowed to be overwritten
+}
+
+var (
+	_ component.Config    = (*Config)(nil)
+	_ confmap.Unmarshaler = (*Config)(nil)
+
+	errMissingEndpointFromConfig   = errors.New("missing receiver server endpoint from config")
+	errReadTimeoutExceedsMaxValue  = errors.New("the duration specified for read_timeout exceeds the maximum allowed value of 10s")
+	errWriteTimeoutExceedsMaxValue = errors.New("the duration specified for write_timeout exceeds the maximum allowed value of 10s")
+	errRequiredHeader              = errors.New("both key and value are required to assign a required_header")
+	errRequireOneScraper           = errors.New("must specify at least one scraper")
+	errGitLabHeader                = errors.New("gitlab default headers [X-Gitlab-Event, X-Gitlab-Token, X-Gitlab-Event-UUID, X-Gitlab-Webhook-UUID, X-Gitlab-Instance] cannot be configured")
+	errAsyncQueueSize              = errors.New("the async queue_size must be greater than 0")
+	errAsyncNumWorkers             = errors.New("the async num_workers must be greater than 0")
+	errAsyncOverflow               = errors.New("the async overflow must be one of [block, drop, reject]")
+)
 
 // Validate the configuration passed through the OTEL config.yaml
 func (cfg *Config) Validate() error {
+	var errs error
+
+	// For now, scrapers are required to be defined in the config. As tracing
+	// and other signals are added, this requirement will change.
 	if len(cfg.Scrapers) == 0 {
-		return errors.New("must specify at least one scraper")
+		errs = multierr.Append(errs, errRequireOneScraper)
 	}
-	return nil
+
+	maxReadWriteTimeout := 10 * time.Second
+
+	if cfg.WebHook.NetAddr.Endpoint == "" {
+		errs = multierr.Append(errs, errMissingEndpointFromConfig)
+	}
+
+	if cfg.WebHook.ReadTimeout > maxReadWriteTimeout {
+		errs = multierr.Append(errs, errReadTimeoutExceedsMaxValue)
+	}
+
+	if cfg.WebHook.WriteTimeout > maxReadWriteTimeout {
+		errs = multierr.Append(errs, errWriteTimeoutExceedsMaxValue)
+	}
+
+	for key, value := range cfg.WebHook.RequiredHeaders {
+		if key == "" || value == "" {
+			errs = multierr.Append(errs, errRequiredHeader)
+		}
+
+		if _, exists := cfg.WebHook.GitLabHeaders.Fixed[key]; exists {
+			errs = multierr.Append(errs, errGitLabHeader)
+		}
+	}
+
+	if cfg.WebHook.Async.Enabled {
+		errs = multierr.Append(errs, cfg.WebHook.Async.validate())
+	}
+
+	return errs
+}
+
+func (cfg *WebHookAsync) validate() error {
+	var errs error
+
+	if cfg.QueueSize <= 0 {
+		errs = multierr.Append(errs, errAsyncQueueSize)
+	}
+
+	if cfg.NumWorkers <= 0 {
+		errs = multierr.Append(errs, errAsyncNumWorkers)
+	}
+
+	switch cfg.Overflow {
+	case overflowBlock, overflowDrop, overflowReject:
+	default:
+		errs = multierr.Append(errs, errAsyncOverflow)
+	}
+
+	return errs
 }
 
 // Unmarshal a config.Parser into the config struct.
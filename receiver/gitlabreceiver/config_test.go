@@ -11,6 +11,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configopaque"
 	"go.opentelemetry.io/collector/confmap"
 	"go.opentelemetry.io/collector/otelcol/otelcoltest"
 	"go.opentelemetry.io/collector/scraper/scraperhelper"
@@ -54,6 +57,40 @@ func TestLoadConfig(t *testing.T) {
 		Scrapers: map[string]internal.Config{
 			gitlabscraper.TypeStr: (&gitlabscraper.Factory{}).CreateDefaultConfig(),
 		},
+		WebHook: WebHook{
+			ServerConfig: confighttp.ServerConfig{
+				NetAddr: confignet.AddrConfig{
+					Endpoint:  "localhost:8080",
+					Transport: confignet.TransportTypeTCP,
+				},
+				ReadTimeout:  500 * time.Millisecond,
+				WriteTimeout: 500 * time.Millisecond,
+			},
+			Path:       "some/path",
+			HealthPath: "health/path",
+			Secret:     "my-secret",
+			RequiredHeaders: map[string]configopaque.String{
+				"key": "value-present",
+			},
+			GitLabHeaders: GitLabHeaders{
+				Customizable: map[string]string{
+					"User-Agent": "",
+				},
+				Fixed: map[string]string{
+					"X-Gitlab-Event":        "",
+					"X-Gitlab-Token":        "",
+					"X-Gitlab-Event-UUID":   "",
+					"X-Gitlab-Webhook-UUID": "",
+					"X-Gitlab-Instance":     "",
+				},
+			},
+			Async: WebHookAsync{
+				Enabled:    true,
+				QueueSize:  100,
+				NumWorkers: 2,
+				Overflow:   overflowReject,
+			},
+		},
 	}
 
 	assert.Equal(t, expectedConfig, r1)
@@ -68,6 +105,7 @@ func TestLoadConfig(t *testing.T) {
 		Scrapers: map[string]internal.Config{
 			gitlabterraformscraper.TypeStr: (&gitlabterraformscraper.Factory{}).CreateDefaultConfig(),
 		},
+		WebHook: factory.CreateDefaultConfig().(*Config).WebHook,
 	}
 
 	assert.Equal(t, expectedTerraformConfig, r2)
@@ -99,6 +137,121 @@ func TestLoadInvalidConfig_InvalidScraperKey(t *testing.T) {
 	require.Contains(t, err.Error(), "invalid scraper key: \"invalidscraperkey\"")
 }
 
+func TestConfig_Validate(t *testing.T) {
+	defaultWebHook := createDefaultConfig().(*Config).WebHook
+
+	tests := []struct {
+		name    string
+		webhook func() WebHook
+		wantErr error
+	}{
+		{
+			name:    "default webhook is valid",
+			webhook: func() WebHook { return defaultWebHook },
+		},
+		{
+			name: "missing endpoint",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.NetAddr.Endpoint = ""
+				return wh
+			},
+			wantErr: errMissingEndpointFromConfig,
+		},
+		{
+			name: "read timeout exceeds max",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.ReadTimeout = 11 * time.Second
+				return wh
+			},
+			wantErr: errReadTimeoutExceedsMaxValue,
+		},
+		{
+			name: "write timeout exceeds max",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.WriteTimeout = 11 * time.Second
+				return wh
+			},
+			wantErr: errWriteTimeoutExceedsMaxValue,
+		},
+		{
+			name: "required header without value",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.RequiredHeaders = map[string]configopaque.String{"key": ""}
+				return wh
+			},
+			wantErr: errRequiredHeader,
+		},
+		{
+			name: "required header overrides fixed gitlab header",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.RequiredHeaders = map[string]configopaque.String{"X-Gitlab-Token": "value"}
+				return wh
+			},
+			wantErr: errGitLabHeader,
+		},
+		{
+			name: "async queue size not positive",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.Async.Enabled = true
+				wh.Async.QueueSize = 0
+				return wh
+			},
+			wantErr: errAsyncQueueSize,
+		},
+		{
+			name: "async num workers not positive",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.Async.Enabled = true
+				wh.Async.NumWorkers = 0
+				return wh
+			},
+			wantErr: errAsyncNumWorkers,
+		},
+		{
+			name: "async overflow unknown",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.Async.Enabled = true
+				wh.Async.Overflow = "retry"
+				return wh
+			},
+			wantErr: errAsyncOverflow,
+		},
+		{
+			name: "async settings ignored when disabled",
+			webhook: func() WebHook {
+				wh := defaultWebHook
+				wh.Async.Overflow = "retry"
+				return wh
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{
+				Scrapers: map[string]internal.Config{
+					gitlabscraper.TypeStr: (&gitlabscraper.Factory{}).CreateDefaultConfig(),
+				},
+				WebHook: test.webhook(),
+			}
+			err := cfg.Validate()
+			if test.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
 func TestConfig_Unmarshal(t *testing.T) {
 	type fields struct {
 		ControllerConfig     scraperhelper.ControllerConfig
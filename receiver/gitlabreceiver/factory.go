@@ -7,8 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
 	"go.opentelemetry.io/collector/consumer"
 	"go.opentelemetry.io/collector/receiver"
 	"go.opentelemetry.io/collector/scraper"
@@ -23,6 +26,21 @@ import (
 
 // This file implements a factory for the gitlab receiver
 
+const (
+	defaultReadTimeout  = 500 * time.Millisecond
+	defaultWriteTimeout = 500 * time.Millisecond
+	defaultPath         = "/events"
+	defaultHealthPath   = "/health"
+	defaultEndpoint     = "localhost:8080"
+
+	// defaultAsyncQueueSize is the number of deliveries which can wait to be
+	// processed when processing asynchronously.
+	defaultAsyncQueueSize = 1000
+	// defaultAsyncNumWorkers is the number of deliveries processed
+	// concurrently when processing asynchronously.
+	defaultAsyncNumWorkers = 4
+)
+
 var (
 	scraperFactories = map[string]internal.ScraperFactory{
 		gitlabscraper.TypeStr:          &gitlabscraper.Factory{},
@@ -39,6 +57,7 @@ func NewFactory() receiver.Factory {
 		metadata.Type,
 		createDefaultConfig,
 		receiver.WithMetrics(createMetricsReceiver, metadata.MetricsStability),
+		receiver.WithTraces(createTracesReceiver, metadata.TracesStability),
 	)
 }
 
@@ -61,6 +80,35 @@ func createDefaultConfig() component.Config {
 		// TODO: aqp completely remove these comments if the metrics build config
 		// needs to be defined in each scraper
 		MetricsBuilderConfig: metadata.NewDefaultMetricsBuilderConfig(),
+		WebHook: WebHook{
+			ServerConfig: confighttp.ServerConfig{
+				NetAddr: confignet.AddrConfig{
+					Endpoint:  defaultEndpoint,
+					Transport: confignet.TransportTypeTCP,
+				},
+				ReadTimeout:  defaultReadTimeout,
+				WriteTimeout: defaultWriteTimeout,
+			},
+			GitLabHeaders: GitLabHeaders{
+				Customizable: map[string]string{
+					defaultUserAgentHeader: "",
+				},
+				Fixed: map[string]string{
+					defaultGitLabEventHeader:       "",
+					defaultGitLabTokenHeader:       "",
+					defaultGitLabEventUUIDHeader:   "",
+					defaultGitLabWebhookUUIDHeader: "",
+					defaultGitLabInstanceHeader:    "",
+				},
+			},
+			Path:       defaultPath,
+			HealthPath: defaultHealthPath,
+			Async: WebHookAsync{
+				QueueSize:  defaultAsyncQueueSize,
+				NumWorkers: defaultAsyncNumWorkers,
+				Overflow:   overflowBlock,
+			},
+		},
 	}
 }
 
@@ -92,6 +140,21 @@ func createMetricsReceiver(
 	)
 }
 
+func createTracesReceiver(
+	_ context.Context,
+	params receiver.Settings,
+	cfg component.Config,
+	consumer consumer.Traces,
+) (receiver.Traces, error) {
+	// check that the configuration is valid
+	conf, ok := cfg.(*Config)
+	if !ok {
+		return nil, errConfigNotValid
+	}
+
+	return newTracesReceiver(params, conf, consumer)
+}
+
 func createAddScraperOpts(
 	ctx context.Context,
 	params receiver.Settings,
@@ -28,6 +28,7 @@ const (
 	_ AttributeVcsChangeState = iota
 	AttributeVcsChangeStateOpen
 	AttributeVcsChangeStateMerged
+	AttributeVcsChangeStateClosed
 )
 
 // String returns the string representation of the AttributeVcsChangeState.
@@ -37,6 +38,8 @@ func (av AttributeVcsChangeState) String() string {
 		return "open"
 	case AttributeVcsChangeStateMerged:
 		return "merged"
+	case AttributeVcsChangeStateClosed:
+		return "closed"
 	}
 	return ""
 }
@@ -45,6 +48,7 @@ func (av AttributeVcsChangeState) String() string {
 var MapAttributeVcsChangeState = map[string]AttributeVcsChangeState{
 	"open":   AttributeVcsChangeStateOpen,
 	"merged": AttributeVcsChangeStateMerged,
+	"closed": AttributeVcsChangeStateClosed,
 }
 
 // AttributeVcsLineChangeType specifies the value vcs.line_change.type attribute.
@@ -617,7 +621,7 @@ type metricVcsChangeCount struct {
 // init fills vcs.change.count metric with initial data.
 func (m *metricVcsChangeCount) init() {
 	m.data.SetName("vcs.change.count")
-	m.data.SetDescription("The number of changes (pull requests) in a repository, categorized by their state (either open or merged).")
+	m.data.SetDescription("The number of changes (pull requests) in a repository, categorized by their state (open, merged or closed without merging).")
 	m.data.SetUnit("{change}")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
@@ -715,7 +719,7 @@ type metricVcsChangeDuration struct {
 // init fills vcs.change.duration metric with initial data.
 func (m *metricVcsChangeDuration) init() {
 	m.data.SetName("vcs.change.duration")
-	m.data.SetDescription("The time duration a change (pull request/merge request/changelist) has been in an open state.")
+	m.data.SetDescription("The time duration a change (pull request/merge request/changelist) has been in a given state (open, or until it was merged or closed).")
 	m.data.SetUnit("s")
 	m.data.SetEmptyGauge()
 	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
@@ -19,18 +19,22 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
+	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadata"
 )
 
 var errClientNotInitErr = errors.New("http client not initialized")
 
 type gitlabCatalogScraper struct {
-	client   *http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	logger   *zap.Logger
-	mb       *metadata.MetricsBuilder
-	rb       *metadata.ResourceBuilder
+	client    *http.Client
+	cfg       *Config
+	settings  component.TelemetrySettings
+	logger    *zap.Logger
+	mb        *metadata.MetricsBuilder
+	rb        *metadata.ResourceBuilder
+	telemetry *metadata.TelemetryBuilder
+	// scraperTelemetry records the requests and the scrapes of the scraper
+	scraperTelemetry *internal.ScraperTelemetry
 }
 
 func (gcs *gitlabCatalogScraper) start(ctx context.Context, host component.Host) (err error) {
@@ -42,9 +46,27 @@ func (gcs *gitlabCatalogScraper) start(ctx context.Context, host component.Host)
 	}
 
 	gcs.client, err = gcs.cfg.ToClient(ctx, extensions, gcs.settings)
+	if err != nil {
+		return
+	}
+
+	gcs.telemetry, err = metadata.NewTelemetryBuilder(gcs.settings)
+	if err != nil {
+		return
+	}
+
+	gcs.scraperTelemetry = internal.NewScraperTelemetry(gcs.telemetry, TypeStr)
+	gcs.client.Transport = gcs.scraperTelemetry.Transport(gcs.client.Transport)
 	return
 }
 
+func (gcs *gitlabCatalogScraper) shutdown(context.Context) error {
+	if gcs.telemetry != nil {
+		gcs.telemetry.Shutdown()
+	}
+	return nil
+}
+
 func newGitLabCatalogScraper(
 	_ context.Context,
 	settings receiver.Settings,
@@ -64,7 +86,10 @@ func (gcs *gitlabCatalogScraper) scrape(ctx context.Context) (pmetric.Metrics, e
 		return pmetric.NewMetrics(), errClientNotInitErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	start := time.Now()
+	defer gcs.scraperTelemetry.RecordScrape(ctx, start)
+
+	now := pcommon.NewTimestampFromTime(start)
 
 	graphCURL := "https://gitlab.com/api/graphql"
 	restCURL := "https://gitlab.com/"
@@ -98,6 +123,7 @@ func (gcs *gitlabCatalogScraper) scrape(ctx context.Context) (pmetric.Metrics, e
 	if err != nil {
 		return gcs.mb.Emit(), fmt.Errorf("error fetching projects for org '%s': %w", gcs.cfg.GitLabOrg, err)
 	}
+	gcs.scraperTelemetry.RecordRepositories(ctx, len(projectList))
 
 	// Step 1: Query componentUsages per project to record per-project counts
 	// and identify which projects use components
@@ -19,10 +19,14 @@ type MergeRequestNode struct {
 	SourceBranch string `json:"sourceBranch"`
 	// Target branch of the merge request.
 	TargetBranch string `json:"targetBranch"`
+	// State of the merge request.
+	State MergeRequestState `json:"state"`
 	// Timestamp of when the merge request was created.
 	CreatedAt time.Time `json:"createdAt"`
 	// Timestamp of when the merge request was merged, null if not merged.
 	MergedAt time.Time `json:"mergedAt"`
+	// Timestamp of when the merge request was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
 	// Summary of which files were changed in this merge request.
 	DiffStatsSummary MergeRequestNodeDiffStatsSummary `json:"diffStatsSummary"`
 }
@@ -39,12 +43,18 @@ func (v *MergeRequestNode) GetSourceBranch() string { return v.SourceBranch }
 // GetTargetBranch returns MergeRequestNode.TargetBranch, and is useful for accessing the field via an interface.
 func (v *MergeRequestNode) GetTargetBranch() string { return v.TargetBranch }
 
+// GetState returns MergeRequestNode.State, and is useful for accessing the field via an interface.
+func (v *MergeRequestNode) GetState() MergeRequestState { return v.State }
+
 // GetCreatedAt returns MergeRequestNode.CreatedAt, and is useful for accessing the field via an interface.
 func (v *MergeRequestNode) GetCreatedAt() time.Time { return v.CreatedAt }
 
 // GetMergedAt returns MergeRequestNode.MergedAt, and is useful for accessing the field via an interface.
 func (v *MergeRequestNode) GetMergedAt() time.Time { return v.MergedAt }
 
+// GetUpdatedAt returns MergeRequestNode.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *MergeRequestNode) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
 // GetDiffStatsSummary returns MergeRequestNode.DiffStatsSummary, and is useful for accessing the field via an interface.
 func (v *MergeRequestNode) GetDiffStatsSummary() MergeRequestNodeDiffStatsSummary {
 	return v.DiffStatsSummary
@@ -264,8 +274,10 @@ query getMergeRequests ($fullPath: ID!, $after: String, $state: MergeRequestStat
 				title
 				sourceBranch
 				targetBranch
+				state
 				createdAt
 				mergedAt
+				updatedAt
 				diffStatsSummary {
 					additions
 					deletions
@@ -18,18 +18,22 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
+	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadata"
 )
 
 var errClientNotInitErr = errors.New("http client not initialized")
 
 type gitlabScraper struct {
-	client   *http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	logger   *zap.Logger
-	mb       *metadata.MetricsBuilder
-	rb       *metadata.ResourceBuilder
+	client    *http.Client
+	cfg       *Config
+	settings  component.TelemetrySettings
+	logger    *zap.Logger
+	mb        *metadata.MetricsBuilder
+	rb        *metadata.ResourceBuilder
+	telemetry *metadata.TelemetryBuilder
+	// scraperTelemetry records the requests and the scrapes of the scraper
+	scraperTelemetry *internal.ScraperTelemetry
 }
 
 func (gls *gitlabScraper) start(ctx context.Context, host component.Host) (err error) {
@@ -44,9 +48,27 @@ func (gls *gitlabScraper) start(ctx context.Context, host component.Host) (err e
 	}
 
 	gls.client, err = gls.cfg.ToClient(ctx, extensions, gls.settings)
+	if err != nil {
+		return
+	}
+
+	gls.telemetry, err = metadata.NewTelemetryBuilder(gls.settings)
+	if err != nil {
+		return
+	}
+
+	gls.scraperTelemetry = internal.NewScraperTelemetry(gls.telemetry, TypeStr)
+	gls.client.Transport = gls.scraperTelemetry.Transport(gls.client.Transport)
 	return
 }
 
+func (gls *gitlabScraper) shutdown(context.Context) error {
+	if gls.telemetry != nil {
+		gls.telemetry.Shutdown()
+	}
+	return nil
+}
+
 func newGitLabScraper(
 	_ context.Context,
 	settings receiver.Settings,
@@ -68,7 +90,10 @@ func (gls *gitlabScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 		return pmetric.NewMetrics(), errClientNotInitErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	start := time.Now()
+	defer gls.scraperTelemetry.RecordScrape(ctx, start)
+
+	now := pcommon.NewTimestampFromTime(start)
 
 	gls.logger.Sugar().Debugf("current time: %v", now)
 
@@ -109,6 +134,7 @@ func (gls *gitlabScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 	}
 	// record repository count metric
 	gls.mb.RecordVcsRepositoryCountDataPoint(now, int64(len(projectList)))
+	gls.scraperTelemetry.RecordRepositories(ctx, len(projectList))
 
 	var wg sync.WaitGroup
 	wg.Add(len(projectList))
@@ -187,23 +213,35 @@ func (gls *gitlabScraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
 			gls.mb.RecordVcsContributorCountDataPoint(now, int64(contributorCount), url, path, projectID)
 			// gls.mb.RecordVcsRepositoryContributorCountDataPoint(now, int64(contributorCount), path)
 
+			var open, merged, closed int64
+
 			for _, mr := range mrs {
 				//nolint:lll
 				gls.mb.RecordVcsRefLinesDeltaDataPoint(now, int64(mr.DiffStatsSummary.Additions), mr.Iid, url, path, projectID, mr.SourceBranch, refType, mr.TargetBranch, metadata.AttributeVcsRefBaseTypeBranch, metadata.AttributeVcsLineChangeTypeAdded)
 				//nolint:lll
 				gls.mb.RecordVcsRefLinesDeltaDataPoint(now, int64(mr.DiffStatsSummary.Deletions), mr.Iid, url, path, projectID, mr.SourceBranch, refType, mr.TargetBranch, metadata.AttributeVcsRefBaseTypeBranch, metadata.AttributeVcsLineChangeTypeRemoved)
 
-				// Checks if the merge request has been merged. This is done with IsZero() which tells us if the
-				// time is or isn't  January 1, year 1, 00:00:00 UTC, which is what null in graphql date values
-				// get returned as in Go.
-				if mr.MergedAt.IsZero() {
-					mrAge := int64(time.Since(mr.CreatedAt).Seconds())
-					gls.mb.RecordVcsChangeDurationDataPoint(now, mrAge, url, path, projectID, mr.SourceBranch, metadata.AttributeVcsChangeStateOpen)
-				} else {
+				switch mr.State {
+				case MergeRequestStateMerged:
+					merged++
 					mergedAge := int64(mr.MergedAt.Sub(mr.CreatedAt).Seconds())
 					gls.mb.RecordVcsChangeTimeToMergeDataPoint(now, mergedAge, url, path, projectID, mr.SourceBranch)
+				case MergeRequestStateClosed:
+					// A merge request closed without being merged was abandoned. The GitLab API
+					// does not expose when it was closed, so its last update is used instead.
+					closed++
+					closedAge := int64(mr.UpdatedAt.Sub(mr.CreatedAt).Seconds())
+					gls.mb.RecordVcsChangeDurationDataPoint(now, closedAge, url, path, projectID, mr.SourceBranch, metadata.AttributeVcsChangeStateClosed)
+				default:
+					open++
+					mrAge := int64(time.Since(mr.CreatedAt).Seconds())
+					gls.mb.RecordVcsChangeDurationDataPoint(now, mrAge, url, path, projectID, mr.SourceBranch, metadata.AttributeVcsChangeStateOpen)
 				}
 			}
+
+			gls.mb.RecordVcsChangeCountDataPoint(now, open, url, metadata.AttributeVcsChangeStateOpen, path, projectID)
+			gls.mb.RecordVcsChangeCountDataPoint(now, merged, url, metadata.AttributeVcsChangeStateMerged, path, projectID)
+			gls.mb.RecordVcsChangeCountDataPoint(now, closed, url, metadata.AttributeVcsChangeStateClosed, path, projectID)
 			mux.Unlock()
 		}()
 	}
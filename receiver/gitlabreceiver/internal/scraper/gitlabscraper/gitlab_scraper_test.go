@@ -94,6 +94,7 @@ func TestScrape(t *testing.T) {
 								{
 									Title:        "mr1",
 									Iid:          "1",
+									State:        MergeRequestStateOpened,
 									SourceBranch: "feature-a",
 									TargetBranch: "main",
 									CreatedAt:    time.Now().AddDate(0, 0, -1),
@@ -109,6 +110,7 @@ func TestScrape(t *testing.T) {
 								{
 									Title:        "mr2",
 									Iid:          "2",
+									State:        MergeRequestStateMerged,
 									SourceBranch: "feature-a",
 									TargetBranch: "main",
 									CreatedAt:    time.Now().AddDate(0, 0, -2),
@@ -120,6 +122,23 @@ func TestScrape(t *testing.T) {
 								},
 							},
 						},
+						{
+							Nodes: []MergeRequestNode{
+								{
+									Title:        "mr3",
+									Iid:          "3",
+									State:        MergeRequestStateClosed,
+									SourceBranch: "feature-b",
+									TargetBranch: "main",
+									CreatedAt:    time.Now().AddDate(0, 0, -3),
+									UpdatedAt:    time.Now().AddDate(0, 0, -2),
+									DiffStatsSummary: MergeRequestNodeDiffStatsSummary{
+										Additions: 3,
+										Deletions: 1,
+									},
+								},
+							},
+						},
 					},
 					responseCode: http.StatusOK,
 				},
@@ -212,7 +212,7 @@ func (gls *gitlabScraper) getCombinedMergeRequests(
 ) ([]MergeRequestNode, error) {
 	createdAfter := time.Time{}
 
-	// If a limit is specified, only retrieve merged MRs from the last X days
+	// If a limit is specified, only retrieve merged and closed MRs from the last X days
 	if limit > 0 {
 		createdAfter = time.Now().AddDate(0, 0, (-1 * limit))
 	}
@@ -226,6 +226,11 @@ func (gls *gitlabScraper) getCombinedMergeRequests(
 	if err != nil {
 		return nil, err
 	}
+	closedMrs, err := gls.getMergeRequests(ctx, graphClient, projectPath, MergeRequestStateClosed, createdAfter)
+	if err != nil {
+		return nil, err
+	}
 	mrs := append(openMrs, mergedMrs...)
+	mrs = append(mrs, closedMrs...)
 	return mrs, nil
 }
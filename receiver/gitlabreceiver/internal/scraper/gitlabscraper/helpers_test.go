@@ -429,7 +429,7 @@ func TestGetCombinedMergeRequests(t *testing.T) {
 					responseCode: http.StatusOK,
 				},
 			}),
-			expectedCount: 2, //because theres two calls to getMergeRequests using this same data
+			expectedCount: 3, //because theres three calls to getMergeRequests using this same data
 			expectedErr:   nil,
 		},
 		{
@@ -471,7 +471,7 @@ func TestGetCombinedMergeRequests(t *testing.T) {
 					responseCode: http.StatusOK,
 				},
 			}),
-			expectedCount: 6,
+			expectedCount: 9,
 			expectedErr:   nil,
 		},
 		{
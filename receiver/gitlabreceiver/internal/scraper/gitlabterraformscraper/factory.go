@@ -43,5 +43,6 @@ func (f *Factory) CreateMetricsScraper(
 	return scraper.NewMetrics(
 		s.scrape,
 		scraper.WithStart(s.start),
+		scraper.WithShutdown(s.shutdown),
 	)
 }
@@ -15,18 +15,22 @@ import (
 	"go.opentelemetry.io/collector/receiver"
 	"go.uber.org/zap"
 
+	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal"
 	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadata"
 )
 
 var errClientNotInitErr = errors.New("http client not initialized")
 
 type gitlabTerraformScraper struct {
-	client   *http.Client
-	cfg      *Config
-	settings component.TelemetrySettings
-	logger   *zap.Logger
-	mb       *metadata.MetricsBuilder
-	rb       *metadata.ResourceBuilder
+	client    *http.Client
+	cfg       *Config
+	settings  component.TelemetrySettings
+	logger    *zap.Logger
+	mb        *metadata.MetricsBuilder
+	rb        *metadata.ResourceBuilder
+	telemetry *metadata.TelemetryBuilder
+	// scraperTelemetry records the requests and the scrapes of the scraper
+	scraperTelemetry *internal.ScraperTelemetry
 }
 
 func (gts *gitlabTerraformScraper) start(ctx context.Context, host component.Host) (err error) {
@@ -38,9 +42,27 @@ func (gts *gitlabTerraformScraper) start(ctx context.Context, host component.Hos
 	}
 
 	gts.client, err = gts.cfg.ToClient(ctx, extensions, gts.settings)
+	if err != nil {
+		return
+	}
+
+	gts.telemetry, err = metadata.NewTelemetryBuilder(gts.settings)
+	if err != nil {
+		return
+	}
+
+	gts.scraperTelemetry = internal.NewScraperTelemetry(gts.telemetry, TypeStr)
+	gts.client.Transport = gts.scraperTelemetry.Transport(gts.client.Transport)
 	return
 }
 
+func (gts *gitlabTerraformScraper) shutdown(context.Context) error {
+	if gts.telemetry != nil {
+		gts.telemetry.Shutdown()
+	}
+	return nil
+}
+
 func newGitLabTerraformScraper(
 	_ context.Context,
 	settings receiver.Settings,
@@ -60,7 +82,10 @@ func (gts *gitlabTerraformScraper) scrape(ctx context.Context) (pmetric.Metrics,
 		return pmetric.NewMetrics(), errClientNotInitErr
 	}
 
-	now := pcommon.NewTimestampFromTime(time.Now())
+	start := time.Now()
+	defer gts.scraperTelemetry.RecordScrape(ctx, start)
+
+	now := pcommon.NewTimestampFromTime(start)
 
 	// Build the REST client URL, supporting self-hosted GitLab instances
 	restCURL := "https://gitlab.com/"
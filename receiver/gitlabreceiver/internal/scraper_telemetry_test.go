@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadata"
+	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadatatest"
+)
+
+func TestRequestEndpoint(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://gitlab.com/api/graphql", expected: "/graphql"},
+		{url: "https://gitlab.com/api/v4/groups/liatrio/projects?per_page=100", expected: "/groups/{group}/projects"},
+		{url: "https://gitlab.com/api/v4/groups/liatrio%2Fsub/packages", expected: "/groups/{group}/packages"},
+		{url: "https://gitlab.com/api/v4/projects/42", expected: "/projects/{project}"},
+		{url: "https://gitlab.com/api/v4/projects/liatrio%2Frepo1/repository/contributors", expected: "/projects/{project}/repository/contributors"},
+		{
+			url:      "https://gitlab.com/api/v4/projects/liatrio%2Frepo1/repository/files/.gitlab-ci.yml/raw?ref=main",
+			expected: "/projects/{project}/repository/files/{file}/raw",
+		},
+		{
+			url:      "https://gitlab.com/api/v4/projects/liatrio%2Frepo1/repository/commits/0123456789abcdef0123456789abcdef01234567",
+			expected: "/projects/{project}/repository/commits/{sha}",
+		},
+		{url: "https://gitlab.com/api/v4/projects/liatrio%2Frepo1/pipelines/7/jobs", expected: "/projects/{project}/pipelines/{id}/jobs"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, tc.url, http.NoBody)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, requestEndpoint(req))
+		})
+	}
+}
+
+func TestScraperTelemetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() == "/api/v4/projects/liatrio%2Fmissing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tel := componenttest.NewTelemetry()
+	defer func() { require.NoError(t, tel.Shutdown(context.Background())) }()
+
+	telemetry, err := metadata.NewTelemetryBuilder(metadatatest.NewSettings(tel).TelemetrySettings)
+	require.NoError(t, err)
+
+	st := NewScraperTelemetry(telemetry, "gitlab")
+	client := &http.Client{Transport: st.Transport(nil)}
+
+	for _, path := range []string{"/api/graphql", "/api/graphql", "/api/v4/projects/liatrio%2Fmissing"} {
+		resp, err := client.Post(server.URL+path, "application/json", http.NoBody)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// a request failing without a response is an error without status code
+	_, err = client.Get("http://127.0.0.1:0/api/graphql")
+	require.Error(t, err)
+
+	st.RecordScrape(context.Background(), time.Now())
+	st.RecordRepositories(context.Background(), 2)
+
+	scraper := attribute.String("scraper", "gitlab")
+	graphql := attribute.String("endpoint", "/graphql")
+	project := attribute.String("endpoint", "/projects/{project}")
+
+	metadatatest.AssertEqualReceiverScraperRequests(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 2, Attributes: attribute.NewSet(scraper, graphql, attribute.Int("http.response.status_code", http.StatusOK))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, project, attribute.Int("http.response.status_code", http.StatusNotFound))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, graphql)},
+		},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualReceiverScraperRequestErrors(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 1, Attributes: attribute.NewSet(scraper, project, attribute.String("error.type", "404"))},
+			{Value: 1, Attributes: attribute.NewSet(scraper, graphql, attribute.String("error.type", "_OTHER"))},
+		},
+		metricdatatest.IgnoreTimestamp())
+	metadatatest.AssertEqualReceiverScraperRepositories(t, tel,
+		[]metricdata.DataPoint[int64]{
+			{Value: 2, Attributes: attribute.NewSet(scraper)},
+		},
+		metricdatatest.IgnoreTimestamp())
+}
+
+func TestScraperTelemetryNotStarted(t *testing.T) {
+	var st *ScraperTelemetry
+	assert.NotPanics(t, func() {
+		st.RecordScrape(context.Background(), time.Now())
+		st.RecordRepositories(context.Background(), 1)
+	})
+}
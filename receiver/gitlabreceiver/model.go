@@ -0,0 +1,278 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlabreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go/v2"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+)
+
+// model.go contains the attributes used by the GitLab traces receiver which are
+// not yet part of the semantic conventions package. They mirror the
+// experimental attributes used by the GitHub receiver so that CI/CD traces from
+// both vendors can be queried the same way.
+const (
+	// vcs.ref.head.type with enum values of branch or tag.
+	AttributeVCSRefHeadTypeBranch = "branch"
+	AttributeVCSRefHeadTypeTag    = "tag"
+
+	// These are being added in https://github.com/open-telemetry/semantic-conventions/pull/1681
+	AttributeCICDPipelineRunStatusKey       = attribute.Key("cicd.pipeline.run.status") // equivalent to GitLab's pipeline `status`
+	AttributeCICDPipelineRunStatusCancelled = "cancelled"
+	AttributeCICDPipelineRunStatusFailure   = "failure"
+	AttributeCICDPipelineRunStatusSkipped   = "skipped"
+	AttributeCICDPipelineRunStatusSuccess   = "success"
+
+	AttributeCICDPipelineTaskRunStatusKey = attribute.Key("cicd.pipeline.task.run.status") // equivalent to GitLab's job `build_status`
+
+	// The following attributes are not part of the semantic conventions yet.
+	AttributeCICDPipelineRunSenderLoginKey     = attribute.Key("cicd.pipeline.run.sender.login")      // GitLab's Pipeline User
+	AttributeCICDPipelineTaskRunSenderLoginKey = attribute.Key("cicd.pipeline.task.run.sender.login") // GitLab's Job User
+	AttributeCICDPipelineStageNameKey          = attribute.Key("cicd.pipeline.stage.name")            // GitLab's Stage Name
+	AttributeCICDPipelineWorkerIDKey           = attribute.Key("cicd.pipeline.worker.id")             // GitLab's Runner ID
+	AttributeCICDPipelineWorkerNameKey         = attribute.Key("cicd.pipeline.worker.name")           // GitLab's Runner Description
+	AttributeCICDPipelineWorkerTypeKey         = attribute.Key("cicd.pipeline.worker.type")           // GitLab's Runner Type
+	AttributeCICDPipelineWorkerLabelsKey       = attribute.Key("cicd.pipeline.worker.labels")         // GitLab's Runner Tags
+	AttributeCICDPipelineRunQueueDurationKey   = attribute.Key("cicd.pipeline.run.queue.duration")    // GitLab's Queued Duration
+
+	// The following attributes are exclusive to GitLab but not listed under
+	// Vendor Extensions within Semantic Conventions yet.
+	AttributeGitLabPipelineSource       = "gitlab.pipeline.source"            // GitLab's Pipeline Source (ie. push, merge_request_event)
+	AttributeGitLabJobFailureReason     = "gitlab.job.failure_reason"         // GitLab's Job Failure Reason
+	AttributeGitLabJobAllowFailure      = "gitlab.job.allow_failure"          // GitLab's Job Allow Failure flag
+	AttributeGitLabJobRetriesCount      = "gitlab.job.retries_count"          // GitLab's Job Retries Count
+	AttributeGitLabEnvironmentName      = "gitlab.environment.name"           // GitLab's Job Environment Name
+	AttributeGitLabEnvironmentTier      = "gitlab.environment.tier"           // GitLab's Job Environment Deployment Tier
+	AttributeGitLabMergeRequestIID      = "gitlab.merge_request.iid"          // GitLab's Merge Request IID when triggered by a MR
+	AttributeGitLabSourcePipelineID     = "gitlab.source_pipeline.id"         // GitLab's upstream (parent or multi-project) pipeline ID
+	AttributeGitLabSourcePipelineJobID  = "gitlab.source_pipeline.job.id"     // GitLab's upstream trigger job ID
+	AttributeGitLabSourcePipelineProjID = "gitlab.source_pipeline.project.id" // GitLab's upstream project ID
+
+	// SECURITY: This information will always exist on the repository, but may
+	// be considered private if the repository is set to private. Care should be
+	// taken in the data pipeline for sanitizing sensitive user information if
+	// the user deems it as such.
+	AttributeVCSRefHeadRevisionAuthorName  = "vcs.ref.head.revision.author.name"  // GitLab's Commit Author Name
+	AttributeVCSRefHeadRevisionAuthorEmail = "vcs.ref.head.revision.author.email" // GitLab's Commit Author Email
+	AttributeVCSVendorName                 = "vcs.vendor.name"                    // GitLab
+)
+
+// gitLabTimeLayouts are the time layouts GitLab uses across webhook payloads.
+// Pipeline events use `2006-01-02 15:04:05 UTC` while job events additionally
+// carry ISO 8601 variants.
+var gitLabTimeLayouts = []string{
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+}
+
+// getPipelineAttrs sets the resource attributes for the GitLab Pipeline Hook
+// event type and returns an error if one occurs.
+func (gtr *gitlabTracesReceiver) getPipelineAttrs(resource pcommon.Resource, e *gitlab.PipelineEvent) error {
+	attrs := resource.Attributes()
+	var err error
+
+	svc, err := gtr.getServiceName(e.Project.PathWithNamespace)
+	if err != nil {
+		err = errors.New("failed to get service.name")
+	}
+
+	attrs.PutStr(string(semconv.ServiceNameKey), svc)
+
+	// VCS Attributes
+	attrs.PutStr(string(semconv.VCSRepositoryNameKey), e.Project.PathWithNamespace)
+	attrs.PutStr(string(semconv.VCSRepositoryURLFullKey), e.Project.WebURL)
+	attrs.PutStr(AttributeVCSVendorName, "gitlab")
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), e.ObjectAttributes.Ref)
+	attrs.PutStr(string(semconv.VCSRefHeadTypeKey), refType(e.ObjectAttributes.Tag))
+	attrs.PutStr(string(semconv.VCSRefHeadRevisionKey), e.ObjectAttributes.SHA)
+	attrs.PutStr(AttributeVCSRefHeadRevisionAuthorName, e.Commit.Author.Name)
+	attrs.PutStr(AttributeVCSRefHeadRevisionAuthorEmail, e.Commit.Author.Email)
+
+	// CICD Attributes
+	attrs.PutStr(string(semconv.CICDPipelineNameKey), pipelineName(e))
+	if e.User != nil {
+		attrs.PutStr(string(AttributeCICDPipelineRunSenderLoginKey), e.User.Username)
+	}
+	attrs.PutStr(string(semconv.CICDPipelineRunURLFullKey), pipelineURL(e.ObjectAttributes.URL, e.Project.WebURL, e.ObjectAttributes.ID))
+	attrs.PutInt(string(semconv.CICDPipelineRunIDKey), e.ObjectAttributes.ID)
+	attrs.PutStr(string(AttributeCICDPipelineRunStatusKey), convertStatus(e.ObjectAttributes.Status))
+	attrs.PutDouble(string(AttributeCICDPipelineRunQueueDurationKey), float64(time.Duration(e.ObjectAttributes.QueuedDuration)*time.Second))
+
+	// GitLab Specific Attributes
+	attrs.PutStr(AttributeGitLabPipelineSource, e.ObjectAttributes.Source)
+	if e.MergeRequest.IID != 0 {
+		attrs.PutInt(AttributeGitLabMergeRequestIID, e.MergeRequest.IID)
+	}
+	if e.SourcePipeline.PipelineID != 0 {
+		attrs.PutInt(AttributeGitLabSourcePipelineID, e.SourcePipeline.PipelineID)
+		attrs.PutInt(AttributeGitLabSourcePipelineJobID, e.SourcePipeline.JobID)
+		attrs.PutInt(AttributeGitLabSourcePipelineProjID, e.SourcePipeline.Project.ID)
+	}
+
+	return err
+}
+
+// getJobAttrs sets the resource attributes for the GitLab Job Hook event type
+// and returns an error if one occurs.
+func (gtr *gitlabTracesReceiver) getJobAttrs(resource pcommon.Resource, e *gitlab.JobEvent) error {
+	attrs := resource.Attributes()
+	var err error
+
+	svc, err := gtr.getServiceName(e.Project.PathWithNamespace)
+	if err != nil {
+		err = errors.New("failed to get service.name")
+	}
+
+	attrs.PutStr(string(semconv.ServiceNameKey), svc)
+
+	// VCS Attributes
+	attrs.PutStr(string(semconv.VCSRepositoryNameKey), e.Project.PathWithNamespace)
+	attrs.PutStr(string(semconv.VCSRepositoryURLFullKey), e.Project.WebURL)
+	attrs.PutStr(AttributeVCSVendorName, "gitlab")
+	attrs.PutStr(string(semconv.VCSRefHeadNameKey), e.Ref)
+	attrs.PutStr(string(semconv.VCSRefHeadTypeKey), refType(e.Tag))
+	attrs.PutStr(string(semconv.VCSRefHeadRevisionKey), e.SHA)
+	attrs.PutStr(AttributeVCSRefHeadRevisionAuthorName, e.Commit.AuthorName)
+	attrs.PutStr(AttributeVCSRefHeadRevisionAuthorEmail, e.Commit.AuthorEmail)
+
+	// CICD Worker (GitLab Runner) Attributes
+	attrs.PutInt(string(AttributeCICDPipelineWorkerIDKey), e.Runner.ID)
+	attrs.PutStr(string(AttributeCICDPipelineWorkerNameKey), e.Runner.Description)
+	attrs.PutStr(string(AttributeCICDPipelineWorkerTypeKey), e.Runner.RunnerType)
+
+	if len(e.Runner.Tags) > 0 {
+		labels := attrs.PutEmptySlice(string(AttributeCICDPipelineWorkerLabelsKey))
+		labels.EnsureCapacity(len(e.Runner.Tags))
+		for _, tag := range e.Runner.Tags {
+			labels.AppendEmpty().SetStr(strings.ToLower(tag))
+		}
+	}
+
+	// CICD Attributes
+	attrs.PutStr(string(semconv.CICDPipelineTaskNameKey), e.BuildName)
+	attrs.PutStr(string(AttributeCICDPipelineStageNameKey), e.BuildStage)
+	if e.User != nil {
+		attrs.PutStr(string(AttributeCICDPipelineTaskRunSenderLoginKey), e.User.Username)
+	}
+	attrs.PutStr(string(semconv.CICDPipelineTaskRunURLFullKey), fmt.Sprintf("%s/-/jobs/%d", e.Project.WebURL, e.BuildID))
+	attrs.PutInt(string(semconv.CICDPipelineTaskRunIDKey), e.BuildID)
+	attrs.PutInt(string(semconv.CICDPipelineRunIDKey), e.PipelineID)
+	attrs.PutStr(string(AttributeCICDPipelineTaskRunStatusKey), convertStatus(e.BuildStatus))
+	attrs.PutDouble(string(AttributeCICDPipelineRunQueueDurationKey), e.BuildQueuedDuration*float64(time.Second))
+
+	// GitLab Specific Attributes
+	attrs.PutBool(AttributeGitLabJobAllowFailure, e.BuildAllowFailure)
+	attrs.PutInt(AttributeGitLabJobRetriesCount, e.RetriesCount)
+	if e.BuildFailureReason != "" && convertStatus(e.BuildStatus) == AttributeCICDPipelineRunStatusFailure {
+		attrs.PutStr(AttributeGitLabJobFailureReason, e.BuildFailureReason)
+	}
+	if e.Environment.Name != "" {
+		attrs.PutStr(AttributeGitLabEnvironmentName, e.Environment.Name)
+		attrs.PutStr(AttributeGitLabEnvironmentTier, e.Environment.DeploymentTier)
+	}
+
+	return err
+}
+
+// isCompleted returns true when the provided GitLab pipeline or job status is
+// a final status, meaning the pipeline or job will not change state again
+// without a retry.
+func isCompleted(status string) bool {
+	switch strings.ToLower(status) {
+	case "success", "failed", "canceled", "skipped":
+		return true
+	default:
+		return false
+	}
+}
+
+// convertStatus converts a GitLab pipeline or job status into the values used
+// by the cicd.pipeline.run.status attribute.
+func convertStatus(status string) string {
+	switch strings.ToLower(status) {
+	case "success":
+		return AttributeCICDPipelineRunStatusSuccess
+	case "failed":
+		return AttributeCICDPipelineRunStatusFailure
+	case "canceled":
+		return AttributeCICDPipelineRunStatusCancelled
+	case "skipped":
+		return AttributeCICDPipelineRunStatusSkipped
+	default:
+		return strings.ToLower(status)
+	}
+}
+
+// refType returns the vcs.ref.head.type based on whether the pipeline or job
+// was triggered by a tag.
+func refType(tag bool) string {
+	if tag {
+		return AttributeVCSRefHeadTypeTag
+	}
+	return AttributeVCSRefHeadTypeBranch
+}
+
+// pipelineName returns the name of the pipeline. GitLab only sets a name when
+// the `workflow:name` keyword is used, so the project path is used otherwise.
+func pipelineName(e *gitlab.PipelineEvent) string {
+	if e.ObjectAttributes.Name != "" {
+		return e.ObjectAttributes.Name
+	}
+	return e.Project.PathWithNamespace
+}
+
+// pipelineURL returns the HTML URL of the pipeline. Older GitLab versions do
+// not send the `url` attribute so it is derived from the project URL.
+func pipelineURL(url string, projectURL string, pipelineID int64) string {
+	if url != "" {
+		return url
+	}
+	return fmt.Sprintf("%s/-/pipelines/%d", projectURL, pipelineID)
+}
+
+// parseGitLabTime parses the time formats GitLab sends in webhook payloads.
+func parseGitLabTime(value string) (time.Time, error) {
+	for _, layout := range gitLabTimeLayouts {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unable to parse time %q", value)
+}
+
+// getServiceName returns a generated service.name resource attribute derived
+// from 1) the service_name defined in the webhook configuration or 2) the
+// project path. The value returned in those cases will always be a formatted
+// string; where the string will be lowercase and underscores will be replaced
+// by hyphens. If neither is set, it returns "unknown_service" according to the
+// semantic conventions for service.name and an error.
+// https://opentelemetry.io/docs/specs/semconv/attributes-registry/service/#service-attributes
+func (gtr *gitlabTracesReceiver) getServiceName(projectPath string) (string, error) {
+	switch {
+	case gtr.cfg.WebHook.ServiceName != "":
+		return formatString(gtr.cfg.WebHook.ServiceName), nil
+	case projectPath != "":
+		parts := strings.Split(projectPath, "/")
+		return formatString(parts[len(parts)-1]), nil
+	default:
+		// This should never happen, but in the event it does, unknown_service
+		// and a error will be returned to abide by semantic conventions.
+		return "unknown_service", errors.New("unable to generate service.name resource attribute")
+	}
+}
+
+// formatString formats a string to lowercase and replaces underscores with
+// hyphens.
+func formatString(input string) string {
+	return strings.ToLower(strings.ReplaceAll(input, "_", "-"))
+}
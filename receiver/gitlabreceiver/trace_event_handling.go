@@ -0,0 +1,362 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlabreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver"
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go/v2"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// handlePipeline handles the creation of spans for a GitLab Pipeline Hook
+// event. The pipeline maps to the semantic conventions for a
+// `cicd.pipeline.run` and each of its stages is represented as a child span of
+// the pipeline root span.
+func (gtr *gitlabTracesReceiver) handlePipeline(e *gitlab.PipelineEvent) (ptrace.Traces, error) {
+	t := ptrace.NewTraces()
+	r := t.ResourceSpans().AppendEmpty()
+
+	resource := r.Resource()
+
+	err := gtr.getPipelineAttrs(resource, e)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to get pipeline attributes: %w", err)
+	}
+
+	traceID, err := newTraceID(e.ObjectAttributes.ID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to generate trace ID", zap.Error(err))
+	}
+
+	rootSpanID, err := gtr.createRootSpan(r, e, traceID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to create root span", zap.Error(err))
+		return ptrace.Traces{}, errors.New("failed to create root span")
+	}
+
+	err = gtr.createStageSpans(r, e, traceID, rootSpanID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to create stage spans", zap.Error(err))
+		return ptrace.Traces{}, errors.New("failed to create stage spans")
+	}
+
+	return t, nil
+}
+
+// handleJob handles the creation of spans for a GitLab Job Hook event. A `job`
+// maps to the semantic conventions for a `cicd.pipeline.task` and is parented
+// to the span of the stage it ran in.
+func (gtr *gitlabTracesReceiver) handleJob(e *gitlab.JobEvent) (ptrace.Traces, error) {
+	t := ptrace.NewTraces()
+	r := t.ResourceSpans().AppendEmpty()
+
+	resource := r.Resource()
+
+	err := gtr.getJobAttrs(resource, e)
+	if err != nil {
+		return ptrace.Traces{}, fmt.Errorf("failed to get job attributes: %w", err)
+	}
+
+	traceID, err := newTraceID(e.PipelineID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to generate trace ID", zap.Error(err))
+	}
+
+	err = gtr.createJobSpan(r, e, traceID)
+	if err != nil {
+		gtr.logger.Sugar().Error("failed to create job span", zap.Error(err))
+		return ptrace.Traces{}, errors.New("failed to create job span")
+	}
+
+	return t, nil
+}
+
+// newTraceID creates a deterministic Trace ID based on the provided pipelineID.
+// `t` is appended to the end of the input to differentiate between a
+// deterministic traceID and the pipelineSpanID. Retried jobs stay within the
+// same pipeline, so unlike GitHub no run attempt is part of the input.
+func newTraceID(pipelineID int64) (pcommon.TraceID, error) {
+	input := fmt.Sprintf("%dt", pipelineID)
+	hash := sha256.Sum256([]byte(input))
+	idHex := hex.EncodeToString(hash[:])
+
+	var id pcommon.TraceID
+	_, err := hex.Decode(id[:], []byte(idHex[:32]))
+	if err != nil {
+		return pcommon.TraceID{}, err
+	}
+
+	return id, nil
+}
+
+// newPipelineSpanID creates a deterministic root Span ID based on the provided
+// pipelineID. `s` is appended to the end of the input to differentiate between
+// a deterministic traceID and the pipelineSpanID.
+func newPipelineSpanID(pipelineID int64) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("%ds", pipelineID)
+	return newSpanID(input)
+}
+
+// newStageSpanID creates a deterministic Stage Span ID based on the provided
+// pipelineID and the name of the stage.
+func newStageSpanID(pipelineID int64, stage string) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("%d%s", pipelineID, stage)
+	return newSpanID(input)
+}
+
+// newJobSpanID creates a deterministic Job Span ID based on the provided
+// pipelineID and jobID. Every retry of a job has its own jobID.
+func newJobSpanID(pipelineID int64, jobID int64) (pcommon.SpanID, error) {
+	input := fmt.Sprintf("%d%dj", pipelineID, jobID)
+	return newSpanID(input)
+}
+
+// newSpanID hashes the provided input into a deterministic Span ID.
+func newSpanID(input string) (pcommon.SpanID, error) {
+	hash := sha256.Sum256([]byte(input))
+	spanIDHex := hex.EncodeToString(hash[:])
+
+	var spanID pcommon.SpanID
+	_, err := hex.Decode(spanID[:], []byte(spanIDHex[16:32]))
+	if err != nil {
+		return pcommon.SpanID{}, err
+	}
+
+	return spanID, nil
+}
+
+// createRootSpan creates a root span based on the provided event, associated
+// with the deterministic traceID.
+func (gtr *gitlabTracesReceiver) createRootSpan(
+	resourceSpans ptrace.ResourceSpans,
+	event *gitlab.PipelineEvent,
+	traceID pcommon.TraceID,
+) (pcommon.SpanID, error) {
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	span := scopeSpans.Spans().AppendEmpty()
+
+	rootSpanID, err := newPipelineSpanID(event.ObjectAttributes.ID)
+	if err != nil {
+		return pcommon.SpanID{}, fmt.Errorf("failed to generate root span ID: %w", err)
+	}
+
+	start, err := parseGitLabTime(event.ObjectAttributes.CreatedAt)
+	if err != nil {
+		return pcommon.SpanID{}, fmt.Errorf("failed to parse pipeline created_at: %w", err)
+	}
+
+	end, err := parseGitLabTime(event.ObjectAttributes.FinishedAt)
+	if err != nil {
+		// Skipped and canceled pipelines may not have a finished_at time.
+		end = start
+	}
+
+	span.SetTraceID(traceID)
+	span.SetSpanID(rootSpanID)
+	span.SetName(pipelineName(event))
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+
+	setSpanStatus(span, event.ObjectAttributes.Status)
+
+	return rootSpanID, nil
+}
+
+// createStageSpans creates a span for each stage in the pipeline. GitLab does
+// not send stage events, so the timing and status of a stage are derived from
+// the builds (jobs) within the stage. Stages where no job ever started are
+// skipped.
+func (gtr *gitlabTracesReceiver) createStageSpans(
+	resourceSpans ptrace.ResourceSpans,
+	event *gitlab.PipelineEvent,
+	traceID pcommon.TraceID,
+	parentSpanID pcommon.SpanID,
+) error {
+	builds := make(map[string][]gitlab.PipelineEventBuild, len(event.ObjectAttributes.Stages))
+	for _, build := range event.Builds {
+		builds[build.Stage] = append(builds[build.Stage], build)
+	}
+
+	var errs error
+	for _, stage := range event.ObjectAttributes.Stages {
+		err := gtr.createStageSpan(resourceSpans, event, traceID, parentSpanID, stage, builds[stage])
+		if err != nil {
+			errs = multierr.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+// createStageSpan creates a span with a deterministic spanID for the provided
+// stage, starting at the first job start time and ending at the last job
+// finish time.
+func (gtr *gitlabTracesReceiver) createStageSpan(
+	resourceSpans ptrace.ResourceSpans,
+	event *gitlab.PipelineEvent,
+	traceID pcommon.TraceID,
+	parentSpanID pcommon.SpanID,
+	stage string,
+	builds []gitlab.PipelineEventBuild,
+) error {
+	var start, end time.Time
+	for _, build := range builds {
+		if started, err := parseGitLabTime(build.StartedAt); err == nil {
+			if start.IsZero() || started.Before(start) {
+				start = started
+			}
+		}
+		if finished, err := parseGitLabTime(build.FinishedAt); err == nil {
+			if finished.After(end) {
+				end = finished
+			}
+		}
+	}
+
+	if start.IsZero() {
+		gtr.logger.Debug("no jobs started in stage, skipping...", zap.String("stage", stage))
+		return nil
+	}
+
+	if end.Before(start) {
+		end = start
+	}
+
+	spanID, err := newStageSpanID(event.ObjectAttributes.ID, stage)
+	if err != nil {
+		return fmt.Errorf("failed to generate stage span ID: %w", err)
+	}
+
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	span := scopeSpans.Spans().AppendEmpty()
+	span.SetTraceID(traceID)
+	span.SetParentSpanID(parentSpanID)
+	span.SetSpanID(spanID)
+	span.SetName(stage)
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+
+	status := stageStatus(builds)
+	attrs := span.Attributes()
+	attrs.PutStr(string(AttributeCICDPipelineStageNameKey), stage)
+	attrs.PutStr(string(AttributeCICDPipelineTaskRunStatusKey), convertStatus(status))
+
+	setSpanStatus(span, status)
+
+	return nil
+}
+
+// stageStatus derives the status of a stage from the status of its builds. A
+// stage fails when any build that is not allowed to fail has failed.
+func stageStatus(builds []gitlab.PipelineEventBuild) string {
+	var success, canceled bool
+	for _, build := range builds {
+		switch strings.ToLower(build.Status) {
+		case "failed":
+			if !build.AllowFailure {
+				return "failed"
+			}
+			success = true
+		case "canceled":
+			canceled = true
+		case "success":
+			success = true
+		}
+	}
+
+	switch {
+	case canceled:
+		return "canceled"
+	case success:
+		return "success"
+	default:
+		return "skipped"
+	}
+}
+
+// createJobSpan creates a span for the job based on the provided event,
+// associated with the deterministic traceID and parented to the stage span.
+func (gtr *gitlabTracesReceiver) createJobSpan(
+	resourceSpans ptrace.ResourceSpans,
+	event *gitlab.JobEvent,
+	traceID pcommon.TraceID,
+) error {
+	parentSpanID, err := newStageSpanID(event.PipelineID, event.BuildStage)
+	if err != nil {
+		return fmt.Errorf("failed to generate stage span ID: %w", err)
+	}
+
+	spanID, err := newJobSpanID(event.PipelineID, event.BuildID)
+	if err != nil {
+		return fmt.Errorf("failed to generate job span ID: %w", err)
+	}
+
+	start, err := parseGitLabTime(firstNonEmpty(event.BuildStartedAtISO, event.BuildStartedAt))
+	if err != nil {
+		// Jobs which were skipped or canceled before being picked up by a
+		// runner never start, so fallback to the time they were created.
+		start, err = parseGitLabTime(firstNonEmpty(event.BuildCreatedAtISO, event.BuildCreatedAt))
+		if err != nil {
+			return fmt.Errorf("failed to parse job start time: %w", err)
+		}
+	}
+
+	end, err := parseGitLabTime(firstNonEmpty(event.BuildFinishedAtISO, event.BuildFinishedAt))
+	if err != nil {
+		end = start
+	}
+
+	scopeSpans := resourceSpans.ScopeSpans().AppendEmpty()
+	span := scopeSpans.Spans().AppendEmpty()
+	span.SetTraceID(traceID)
+	span.SetParentSpanID(parentSpanID)
+	span.SetSpanID(spanID)
+	span.SetName(event.BuildName)
+	span.SetKind(ptrace.SpanKindServer)
+	span.SetStartTimestamp(pcommon.NewTimestampFromTime(start))
+	span.SetEndTimestamp(pcommon.NewTimestampFromTime(end))
+
+	attrs := span.Attributes()
+	attrs.PutStr(string(semconv.CICDPipelineTaskNameKey), event.BuildName)
+	attrs.PutStr(string(AttributeCICDPipelineTaskRunStatusKey), convertStatus(event.BuildStatus))
+
+	setSpanStatus(span, event.BuildStatus)
+
+	return nil
+}
+
+// setSpanStatus sets the span status code and message from the GitLab status.
+func setSpanStatus(span ptrace.Span, status string) {
+	switch strings.ToLower(status) {
+	case "success":
+		span.Status().SetCode(ptrace.StatusCodeOk)
+	case "failed":
+		span.Status().SetCode(ptrace.StatusCodeError)
+	default:
+		span.Status().SetCode(ptrace.StatusCodeUnset)
+	}
+
+	span.Status().SetMessage(status)
+}
+
+// firstNonEmpty returns the first value that is not an empty string.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
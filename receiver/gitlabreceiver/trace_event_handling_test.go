@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlabreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver"
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/golden"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/pdatatest/ptracetest"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go/v2"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadata"
+)
+
+func TestHandlePipelineWithGoldenFile(t *testing.T) {
+	defaultConfig := createDefaultConfig().(*Config)
+	defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+	consumer := consumertest.NewNop()
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumer)
+	require.NoError(t, err, "failed to create receiver")
+
+	data, err := os.ReadFile(filepath.Join("testdata", "pipeline-completed.json"))
+	require.NoError(t, err, "Failed to read test data file")
+
+	var event gitlab.PipelineEvent
+	err = json.Unmarshal(data, &event)
+	require.NoError(t, err, "Failed to unmarshal pipeline event")
+
+	traces, err := receiver.handlePipeline(&event)
+	require.NoError(t, err, "Failed to handle pipeline event")
+
+	expectedFile := filepath.Join("testdata", "pipeline-expected.yaml")
+
+	// Uncomment the following line to update the golden file
+	// golden.WriteTraces(t, expectedFile, traces)
+
+	expectedTraces, err := golden.ReadTraces(expectedFile)
+	require.NoError(t, err, "Failed to read expected traces")
+
+	require.NoError(t, ptracetest.CompareTraces(expectedTraces, traces))
+}
+
+func TestHandleJobWithGoldenFile(t *testing.T) {
+	defaultConfig := createDefaultConfig().(*Config)
+	defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+	consumer := consumertest.NewNop()
+
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumer)
+	require.NoError(t, err, "failed to create receiver")
+
+	data, err := os.ReadFile(filepath.Join("testdata", "job-completed.json"))
+	require.NoError(t, err, "Failed to read test data file")
+
+	var event gitlab.JobEvent
+	err = json.Unmarshal(data, &event)
+	require.NoError(t, err, "Failed to unmarshal job event")
+
+	traces, err := receiver.handleJob(&event)
+	require.NoError(t, err, "Failed to handle job event")
+
+	expectedFile := filepath.Join("testdata", "job-expected.yaml")
+
+	// Uncomment the following line to update the golden file
+	// golden.WriteTraces(t, expectedFile, traces)
+
+	expectedTraces, err := golden.ReadTraces(expectedFile)
+	require.NoError(t, err, "Failed to read expected traces")
+
+	require.NoError(t, ptracetest.CompareTraces(expectedTraces, traces))
+}
+
+// TestJobSpanParentsToStageSpan ensures a job span emitted from a Job Hook is
+// parented to the stage span emitted from the Pipeline Hook of the same run.
+func TestJobSpanParentsToStageSpan(t *testing.T) {
+	defaultConfig := createDefaultConfig().(*Config)
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumertest.NewNop())
+	require.NoError(t, err)
+
+	var pipeline gitlab.PipelineEvent
+	data, err := os.ReadFile(filepath.Join("testdata", "pipeline-completed.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &pipeline))
+
+	var job gitlab.JobEvent
+	data, err = os.ReadFile(filepath.Join("testdata", "job-completed.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &job))
+
+	pipelineTraces, err := receiver.handlePipeline(&pipeline)
+	require.NoError(t, err)
+	jobTraces, err := receiver.handleJob(&job)
+	require.NoError(t, err)
+
+	jobSpan := jobTraces.ResourceSpans().At(0).ScopeSpans().At(0).Spans().At(0)
+
+	var stageSpan ptrace.Span
+	found := false
+	scopeSpans := pipelineTraces.ResourceSpans().At(0).ScopeSpans()
+	for i := 0; i < scopeSpans.Len(); i++ {
+		span := scopeSpans.At(i).Spans().At(0)
+		if span.Name() == job.BuildStage {
+			stageSpan = span
+			found = true
+		}
+	}
+
+	require.True(t, found, "stage span not found")
+	require.Equal(t, stageSpan.TraceID(), jobSpan.TraceID())
+	require.Equal(t, stageSpan.SpanID(), jobSpan.ParentSpanID())
+}
+
+func TestNewTraceID(t *testing.T) {
+	a, err := newTraceID(1593416342)
+	require.NoError(t, err)
+	b, err := newTraceID(1593416342)
+	require.NoError(t, err)
+	c, err := newTraceID(1593416343)
+	require.NoError(t, err)
+
+	require.False(t, a.IsEmpty())
+	require.Equal(t, a, b)
+	require.NotEqual(t, a, c)
+}
+
+func TestNewSpanIDs(t *testing.T) {
+	pipeline, err := newPipelineSpanID(1)
+	require.NoError(t, err)
+	stage, err := newStageSpanID(1, "build")
+	require.NoError(t, err)
+	otherStage, err := newStageSpanID(1, "test")
+	require.NoError(t, err)
+	job, err := newJobSpanID(1, 100)
+	require.NoError(t, err)
+	retriedJob, err := newJobSpanID(1, 101)
+	require.NoError(t, err)
+
+	ids := []any{pipeline, stage, otherStage, job, retriedJob}
+	for i := range ids {
+		for j := range ids {
+			if i != j {
+				require.NotEqual(t, ids[i], ids[j])
+			}
+		}
+	}
+
+	again, err := newStageSpanID(1, "build")
+	require.NoError(t, err)
+	require.Equal(t, stage, again)
+}
+
+func TestStageStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		builds   []gitlab.PipelineEventBuild
+		expected string
+	}{
+		{
+			name:     "all success",
+			builds:   []gitlab.PipelineEventBuild{{Status: "success"}, {Status: "success"}},
+			expected: "success",
+		},
+		{
+			name:     "failed build fails the stage",
+			builds:   []gitlab.PipelineEventBuild{{Status: "success"}, {Status: "failed"}},
+			expected: "failed",
+		},
+		{
+			name:     "allowed failure does not fail the stage",
+			builds:   []gitlab.PipelineEventBuild{{Status: "success"}, {Status: "failed", AllowFailure: true}},
+			expected: "success",
+		},
+		{
+			name:     "canceled build cancels the stage",
+			builds:   []gitlab.PipelineEventBuild{{Status: "success"}, {Status: "canceled"}},
+			expected: "canceled",
+		},
+		{
+			name:     "only skipped builds",
+			builds:   []gitlab.PipelineEventBuild{{Status: "skipped"}, {Status: "manual"}},
+			expected: "skipped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, stageStatus(tt.builds))
+		})
+	}
+}
+
+func TestParseGitLabTime(t *testing.T) {
+	expected := time.Date(2025, 1, 13, 15, 23, 28, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "pipeline hook format", input: "2025-01-13 15:23:28 UTC"},
+		{name: "offset format", input: "2025-01-13 15:23:28 +0000"},
+		{name: "iso format", input: "2025-01-13T15:23:28Z"},
+		{name: "empty", input: "", wantErr: true},
+		{name: "invalid", input: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGitLabTime(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, expected.Equal(got), "expected %s, got %s", expected, got)
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlabreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver"
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+	gitlab "gitlab.com/gitlab-org/api/client-go/v2"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componentstatus"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/receiver"
+	"go.opentelemetry.io/collector/receiver/receiverhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+var (
+	errMissingEndpoint     = errors.New("missing a receiver endpoint")
+	errInvalidToken        = errors.New("invalid gitlab token")
+	errMissingRequiredHead = errors.New("missing or invalid required header")
+	errUnsupportedEvent    = errors.New("event type not supported")
+)
+
+const healthyResponse = `{"text": "GitLab receiver webhook is healthy"}`
+
+const transportProtocol = "http"
+
+type gitlabTracesReceiver struct {
+	traceConsumer consumer.Traces
+	cfg           *Config
+	server        *http.Server
+	shutdownWG    sync.WaitGroup
+	settings      receiver.Settings
+	logger        *zap.Logger
+	obsrecv       *receiverhelper.ObsReport
+	queue         *webhookQueue
+}
+
+func newTracesReceiver(
+	params receiver.Settings,
+	config *Config,
+	traceConsumer consumer.Traces,
+) (*gitlabTracesReceiver, error) {
+	if config.WebHook.NetAddr.Endpoint == "" {
+		return nil, errMissingEndpoint
+	}
+
+	obsrecv, err := receiverhelper.NewObsReport(receiverhelper.ObsReportSettings{
+		ReceiverID:             params.ID,
+		Transport:              transportProtocol,
+		ReceiverCreateSettings: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	gtr := &gitlabTracesReceiver{
+		traceConsumer: traceConsumer,
+		cfg:           config,
+		settings:      params,
+		logger:        params.Logger,
+		obsrecv:       obsrecv,
+	}
+
+	if config.WebHook.Async.Enabled {
+		gtr.queue, err = newWebhookQueue(config.WebHook.Async, params.TelemetrySettings, gtr.handlePayload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gtr, nil
+}
+
+func (gtr *gitlabTracesReceiver) Start(ctx context.Context, host component.Host) error {
+	endpoint := fmt.Sprintf("%s%s", gtr.cfg.WebHook.NetAddr.Endpoint, gtr.cfg.WebHook.Path)
+	gtr.logger.Info("Starting GitLab WebHook receiving server", zap.String("endpoint", endpoint))
+
+	// noop if not nil. if start has not been called before these values should be nil.
+	if gtr.server != nil && gtr.server.Handler != nil {
+		return nil
+	}
+
+	// create listener from config
+	ln, err := gtr.cfg.WebHook.ToListener(ctx)
+	if err != nil {
+		return err
+	}
+
+	// use gorilla mux to set up a router
+	router := mux.NewRouter()
+
+	// setup health route
+	router.HandleFunc(gtr.cfg.WebHook.HealthPath, gtr.handleHealthCheck)
+
+	// setup webhook route for traces
+	router.HandleFunc(gtr.cfg.WebHook.Path, gtr.handleReq)
+
+	// Initialize extensions as nil, which is safe to pass to ToClient when host is nil
+	// The OpenTelemetry client will handle the nil extensions case appropriately
+	var extensions map[component.ID]component.Component
+	if host != nil {
+		extensions = host.GetExtensions()
+	}
+
+	// webhook server standup and configuration
+	gtr.server, err = gtr.cfg.WebHook.ToServer(ctx, extensions, gtr.settings.TelemetrySettings, router)
+	if err != nil {
+		return err
+	}
+
+	gtr.logger.Info("Health check now listening at", zap.String("health_path", gtr.cfg.WebHook.HealthPath))
+
+	if gtr.queue != nil {
+		gtr.queue.start(ctx)
+	}
+
+	gtr.shutdownWG.Add(1)
+	go func() {
+		defer gtr.shutdownWG.Done()
+
+		if errHTTP := gtr.server.Serve(ln); !errors.Is(errHTTP, http.ErrServerClosed) && errHTTP != nil {
+			componentstatus.ReportStatus(host, componentstatus.NewFatalErrorEvent(errHTTP))
+		}
+	}()
+
+	return nil
+}
+
+func (gtr *gitlabTracesReceiver) Shutdown(ctx context.Context) error {
+	// server must exist to be closed.
+	if gtr.server == nil {
+		return nil
+	}
+
+	err := gtr.server.Close()
+	gtr.shutdownWG.Wait()
+
+	// process the deliveries already acknowledged before shutting down
+	if gtr.queue != nil {
+		err = multierr.Append(err, gtr.queue.shutdown(ctx))
+	}
+	return err
+}
+
+// handleReq handles incoming request sent to the webhook endoint. On success
+// returns a 200 response code, or a 202 when processed asynchronously.
+func (gtr *gitlabTracesReceiver) handleReq(w http.ResponseWriter, req *http.Request) {
+	if err := gtr.validateReq(req); err != nil {
+		gtr.logger.Debug("unable to validate request", zap.Error(err))
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	p, err := io.ReadAll(req.Body)
+	if err != nil {
+		gtr.logger.Debug("failed to read request body", zap.Error(err))
+		http.Error(w, "failed to read request body", http.StatusBadRequest)
+		return
+	}
+	defer req.Body.Close()
+
+	if gtr.queue != nil {
+		status := gtr.queue.enqueue(req, p)
+		if status != http.StatusAccepted {
+			http.Error(w, http.StatusText(status), status)
+			return
+		}
+		w.WriteHeader(status)
+		return
+	}
+
+	gtr.handlePayload(w, req, p)
+}
+
+// handlePayload converts a validated delivery into traces and consumes them.
+func (gtr *gitlabTracesReceiver) handlePayload(w http.ResponseWriter, req *http.Request, p []byte) {
+	td := ptrace.NewTraces()
+	var err error
+
+	ctx := gtr.obsrecv.StartTracesOp(req.Context())
+	defer func() {
+		gtr.obsrecv.EndTracesOp(ctx, "protobuf", td.SpanCount(), err)
+	}()
+
+	eventType := gitlab.HookEventType(req)
+	event, err := gitlab.ParseWebhook(eventType, p)
+	if err != nil {
+		gtr.logger.Debug("failed to parse event", zap.Error(err))
+		http.Error(w, "failed to parse event", http.StatusBadRequest)
+		return
+	}
+
+	switch e := event.(type) {
+	case *gitlab.PipelineEvent:
+		if !isCompleted(e.ObjectAttributes.Status) {
+			gtr.logger.Debug("pipeline not complete, skipping...", zap.String("status", e.ObjectAttributes.Status))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		td, err = gtr.handlePipeline(e)
+	case *gitlab.JobEvent:
+		if !isCompleted(e.BuildStatus) {
+			gtr.logger.Debug("job not complete, skipping...", zap.String("status", e.BuildStatus))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		td, err = gtr.handleJob(e)
+	default:
+		gtr.logger.Debug("event type not supported", zap.String("event_type", string(eventType)))
+		err = fmt.Errorf("%w: %s", errUnsupportedEvent, eventType)
+		http.Error(w, "event type not supported", http.StatusBadRequest)
+		return
+	}
+
+	if err != nil {
+		gtr.logger.Debug("failed to handle event", zap.Error(err))
+		http.Error(w, "failed to handle event", http.StatusBadRequest)
+		return
+	}
+
+	if td.SpanCount() > 0 {
+		err = gtr.traceConsumer.ConsumeTraces(ctx, td)
+		if err != nil {
+			http.Error(w, "failed to consume traces", http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// validateReq verifies the X-Gitlab-Token header matches the configured secret
+// and that all configured required headers are present on the request.
+func (gtr *gitlabTracesReceiver) validateReq(req *http.Request) error {
+	if gtr.cfg.WebHook.Secret != "" {
+		token := gitlab.HookEventToken(req)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(gtr.cfg.WebHook.Secret)) != 1 {
+			return errInvalidToken
+		}
+	}
+
+	for key, value := range gtr.cfg.WebHook.RequiredHeaders {
+		if req.Header.Get(key) != string(value) {
+			return fmt.Errorf("%w: %s", errMissingRequiredHead, key)
+		}
+	}
+
+	return nil
+}
+
+// Simple healthcheck endpoint.
+func (gtr *gitlabTracesReceiver) handleHealthCheck(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = w.Write([]byte(healthyResponse)) //nolint:all
+}
@@ -0,0 +1,198 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package gitlabreceiver // import "github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver"
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/config/confighttp"
+	"go.opentelemetry.io/collector/config/confignet"
+	"go.opentelemetry.io/collector/config/configopaque"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/consumer/consumertest"
+	"go.opentelemetry.io/collector/receiver/receivertest"
+
+	"github.com/liatrio/liatrio-otel-collector/receiver/gitlabreceiver/internal/metadata"
+)
+
+func TestCreateNewTracesReceiver(t *testing.T) {
+	defaultConfig := createDefaultConfig().(*Config)
+
+	tests := []struct {
+		desc     string
+		config   Config
+		consumer consumer.Traces
+		err      error
+	}{
+		{
+			desc:     "Default config succeeds",
+			config:   *defaultConfig,
+			consumer: consumertest.NewNop(),
+			err:      nil,
+		},
+		{
+			desc: "User defined config success",
+			config: Config{
+				WebHook: WebHook{
+					ServerConfig: confighttp.ServerConfig{
+						NetAddr: confignet.AddrConfig{
+							Endpoint: "localhost:0",
+						},
+					},
+					Path:       "/events",
+					HealthPath: "/health_check",
+				},
+			},
+			consumer: consumertest.NewNop(),
+		},
+		{
+			desc: "Missing endpoint fails",
+			config: Config{
+				WebHook: WebHook{},
+			},
+			consumer: consumertest.NewNop(),
+			err:      errMissingEndpoint,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			rec, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), &test.config, test.consumer)
+			if test.err == nil {
+				require.NotNil(t, rec)
+			} else {
+				require.ErrorIs(t, err, test.err)
+				require.Nil(t, rec)
+			}
+		})
+	}
+}
+
+func TestHealthCheck(t *testing.T) {
+	defaultConfig := createDefaultConfig().(*Config)
+	defaultConfig.WebHook.NetAddr.Endpoint = "localhost:0"
+	consumer := consumertest.NewNop()
+	receiver, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), defaultConfig, consumer)
+	require.NoError(t, err, "failed to create receiver")
+
+	r := receiver
+	require.NoError(t, r.Start(context.Background(), componenttest.NewNopHost()), "failed to start receiver")
+	defer func() {
+		require.NoError(t, r.Shutdown(context.Background()), "failed to shutdown receiver")
+	}()
+
+	w := httptest.NewRecorder()
+	r.handleHealthCheck(w, httptest.NewRequestWithContext(context.Background(), http.MethodGet, "http://localhost/health", nil))
+
+	response := w.Result()
+	require.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestHandleReq(t *testing.T) {
+	pipeline, err := os.ReadFile(filepath.Join("testdata", "pipeline-completed.json"))
+	require.NoError(t, err)
+	job, err := os.ReadFile(filepath.Join("testdata", "job-completed.json"))
+	require.NoError(t, err)
+	running := bytes.Replace(job, []byte(`"build_status": "failed"`), []byte(`"build_status": "running"`), 1)
+
+	tests := []struct {
+		desc            string
+		event           string
+		token           string
+		headers         map[string]string
+		requiredHeaders map[string]configopaque.String
+		body            []byte
+		expectedStatus  int
+		expectedSpans   int
+	}{
+		{
+			desc:           "pipeline hook with valid token",
+			event:          "Pipeline Hook",
+			token:          "secret",
+			body:           pipeline,
+			expectedStatus: http.StatusOK,
+			expectedSpans:  3,
+		},
+		{
+			desc:           "job hook with valid token",
+			event:          "Job Hook",
+			token:          "secret",
+			body:           job,
+			expectedStatus: http.StatusOK,
+			expectedSpans:  1,
+		},
+		{
+			desc:           "job hook that is not complete is skipped",
+			event:          "Job Hook",
+			token:          "secret",
+			body:           running,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			desc:           "invalid token is rejected",
+			event:          "Pipeline Hook",
+			token:          "wrong",
+			body:           pipeline,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			desc:            "missing required header is rejected",
+			event:           "Pipeline Hook",
+			token:           "secret",
+			requiredHeaders: map[string]configopaque.String{"WAF-Header": "value"},
+			body:            pipeline,
+			expectedStatus:  http.StatusBadRequest,
+		},
+		{
+			desc:            "present required header is accepted",
+			event:           "Pipeline Hook",
+			token:           "secret",
+			headers:         map[string]string{"WAF-Header": "value"},
+			requiredHeaders: map[string]configopaque.String{"WAF-Header": "value"},
+			body:            pipeline,
+			expectedStatus:  http.StatusOK,
+			expectedSpans:   3,
+		},
+		{
+			desc:           "unsupported event type",
+			event:          "Push Hook",
+			token:          "secret",
+			body:           []byte(`{"object_kind": "push"}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := createDefaultConfig().(*Config)
+			cfg.WebHook.Secret = "secret"
+			cfg.WebHook.RequiredHeaders = test.requiredHeaders
+
+			sink := new(consumertest.TracesSink)
+			r, err := newTracesReceiver(receivertest.NewNopSettings(metadata.Type), cfg, sink)
+			require.NoError(t, err)
+
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, "http://localhost/events", bytes.NewReader(test.body))
+			req.Header.Set(defaultGitLabEventHeader, test.event)
+			req.Header.Set(defaultGitLabTokenHeader, test.token)
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+
+			w := httptest.NewRecorder()
+			r.handleReq(w, req)
+
+			require.Equal(t, test.expectedStatus, w.Result().StatusCode)
+			require.Equal(t, test.expectedSpans, sink.SpanCount())
+		})
+	}
+}